@@ -12,13 +12,14 @@ import (
 func NewAccountCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account",
-		Short: "Operate an account or address: balance|new|newkeys|contracts|restore|decrypt.",
+		Short: "Operate an account or address: balance|new|newkeys|contracts|history|restore|decrypt.",
 	}
 	cmd.AddCommand(NewAccountBalanceCommand(cli))
 	cmd.AddCommand(NewAccountNewkeysCommand(cli))
 	cmd.AddCommand(NewAccountNewCommand(cli))
 	cmd.AddCommand(NewAccountContractsCommand(cli))
 	cmd.AddCommand(NewAccountQueryCommand(cli))
+	cmd.AddCommand(NewAccountHistoryCommand(cli))
 	cmd.AddCommand(NewAccountRestoreCommand(cli))
 	cmd.AddCommand(NewAccountDecryptCommand(cli))
 	return cmd
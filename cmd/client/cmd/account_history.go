@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// AccountHistoryCommand account history cmd
+type AccountHistoryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	offset int64
+	limit  int64
+}
+
+// NewAccountHistoryCommand new account history cmd
+func NewAccountHistoryCommand(cli *Cli) *cobra.Command {
+	t := new(AccountHistoryCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "history account",
+		Short: "list acl, ak and method acl changes of a contract account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.queryHistory(ctx, args[0])
+		},
+	}
+	t.addFlags()
+	return t.cmd
+}
+
+func (t *AccountHistoryCommand) addFlags() {
+	t.cmd.Flags().Int64VarP(&t.offset, "offset", "", 0, "offset of the first change")
+	t.cmd.Flags().Int64VarP(&t.limit, "limit", "l", 20, "max changes to list")
+}
+
+func (t *AccountHistoryCommand) queryHistory(ctx context.Context, account string) error {
+	client := t.cli.XchainClient()
+	request := &pb.AccountHistoryRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:  t.cli.RootOptions.Name,
+		Account: account,
+		Offset:  t.offset,
+		Limit:   t.limit,
+	}
+	reply, err := client.GetAccountHistory(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	for _, change := range reply.GetChanges() {
+		t.printChange(change)
+	}
+	if reply.GetHasMore() {
+		fmt.Printf("more changes, use --offset %d to continue\n", t.offset+int64(len(reply.GetChanges())))
+	}
+	if reply.GetTruncated() {
+		fmt.Println("history truncated, earlier changes may be missing")
+	}
+	return nil
+}
+
+func (t *AccountHistoryCommand) printChange(change *pb.AccountChange) {
+	height := "unconfirmed"
+	if change.GetStatus() == pb.TransactionStatus_CONFIRM {
+		height = fmt.Sprintf("%d", change.GetHeight())
+	}
+	fmt.Printf("%s\t%x\t%s", height, change.GetTxid(),
+		strings.TrimPrefix(change.GetType().String(), "ACCOUNT_CHANGE_"))
+	if change.GetType() == pb.AccountChangeType_ACCOUNT_CHANGE_METHOD_ACL {
+		fmt.Printf("\t%s.%s", change.GetContractName(), change.GetMethodName())
+	}
+	fmt.Printf("\tinitiator:%s\n", change.GetInitiator())
+	for _, ak := range change.GetAddedAks() {
+		fmt.Printf("  + %s\n", ak)
+	}
+	for _, ak := range change.GetRemovedAks() {
+		fmt.Printf("  - %s\n", ak)
+	}
+
+	output, err := json.MarshalIndent(change.GetAcl(), "  ", "  ")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("  %s\n", output)
+}
@@ -187,6 +187,15 @@ func (p *KeyPair) SignTx(tx *pb.Transaction, crypto base.CryptoClient) (*pb.Sign
 //	utxo: locked UTXO
 //	crypto: crypto client
 func (p *KeyPair) SignUtxo(utxo *models.LockedUtxo, crypto base.CryptoClient) (pb.SignatureInfo, error) {
+	return p.signHash(utxo.Hash(), crypto)
+}
+
+// SignUtxoRelease signs for releasing locked UTXO with given crypto client by AK key pair
+func (p *KeyPair) SignUtxoRelease(release *models.UtxoRelease, crypto base.CryptoClient) (pb.SignatureInfo, error) {
+	return p.signHash(release.Hash(), crypto)
+}
+
+func (p *KeyPair) signHash(hash []byte, crypto base.CryptoClient) (pb.SignatureInfo, error) {
 
 	// prepare private key
 	ecdsaPrivateKey, err := crypto.GetEcdsaPrivateKeyFromJsonStr(p.secretKey)
@@ -195,7 +204,7 @@ func (p *KeyPair) SignUtxo(utxo *models.LockedUtxo, crypto base.CryptoClient) (p
 	}
 
 	// sign
-	sign, err := crypto.SignECDSA(ecdsaPrivateKey, utxo.Hash())
+	sign, err := crypto.SignECDSA(ecdsaPrivateKey, hash)
 	if err != nil {
 		return pb.SignatureInfo{}, err
 	}
@@ -19,12 +19,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
 	"github.com/xuperchain/xupercore/lib/crypto/client"
 	"github.com/xuperchain/xupercore/lib/crypto/client/base"
 	"github.com/xuperchain/xupercore/lib/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/xuperchain/xuperchain/models"
 	"github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
 )
@@ -48,6 +50,8 @@ type RootOptions struct {
 	EndorseServiceHost string                `yaml:"endorseServiceHost,omitempty"`
 	ComplianceCheck    ComplianceCheckConfig `yaml:"complianceCheck,omitempty"`
 	MinNewChainAmount  string                `yaml:"minNewChainAmount,omitempty"`
+	// 节点开启访问控制时使用的api key或JWT
+	Token string `yaml:"token,omitempty"`
 }
 
 // Cli 是所有子命令执行的上下文.
@@ -78,7 +82,8 @@ func (c *Cli) SetVer(ver string) {
 
 func (c *Cli) initXchainClient() error {
 	//nolint:staticcheck
-	conn, err := grpc.Dial(c.RootOptions.Host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
+	options := []grpc.DialOption{grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20 - 1)}
+	conn, err := grpc.Dial(c.RootOptions.Host, c.withToken(options)...)
 	if err != nil {
 		return err
 	}
@@ -100,6 +105,7 @@ func (c *Cli) initFlags() error {
 	rootFlag.String("name", c.RootOptions.Name, "block chain name")
 	rootFlag.String("keys", c.RootOptions.Keys, "directory of keys")
 	rootFlag.String("crypto", c.RootOptions.Crypto, "crypto type")
+	rootFlag.String("token", c.RootOptions.Token, "api key or JWT for node with auth enabled")
 	if err := viper.BindPFlags(rootFlag); err != nil {
 		return err
 	}
@@ -177,6 +183,25 @@ func (c *Cli) GetNodes(ctx context.Context) ([]string, error) {
 	return nodes, nil
 }
 
+// tokenCreds 以"Bearer <token>"的形式在每个请求的metadata中携带token
+type tokenCreds string
+
+func (t tokenCreds) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCreds) RequireTransportSecurity() bool {
+	return false
+}
+
+// withToken 配置了token时追加携带token的选项
+func (c *Cli) withToken(options []grpc.DialOption) []grpc.DialOption {
+	if c.RootOptions.Token == "" {
+		return options
+	}
+	return append(options, grpc.WithPerRPCCredentials(tokenCreds(c.RootOptions.Token)))
+}
+
 func genCreds(certPath, serverName string) (credentials.TransportCredentials, error) {
 	bs, err := os.ReadFile(certPath + "/cert.crt")
 
@@ -222,7 +247,7 @@ func (c *Cli) RangeNodes(ctx context.Context, f func(addr string, client pb.Xcha
 	}
 
 	for _, addr := range nodes {
-		conn, err := grpc.Dial(addr, options...)
+		conn, err := grpc.Dial(addr, c.withToken(options)...)
 		if err != nil {
 			err = f(addr, nil, err)
 			if err != nil {
@@ -237,7 +262,7 @@ func (c *Cli) RangeNodes(ctx context.Context, f func(addr string, client pb.Xcha
 	}
 
 	optionsRPC := []grpc.DialOption{grpc.WithMaxMsgSize(64<<20 - 1), grpc.WithInsecure()} //nolint:staticcheck
-	conn, err := grpc.Dial(c.RootOptions.Host, optionsRPC...)
+	conn, err := grpc.Dial(c.RootOptions.Host, c.withToken(optionsRPC)...)
 	if err != nil {
 		return err
 	}
@@ -285,6 +310,13 @@ func (c *Cli) transferSupportAccount(ctx context.Context, client pb.XchainClient
 	if err != nil {
 		return "", err
 	}
+	posted := false
+	defer func() {
+		// 交易未提交时提前释放锁定的utxo，避免重试时余额被占用直到锁过期
+		if !posted {
+			releaseUtxoLock(ctx, client, opt, initiator, cryptoClient, txStatus.Tx.GetTxInputs())
+		}
+	}()
 
 	// 签名和生成 Tx ID
 	signInfo, err := initiator.KeyPair.SignTx(txStatus.Tx, cryptoClient)
@@ -318,6 +350,7 @@ func (c *Cli) transferSupportAccount(ctx context.Context, client pb.XchainClient
 	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
 		return "", fmt.Errorf("Failed to post tx: %s", reply.Header.String())
 	}
+	posted = true
 	return hex.EncodeToString(txStatus.GetTxid()), nil
 }
 
@@ -371,6 +404,7 @@ func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *
 	// 设置auth require
 	tx.AuthRequire, err = genAuthRequirement(opt.From, opt.AccountPath)
 	if err != nil {
+		releaseUtxoLock(ctx, client, opt, initiator, cryptoClient, txInputs)
 		return nil, err
 	}
 
@@ -386,6 +420,7 @@ func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *
 
 	preExeRes, err := client.PreExec(ctx, preExeRPCReq)
 	if err != nil {
+		releaseUtxoLock(ctx, client, opt, initiator, cryptoClient, txInputs)
 		return nil, err
 	}
 
@@ -476,6 +511,80 @@ func signTxForAccount(tx *pb.Transaction, path string, crypto base.CryptoClient)
 	return signs, nil
 }
 
+// releaseUtxoLock 释放assembleTxInputsSupportAccount锁定的utxo，失败时等待锁自动过期即可
+func releaseUtxoLock(ctx context.Context, client pb.XchainClient, opt *TransferOptions,
+	initiator AKInfo, crypto base.CryptoClient, txInputs []*pb.TxInput) {
+	if len(txInputs) == 0 {
+		return
+	}
+
+	// 合约账户由账户路径下的AK签名
+	signers := []KeyPair{initiator.KeyPair}
+	if opt.AccountPath != "" {
+		akList, err := listAKs(opt.AccountPath)
+		if err != nil {
+			return
+		}
+		signers = signers[:0]
+		for _, ak := range akList {
+			keyPair, err := ak.keyPair()
+			if err != nil {
+				return
+			}
+			signers = append(signers, keyPair)
+		}
+	}
+	req, err := newUtxoLockRelease(opt.BlockchainName, opt.From, txInputs, signers, crypto)
+	if err != nil {
+		return
+	}
+	_, _ = client.ReleaseUTXOLock(ctx, req)
+}
+
+// newUtxoLockRelease 生成释放txInputs引用的utxo锁的请求，
+// 普通地址由signers[0]签名，合约账户由signers中的所有AK签名
+func newUtxoLockRelease(bcName, address string, txInputs []*pb.TxInput, signers []KeyPair,
+	crypto base.CryptoClient) (*pb.UtxoLockRelease, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signer for utxo release")
+	}
+
+	req := &pb.UtxoLockRelease{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:    bcName,
+		Address:   address,
+		Timestamp: time.Now().Unix(),
+	}
+	release := models.NewUtxoRelease(bcName, address, req.Timestamp)
+	for _, txInput := range txInputs {
+		req.UtxoList = append(req.UtxoList, &pb.Utxo{
+			RefTxid:   txInput.RefTxid,
+			RefOffset: txInput.RefOffset,
+		})
+		release.AddUtxo(txInput.RefTxid, txInput.RefOffset)
+	}
+
+	if !aclUtils.IsAccount(address) {
+		sign, err := signers[0].SignUtxoRelease(release, crypto)
+		if err != nil {
+			return nil, err
+		}
+		req.Publickey = sign.PublicKey
+		req.UserSign = sign.Sign
+		return req, nil
+	}
+	for _, signer := range signers {
+		sign, err := signer.SignUtxoRelease(release, crypto)
+		if err != nil {
+			return nil, fmt.Errorf("sign error: %s", err)
+		}
+		req.AuthRequireSigns = append(req.AuthRequireSigns, &sign)
+	}
+	return req, nil
+}
+
 func assembleTxInputsSupportAccount(ctx context.Context, client pb.XchainClient, opt *TransferOptions,
 	totalNeed *big.Int, initiator AKInfo, crypto base.CryptoClient) ([]*pb.TxInput, *pb.TxOutput, error) {
 
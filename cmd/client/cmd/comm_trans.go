@@ -61,6 +61,13 @@ type CommTrans struct {
 
 	// DebugTx if enabled, tx will be printed instead of being posted
 	DebugTx bool
+	// Confirmations if greater than 0, wait until tx is confirmed on trunk after being posted
+	Confirmations int64
+
+	// lockUtxo 选择utxo时临时锁定，只在结束时会释放锁的Transfer中开启
+	lockUtxo bool
+	// lockedInputs 选择时临时锁定、尚未随交易提交的utxo
+	lockedInputs []*pb.TxInput
 }
 
 // GenerateTx generate raw tx
@@ -79,6 +86,41 @@ func (t *CommTrans) GenerateTx(ctx context.Context) (*pb.Transaction, error) {
 // GenPreExeRes 得到预执行的结果
 func (t *CommTrans) GenPreExeRes(ctx context.Context) (
 	*pb.InvokeRPCResponse, []*pb.InvokeRequest, error) {
+	preExeRPCReq, err := t.preExecReq()
+	if err != nil {
+		return nil, nil, err
+	}
+	preExeRPCRes, err := t.XchainClient.PreExec(ctx, preExeRPCReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeRPCReq.Header.Logid)
+	}
+	for _, res := range preExeRPCRes.Response.Responses {
+		if res.Status >= contract.StatusErrorThreshold {
+			return nil, nil, fmt.Errorf("contract error status:%d message:%s", res.Status, res.Message)
+		}
+		fmt.Printf("contract response: %s\n", string(res.Body))
+	}
+	return preExeRPCRes, preExeRPCRes.Response.Requests, nil
+}
+
+// EstimateFee 预执行并按资源类型预估交易费用
+func (t *CommTrans) EstimateFee(ctx context.Context) (*pb.EstimateFeeResponse, error) {
+	req, err := t.preExecReq()
+	if err != nil {
+		return nil, err
+	}
+	reply, err := t.XchainClient.EstimateFee(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee failed: %v, logid:%s", err, req.Header.Logid)
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return nil, errors.New(reply.Header.Error.String())
+	}
+	return reply, nil
+}
+
+// preExecReq 根据命令参数生成预执行请求
+func (t *CommTrans) preExecReq() (*pb.InvokeRPCRequest, error) {
 	preExeReqs := []*pb.InvokeRequest{}
 	if t.ModuleName != "" {
 		if t.ModuleName == "xkernel" {
@@ -104,7 +146,7 @@ func (t *CommTrans) GenPreExeRes(ctx context.Context) (
 	} else {
 		tmpReq, err := t.GetInvokeRequestFromDesc()
 		if err != nil {
-			return nil, nil, fmt.Errorf("Get pb.InvokeRPCRequest error:%s", err)
+			return nil, fmt.Errorf("Get pb.InvokeRPCRequest error:%s", err)
 		}
 		if tmpReq != nil {
 			preExeReqs = append(preExeReqs, tmpReq)
@@ -121,32 +163,22 @@ func (t *CommTrans) GenPreExeRes(ctx context.Context) (
 
 	initiator, err := t.genInitiator()
 	if err != nil {
-		return nil, nil, fmt.Errorf("Get initiator error: %s", err.Error())
+		return nil, fmt.Errorf("Get initiator error: %s", err.Error())
 	}
 
 	preExeRPCReq.Initiator = initiator
 	if !t.IsQuick {
 		preExeRPCReq.AuthRequire, err = t.genAuthRequireQuick()
 		if err != nil {
-			return nil, nil, fmt.Errorf("Get auth require quick error: %s", err.Error())
+			return nil, fmt.Errorf("Get auth require quick error: %s", err.Error())
 		}
 	} else {
 		preExeRPCReq.AuthRequire, err = t.GenAuthRequire(t.MultiAddrs)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Get auth require error: %s", err.Error())
+			return nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
 	}
-	preExeRPCRes, err := t.XchainClient.PreExec(ctx, preExeRPCReq)
-	if err != nil {
-		return nil, nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeRPCReq.Header.Logid)
-	}
-	for _, res := range preExeRPCRes.Response.Responses {
-		if res.Status >= contract.StatusErrorThreshold {
-			return nil, nil, fmt.Errorf("contract error status:%d message:%s", res.Status, res.Message)
-		}
-		fmt.Printf("contract response: %s\n", string(res.Body))
-	}
-	return preExeRPCRes, preExeRPCRes.Response.Requests, nil
+	return preExeRPCReq, nil
 }
 
 // GetInvokeRequestFromDesc get invokerequest from desc file
@@ -337,11 +369,20 @@ func (t *CommTrans) GenTxInputs(ctx context.Context, totalNeed *big.Int) (
 		}
 	}
 
+	// 临时锁定选中的utxo，避免并发发送的交易选中相同的utxo
 	utxoInput := &pb.UtxoInput{
 		Bcname:    t.ChainName,
 		Address:   fromAddr,
 		TotalNeed: totalNeed.String(),
-		NeedLock:  false,
+		NeedLock:  t.lockUtxo && t.ownsAddress(fromAddr),
+	}
+	if utxoInput.NeedLock {
+		signature, err := t.signLockUtxo(utxoInput)
+		if err != nil {
+			return nil, nil, err
+		}
+		utxoInput.Publickey = signature.PublicKey
+		utxoInput.UserSign = signature.Sign
 	}
 
 	utxoOutputs, err := t.XchainClient.SelectUTXO(ctx, utxoInput)
@@ -363,6 +404,9 @@ func (t *CommTrans) GenTxInputs(ctx context.Context, totalNeed *big.Int) (
 		txInput.Amount = utxo.Amount
 		txInputs = append(txInputs, txInput)
 	}
+	if utxoInput.NeedLock {
+		t.lockedInputs = append(t.lockedInputs, txInputs...)
+	}
 
 	utxoTotal, ok := big.NewInt(0).SetString(utxoOutputs.TotalSelected, 10)
 	if !ok {
@@ -384,6 +428,10 @@ func (t *CommTrans) GenTxInputs(ctx context.Context, totalNeed *big.Int) (
 
 // Transfer quick access to transfer
 func (t *CommTrans) Transfer(ctx context.Context) error {
+	// 交易未提交时提前释放锁定的utxo，避免重试时余额被占用直到锁过期
+	t.lockUtxo = true
+	defer t.releaseUtxoLock(ctx)
+
 	if t.RootOptions.ComplianceCheck.IsNeedComplianceCheck {
 		preSelectUTXORes, err := t.GenPreExeWithSelectUtxoRes(ctx)
 		if err != nil {
@@ -440,7 +488,7 @@ func (t *CommTrans) SendTx(ctx context.Context, tx *pb.Transaction) error {
 	}
 	fmt.Printf("Tx id: %s\n", txID)
 
-	return nil
+	return t.waitTx(ctx, tx.Txid)
 }
 
 // signTx generates auth required signatures for transaction according to path
@@ -503,10 +551,55 @@ func (t *CommTrans) postTx(ctx context.Context, tx *pb.Transaction) (string, err
 	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
 		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
 	}
+	// 锁定的utxo已被提交的交易引用
+	t.lockedInputs = nil
 
 	return hex.EncodeToString(txStatus.Txid), nil
 }
 
+// releaseUtxoLock 释放选择时锁定且未提交的utxo，失败时等待锁自动过期即可
+func (t *CommTrans) releaseUtxoLock(ctx context.Context) {
+	if len(t.lockedInputs) == 0 {
+		return
+	}
+	lockedInputs := t.lockedInputs
+	t.lockedInputs = nil
+
+	fromAddr, err := t.genInitiator()
+	if err != nil {
+		return
+	}
+	ak := newAK(t.Keys)
+	keyPair, err := ak.keyPair()
+	if err != nil {
+		return
+	}
+	crypto, err := client.CreateCryptoClient(t.CryptoType)
+	if err != nil {
+		return
+	}
+	req, err := newUtxoLockRelease(t.ChainName, fromAddr, lockedInputs, []KeyPair{keyPair}, crypto)
+	if err != nil {
+		return
+	}
+	_, _ = t.XchainClient.ReleaseUTXOLock(ctx, req)
+}
+
+// waitTx wait until tx is confirmed on trunk if Confirmations is set
+func (t *CommTrans) waitTx(ctx context.Context, txid []byte) error {
+	if t.Confirmations <= 0 {
+		return nil
+	}
+
+	reply, err := waitTx(ctx, t.XchainClient, t.ChainName, txid, t.Confirmations, 0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Tx confirmed in block %x, height: %d, confirmations: %d\n",
+		reply.GetBlockid(), reply.GetHeight(), reply.GetConfirmations())
+	return nil
+}
+
 // GenerateMultisigGenRawTx for multisig gen cmd
 func (t *CommTrans) GenerateMultisigGenRawTx(ctx context.Context) error {
 	tx, err := t.GenerateTx(ctx)
@@ -639,6 +732,7 @@ func (t *CommTrans) GenTxInputsWithMergeUTXO(ctx context.Context) ([]*pb.TxInput
 		}
 		txInputs = append(txInputs, txInput)
 	}
+	t.lockedInputs = append(t.lockedInputs, txInputs...)
 
 	utxoTotal, ok := big.NewInt(0).SetString(utxoOutputs.TotalSelected, 10)
 	if !ok {
@@ -820,7 +914,7 @@ func (t *CommTrans) GenCompleteTxAndPost(ctx context.Context, preExeResp *pb.Pre
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return t.waitTx(ctx, tx.Txid)
 }
 
 func (t *CommTrans) GenRealTx(response *pb.PreExecWithSelectUTXOResponse,
@@ -1173,6 +1267,12 @@ func (t *CommTrans) GenerateTxOutput(to, amount, fee string) ([]*pb.TxOutput, er
 	return txOutputs, nil
 }
 
+// ownsAddress 地址为当前私钥的地址时才能签名锁定和释放utxo
+func (t *CommTrans) ownsAddress(address string) bool {
+	keyAddr, err := readAddress(t.Keys)
+	return err == nil && keyAddr == address
+}
+
 func (t *CommTrans) signLockUtxo(utxo *pb.UtxoInput) (pb.SignatureInfo, error) {
 
 	ak := newAK(t.Keys)
@@ -1186,6 +1286,13 @@ func (t *CommTrans) signLockUtxo(utxo *pb.UtxoInput) (pb.SignatureInfo, error) {
 		return pb.SignatureInfo{}, errors.New("Create crypto client error")
 	}
 
-	lockedUtxoAll := models.NewLockedUtxoAll(utxo.Bcname, utxo.Address)
-	return keyPair.SignUtxo(lockedUtxoAll, crypto)
+	// SelectUTXOBySize不指定数量，按全部utxo签名
+	amount := big.NewInt(0)
+	if utxo.TotalNeed != "" {
+		if _, ok := amount.SetString(utxo.TotalNeed, 10); !ok {
+			return pb.SignatureInfo{}, ErrInvalidAmount
+		}
+	}
+	lockedUtxo := models.NewLockedUtxo(utxo.Bcname, utxo.Address, amount)
+	return keyPair.SignUtxo(lockedUtxo, crypto)
 }
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/xupercore/lib/crypto/client"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/models"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// mockXchainClient 只实现Transfer用到的接口，记录释放utxo锁的请求
+type mockXchainClient struct {
+	pb.XchainClient
+	postErr  error
+	selected *pb.UtxoInput
+	released []*pb.UtxoLockRelease
+}
+
+func (c *mockXchainClient) PreExec(ctx context.Context, in *pb.InvokeRPCRequest,
+	opts ...grpc.CallOption) (*pb.InvokeRPCResponse, error) {
+	return &pb.InvokeRPCResponse{Header: &pb.Header{}, Response: &pb.InvokeResponse{}}, nil
+}
+
+func (c *mockXchainClient) SelectUTXO(ctx context.Context, in *pb.UtxoInput,
+	opts ...grpc.CallOption) (*pb.UtxoOutput, error) {
+	c.selected = in
+	return &pb.UtxoOutput{
+		Header: &pb.Header{},
+		UtxoList: []*pb.Utxo{
+			{RefTxid: []byte{0x01}, RefOffset: 0, ToAddr: []byte(in.Address), Amount: []byte{0x05}},
+			{RefTxid: []byte{0x02}, RefOffset: 1, ToAddr: []byte(in.Address), Amount: []byte{0x05}},
+		},
+		TotalSelected: "10",
+	}, nil
+}
+
+func (c *mockXchainClient) PostTx(ctx context.Context, in *pb.TxStatus,
+	opts ...grpc.CallOption) (*pb.CommonReply, error) {
+	if c.postErr != nil {
+		return nil, c.postErr
+	}
+	return &pb.CommonReply{Header: &pb.Header{}}, nil
+}
+
+func (c *mockXchainClient) ReleaseUTXOLock(ctx context.Context, in *pb.UtxoLockRelease,
+	opts ...grpc.CallOption) (*pb.CommonReply, error) {
+	c.released = append(c.released, in)
+	return &pb.CommonReply{Header: &pb.Header{}}, nil
+}
+
+func TestCommTrans_TransferReleaseUtxoLock(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"
+
+	tests := []struct {
+		name        string
+		postErr     error
+		wantRelease bool
+	}{
+		{
+			name:        "post failed",
+			postErr:     errors.New("post failed"),
+			wantRelease: true,
+		},
+		{
+			name: "posted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xclient := &mockXchainClient{postErr: tt.postErr}
+			ct := &CommTrans{
+				To:           "bob",
+				Amount:       "10",
+				ChainName:    "xuper",
+				Keys:         filepath.Join(validAKDir, "keys"),
+				XchainClient: xclient,
+				CryptoType:   client.CryptoTypeDefault,
+			}
+			err := ct.Transfer(context.Background())
+			if (err != nil) != (tt.postErr != nil) {
+				t.Fatalf("Transfer() error = %v, want %v", err, tt.postErr)
+			}
+			if !xclient.selected.GetNeedLock() {
+				t.Fatal("expect utxo locked when selecting")
+			}
+			if !tt.wantRelease {
+				if len(xclient.released) != 0 {
+					t.Fatalf("expect no release after posted, got %d", len(xclient.released))
+				}
+				return
+			}
+
+			if len(xclient.released) != 1 {
+				t.Fatalf("expect 1 release request, got %d", len(xclient.released))
+			}
+			req := xclient.released[0]
+			if req.Address != address || len(req.UtxoList) != 2 {
+				t.Fatalf("unexpected release request: %v", req)
+			}
+			release := models.NewUtxoRelease("xuper", address, req.Timestamp)
+			for _, item := range req.UtxoList {
+				release.AddUtxo(item.RefTxid, item.RefOffset)
+			}
+			publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(req.Publickey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok, _ := crypto.VerifyECDSA(publicKey, req.UserSign, release.Hash()); !ok {
+				t.Fatal("expect release signed by initiator")
+			}
+		})
+	}
+}
+
+func TestCommTrans_TransferFromOtherAddress(t *testing.T) {
+	xclient := &mockXchainClient{postErr: errors.New("post failed")}
+	ct := &CommTrans{
+		From:         "SmJG3rH2ZzYQ9ojxhbRCPwFiE9y6pD1Co",
+		To:           "bob",
+		Amount:       "10",
+		ChainName:    "xuper",
+		Keys:         filepath.Join(validAKDir, "keys"),
+		XchainClient: xclient,
+		CryptoType:   client.CryptoTypeDefault,
+	}
+	if err := ct.Transfer(context.Background()); err == nil {
+		t.Fatal("expect post error")
+	}
+	// 私钥无法为其他地址签名锁定，按不锁定的方式选择utxo
+	if xclient.selected.GetAddress() != ct.From || xclient.selected.GetNeedLock() ||
+		len(xclient.selected.GetUserSign()) != 0 {
+		t.Fatalf("unexpected select request: %v", xclient.selected)
+	}
+	if len(xclient.released) != 0 {
+		t.Fatalf("expect no release for unlocked utxos, got %d", len(xclient.released))
+	}
+}
+
+func TestCommTrans_GenTxInputsNoLock(t *testing.T) {
+	xclient := &mockXchainClient{}
+	ct := &CommTrans{
+		ChainName:    "xuper",
+		Keys:         filepath.Join(validAKDir, "keys"),
+		XchainClient: xclient,
+		CryptoType:   client.CryptoTypeDefault,
+	}
+	// Transfer以外的调用方不会释放锁，选择时不锁定
+	if _, _, err := ct.GenTxInputs(context.Background(), big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	if xclient.selected.GetNeedLock() || len(ct.lockedInputs) != 0 {
+		t.Fatalf("expect utxo not locked, got %v", xclient.selected)
+	}
+}
@@ -12,10 +12,11 @@ import (
 func NewConsensusCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consensus",
-		Short: "Consensus module: status|invoke.",
+		Short: "Consensus module: status|invoke|validators.",
 	}
 	cmd.AddCommand(NewConsensusInvokeCommand(cli))
 	cmd.AddCommand(NewConsensusStatusCommand(cli))
+	cmd.AddCommand(NewConsensusValidatorsCommand(cli))
 	return cmd
 }
 
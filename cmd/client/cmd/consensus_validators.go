@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// 本文件封装了xpoa候选人查询的client调用接口, 具体格式为:
+// xchain-cli consensus validators 当前及待生效的xpoa候选人
+
+type ConsensusValidatorsCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewConsensusValidatorsCommand new consensus validators cmd
+func NewConsensusValidatorsCommand(cli *Cli) *cobra.Command {
+	c := new(ConsensusValidatorsCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "validators",
+		Short: "get current and pending validators of xpoa consensus",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.getValidators(ctx)
+		},
+	}
+	return c.cmd
+}
+
+func (c *ConsensusValidatorsCommand) getValidators(ctx context.Context) error {
+	client := c.cli.XchainClient()
+	header := &pb.Header{
+		Logid: utils.GenLogId(),
+	}
+	status, err := client.XpoaStatus(ctx, &pb.XpoaStatusRequest{
+		Header: header,
+		Bcname: c.cli.RootOptions.Name,
+	})
+	if err != nil {
+		return err
+	}
+	if status.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(status.Header.Error.String())
+	}
+	reply, err := client.XpoaValidators(ctx, &pb.XpoaValidatorsRequest{
+		Header: header,
+		Bcname: c.cli.RootOptions.Name,
+	})
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	result := struct {
+		Term              int64               `json:"term"`
+		Miner             string              `json:"miner"`
+		Validators        []*pb.XpoaValidator `json:"validators"`
+		PendingValidators []*pb.XpoaValidator `json:"pendingValidators,omitempty"`
+	}{
+		Term:              status.GetTerm(),
+		Miner:             status.GetMiner(),
+		Validators:        reply.GetValidators(),
+		PendingValidators: reply.GetPendingValidators(),
+	}
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
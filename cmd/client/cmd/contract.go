@@ -8,9 +8,13 @@ import (
 func NewContractCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract",
-		Short: "Operate contract command, query",
+		Short: "Operate contract command, query|keys|state|info|events",
 	}
 	cmd.AddCommand(NewContractStatDataQueryCommand(cli))
+	cmd.AddCommand(NewContractKeysCommand(cli))
+	cmd.AddCommand(NewContractStateQueryCommand(cli))
+	cmd.AddCommand(NewContractInfoCommand(cli))
+	cmd.AddCommand(NewContractEventsCommand(cli))
 	return cmd
 }
 
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// ContractEventsCommand query contract events by height range cmd
+type ContractEventsCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	contract  string
+	eventName string
+	initiator string
+	start     int64
+	end       int64
+	skip      int64
+	limit     int64
+	all       bool
+}
+
+// NewContractEventsCommand new a command for ContractEventsCommand
+func NewContractEventsCommand(cli *Cli) *cobra.Command {
+	c := new(ContractEventsCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "events",
+		Short: "query contract events in a block height range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.queryEvents(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ContractEventsCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.contract, "contract", "", "", "contract name regexp")
+	c.cmd.Flags().StringVarP(&c.eventName, "event", "", "", "event name regexp")
+	c.cmd.Flags().StringVarP(&c.initiator, "initiator", "", "", "tx initiator regexp")
+	c.cmd.Flags().Int64VarP(&c.start, "start", "", 0, "start block height, inclusive")
+	c.cmd.Flags().Int64VarP(&c.end, "end", "", -1, "end block height, inclusive. The default value is -1, query to the trunk tip.")
+	c.cmd.Flags().Int64VarP(&c.skip, "skip", "", 0, "skip events of the start block returned by last page")
+	c.cmd.Flags().Int64VarP(&c.limit, "limit", "l", 20, "max events of one page")
+	c.cmd.Flags().BoolVarP(&c.all, "all", "", false, "list all pages")
+}
+
+func (c *ContractEventsCommand) queryEvents(ctx context.Context) error {
+	end := c.end
+	if end < 0 {
+		// 服务端会将超过主干高度的结束高度截断到主干高度
+		end = math.MaxInt64
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.ContractEventsRequest{
+		Bcname:      c.cli.RootOptions.Name,
+		Contract:    c.contract,
+		EventName:   c.eventName,
+		Initiator:   c.initiator,
+		StartHeight: c.start,
+		EndHeight:   end,
+		Skip:        c.skip,
+		Limit:       c.limit,
+	}
+	for {
+		request.Header = &pb.Header{
+			Logid: utils.GenLogId(),
+		}
+		reply, err := client.QueryContractEvents(ctx, request)
+		if err != nil {
+			return err
+		}
+		if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+			return errors.New(reply.Header.Error.String())
+		}
+
+		for _, info := range reply.GetEvents() {
+			event := info.GetEvent()
+			fmt.Printf("%d\t%x\t%s\t%s\t%s\n", info.GetHeight(), info.GetTxid(),
+				event.GetContract(), event.GetName(), printable(event.GetBody()))
+		}
+		if !reply.GetHasMore() {
+			return nil
+		}
+		if !c.all {
+			fmt.Printf("more events, use --start %d --skip %d to continue\n",
+				reply.GetNextHeight(), reply.GetNextSkip())
+			return nil
+		}
+		request.StartHeight = reply.GetNextHeight()
+		request.Skip = reply.GetNextSkip()
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/crypto/hash"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// ContractInfoCommand query contract deploy info cmd
+type ContractInfoCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	output string
+	verify string
+}
+
+// NewContractInfoCommand new a command for ContractInfoCommand
+func NewContractInfoCommand(cli *Cli) *cobra.Command {
+	c := new(ContractInfoCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "info contract_name",
+		Short: "query contract module, code hash, deployer and deploy txs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.queryInfo(ctx, args[0])
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ContractInfoCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.output, "output", "o", "", "save on-chain contract code to the file")
+	c.cmd.Flags().StringVarP(&c.verify, "verify", "", "", "check the contract code file matches on-chain code hash")
+}
+
+func (c *ContractInfoCommand) queryInfo(ctx context.Context, contractName string) error {
+	client := c.cli.XchainClient()
+	request := &pb.ContractInfoRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:       c.cli.RootOptions.Name,
+		ContractName: contractName,
+		NeedCode:     c.output != "",
+	}
+	reply, err := client.GetContractInfo(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	fmt.Printf("contract: %s\n", reply.GetContractName())
+	fmt.Printf("module: %s\n", reply.GetModule())
+	fmt.Printf("runtime: %s\n", reply.GetRuntime())
+	fmt.Printf("code hash: %x\n", reply.GetCodeHash())
+	fmt.Printf("account: %s\n", reply.GetAccount())
+	fmt.Printf("deploy txid: %x\n", reply.GetDeployTxid())
+	if len(reply.GetUpgradeTxid()) > 0 {
+		fmt.Printf("upgrade txid: %x\n", reply.GetUpgradeTxid())
+	}
+	if len(reply.GetAbi()) > 0 {
+		fmt.Printf("abi: %s\n", reply.GetAbi())
+	}
+
+	if c.output != "" {
+		if err := os.WriteFile(c.output, reply.GetCode(), 0644); err != nil {
+			return err
+		}
+		fmt.Printf("code saved to %s\n", c.output)
+	}
+	if c.verify != "" {
+		code, err := os.ReadFile(c.verify)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash.DoubleSha256(code), reply.GetCodeHash()) {
+			return fmt.Errorf("code hash mismatch, file hash %x", hash.DoubleSha256(code))
+		}
+		fmt.Println("code hash verified")
+	}
+	return nil
+}
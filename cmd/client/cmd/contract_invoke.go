@@ -31,6 +31,8 @@ type ContractInvokeCommand struct {
 	amount     string
 	debug      bool
 	abiFile    string
+	wait       int64
+	estimate   bool
 }
 
 // NewContractInvokeCommand new wasm/native/evm invoke cmd
@@ -62,6 +64,8 @@ func (c *ContractInvokeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.methodName, "method", "", "invoke", "contract method name")
 	c.cmd.Flags().StringVarP(&c.amount, "amount", "", "", "the amount transfer to contract")
 	c.cmd.Flags().BoolVarP(&c.debug, "debug", "", false, "debug print tx instead of posting")
+	c.cmd.Flags().Int64VarP(&c.wait, "wait", "", 0, "wait until tx is confirmed with given confirmations, 0 means not wait")
+	c.cmd.Flags().BoolVarP(&c.estimate, "estimate", "", false, "estimate fee of the invoke instead of posting")
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
 	}
@@ -93,6 +97,7 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 		DebugTx:      c.debug,
 		RootOptions:  c.cli.RootOptions,
 	}
+	ct.Confirmations = c.wait
 	// transfer to contract
 	if c.amount != "" {
 		ct.To = ct.ContractName
@@ -115,6 +120,10 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 		}
 	}
 
+	if c.estimate {
+		return c.estimateFee(ctx, ct)
+	}
+
 	if c.isMulti {
 		err = ct.GenerateMultisigGenRawTx(ctx)
 	} else {
@@ -124,6 +133,20 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 	return err
 }
 
+func (c *ContractInvokeCommand) estimateFee(ctx context.Context, ct *CommTrans) error {
+	reply, err := ct.EstimateFee(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("cpu fee: %d\n", reply.GetCpuFee())
+	fmt.Printf("mem fee: %d\n", reply.GetMemFee())
+	fmt.Printf("disk fee: %d\n", reply.GetDiskFee())
+	fmt.Printf("xfee fee: %d\n", reply.GetXfeeFee())
+	fmt.Printf("total fee: %d\n", reply.GetTotalFee())
+	fmt.Printf("suggested fee: %d (+%d%%)\n", reply.GetSuggestedFee(), reply.GetSafetyMargin())
+	return nil
+}
+
 func convertToXuper3Args(args map[string]interface{}) (map[string][]byte, error) {
 	argmap := make(map[string][]byte)
 	for k, v := range args {
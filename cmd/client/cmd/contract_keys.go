@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// ContractKeysCommand list contract state keys cmd
+type ContractKeysCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	prefix     string
+	hexPrefix  bool
+	cursor     string
+	limit      int64
+	height     int64
+	all        bool
+	decodeType string
+}
+
+// NewContractKeysCommand new a command for ContractKeysCommand
+func NewContractKeysCommand(cli *Cli) *cobra.Command {
+	c := new(ContractKeysCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "keys contract_name",
+		Short: "list contract state keys by prefix",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("expect contract name")
+			}
+			ctx := context.TODO()
+			return c.listKeys(ctx, args[0])
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ContractKeysCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.prefix, "prefix", "p", "", "key prefix")
+	c.cmd.Flags().BoolVarP(&c.hexPrefix, "HexPrefix", "", false, "the parameter prefix is hexadecimal")
+	c.cmd.Flags().StringVarP(&c.cursor, "cursor", "", "", "hex cursor returned by last page")
+	c.cmd.Flags().Int64VarP(&c.limit, "limit", "l", 20, "max keys of one page")
+	c.cmd.Flags().Int64VarP(&c.height, "height", "N", -1, "snapshoot query by height. The default value is -1, query the latest value.")
+	c.cmd.Flags().BoolVarP(&c.all, "all", "", false, "list all pages")
+	c.cmd.Flags().StringVarP(&c.decodeType, "decode", "d", "raw", "key and val decode type. [raw|hex]")
+}
+
+func (c *ContractKeysCommand) listKeys(ctx context.Context, contractName string) error {
+	var err error
+	prefix := []byte(c.prefix)
+	if c.hexPrefix {
+		prefix, err = hex.DecodeString(c.prefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	cursor, err := hex.DecodeString(c.cursor)
+	if err != nil {
+		return fmt.Errorf("bad cursor:%s", c.cursor)
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.ListContractKeysRequest{
+		Bcname:       c.cli.RootOptions.Name,
+		ContractName: contractName,
+		Prefix:       prefix,
+		Cursor:       cursor,
+		Limit:        c.limit,
+		UseSnapshot:  c.height >= 0,
+		Height:       c.height,
+	}
+	for {
+		request.Header = &pb.Header{
+			Logid: utils.GenLogId(),
+		}
+		reply, err := client.ListContractKeys(ctx, request)
+		if err != nil {
+			return err
+		}
+		if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+			return errors.New(reply.Header.Error.String())
+		}
+
+		for _, kv := range reply.GetKvs() {
+			fmt.Printf("%s\t%s\n", c.decode(kv.GetKey()), c.decode(kv.GetValue()))
+		}
+		if len(reply.GetNextCursor()) == 0 {
+			return nil
+		}
+		if !c.all {
+			fmt.Printf("next cursor: %x\n", reply.GetNextCursor())
+			return nil
+		}
+		request.Cursor = reply.GetNextCursor()
+	}
+}
+
+func (c *ContractKeysCommand) decode(buf []byte) string {
+	if c.decodeType == "hex" {
+		return hex.EncodeToString(buf)
+	}
+	return string(buf)
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// ContractStateQueryCommand query historical state cmd
+type ContractStateQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	bucket     string
+	key        string
+	hexKey     bool
+	height     int64
+	blockid    string
+	decodeType string
+}
+
+// NewContractStateQueryCommand new a command for ContractStateQueryCommand
+func NewContractStateQueryCommand(cli *Cli) *cobra.Command {
+	c := new(ContractStateQueryCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "state",
+		Short: "query the value of a bucket key as of a trunk block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if c.bucket == "" {
+				return errors.New("expect bucket")
+			}
+			if c.key == "" {
+				return errors.New("expect key")
+			}
+			ctx := context.TODO()
+			return c.queryState(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ContractStateQueryCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.bucket, "bucket", "b", "", "bucket space in kvstore, eg. contract name")
+	c.cmd.Flags().StringVarP(&c.key, "key", "k", "", "key in kvstore")
+	c.cmd.Flags().BoolVarP(&c.hexKey, "HexKey", "", false, "the parameter key is hexadecimal")
+	c.cmd.Flags().Int64VarP(&c.height, "height", "N", 0, "snapshoot query by height")
+	c.cmd.Flags().StringVarP(&c.blockid, "blockid", "", "", "snapshoot query by blockid, takes precedence over height")
+	c.cmd.Flags().StringVarP(&c.decodeType, "decode", "d", "raw", "val decode type. [raw|hex]")
+}
+
+func (c *ContractStateQueryCommand) queryState(ctx context.Context) error {
+	var err error
+	key := []byte(c.key)
+	if c.hexKey {
+		key, err = hex.DecodeString(c.key)
+		if err != nil {
+			return err
+		}
+	}
+	blockid, err := hex.DecodeString(c.blockid)
+	if err != nil {
+		return fmt.Errorf("bad blockid:%s", c.blockid)
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.StateAtHeightRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:  c.cli.RootOptions.Name,
+		Bucket:  c.bucket,
+		Key:     key,
+		Height:  c.height,
+		Blockid: blockid,
+	}
+	reply, err := client.GetStateAtHeight(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	fmt.Printf("blockid: %x\n", reply.GetBlockid())
+	fmt.Printf("height: %d\n", reply.GetHeight())
+	if len(reply.GetValue()) == 0 {
+		fmt.Println("val is nil")
+		return nil
+	}
+	if c.decodeType == "hex" {
+		fmt.Printf("value: %x\n", reply.GetValue())
+	} else {
+		fmt.Printf("value: %s\n", reply.GetValue())
+	}
+	return nil
+}
@@ -6,10 +6,17 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	proposalUtils "github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+	"github.com/xuperchain/xupercore/lib/utils"
 )
 
 // GovernTokenQueryCommand govern token query cmd
@@ -17,18 +24,13 @@ type GovernTokenQueryCommand struct {
 	cli *Cli
 	cmd *cobra.Command
 
-	module     string
-	isMulti    bool
-	verbose    bool
-	multiAddrs string
-	account    string
+	account string
 }
 
 // NewContractQueryCommand new wasm/native/evm query cmd
 func NewGovernTokenQueryCommand(cli *Cli) *cobra.Command {
 	c := new(GovernTokenQueryCommand)
 	c.cli = cli
-	c.module = "xkernel"
 	c.cmd = &cobra.Command{
 		Use:     "query",
 		Short:   "Query account's govern token balance",
@@ -53,32 +55,51 @@ xchain-cli governToken query -a "your account"
 }
 
 func (c *GovernTokenQueryCommand) query(ctx context.Context) error {
+	if c.account == "" {
+		return fmt.Errorf("no account found")
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.GovernTokenBalanceRequest{
+		Header:  &pb.Header{Logid: utils.GenLogId()},
+		Bcname:  c.cli.RootOptions.Name,
+		Account: c.account,
+	}
+	reply, err := client.GovernTokenBalance(ctx, request)
+	if err != nil {
+		// 节点未升级时回退到通过xkernel合约预执行查询
+		if status.Code(err) == codes.Unimplemented {
+			return c.queryByPreExec(ctx)
+		}
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	reply.Header = nil
+	output, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// queryByPreExec 通过预执行xkernel合约查询，兼容不支持查询接口的节点
+func (c *GovernTokenQueryCommand) queryByPreExec(ctx context.Context) error {
 	ct := &CommTrans{
 		ModuleName:   "xkernel",
-		ContractName: utils.GovernTokenKernelContract,
+		ContractName: proposalUtils.GovernTokenKernelContract,
 		MethodName:   "Query",
 		Args:         make(map[string][]byte),
-		IsQuick:      c.isMulti,
 		Keys:         c.cli.RootOptions.Keys,
-		MultiAddrs:   c.multiAddrs,
 
 		ChainName:    c.cli.RootOptions.Name,
 		XchainClient: c.cli.XchainClient(),
 	}
-
-	if c.account == "" {
-		return fmt.Errorf("no account found")
-	}
 	ct.Args["account"] = []byte(c.account)
 
-	response, _, err := ct.GenPreExeRes(ctx)
-	if c.verbose {
-		for _, req := range response.GetResponse().GetRequests() {
-			limits := req.GetResourceLimits()
-			for _, limit := range limits {
-				fmt.Println(limit.Type.String(), ": ", limit.Limit)
-			}
-		}
-	}
+	_, _, err := ct.GenPreExeRes(ctx)
 	return err
 }
@@ -30,13 +30,14 @@ type PartialSign struct {
 func NewMultisigCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "multisig",
-		Short: "Operate a command with multisign: check|gen|send|sign|get.",
+		Short: "Operate a command with multisign: check|gen|send|sign|get|simulate.",
 	}
 	cmd.AddCommand(NewMultisigGenCommand(cli))
 	cmd.AddCommand(NewGetComplianceCheckSignCommand(cli))
 	cmd.AddCommand(NewMultisigCheckCommand(cli))
 	cmd.AddCommand(NewMultisigSignCommand(cli))
 	cmd.AddCommand(NewMultisigSendCommand(cli))
+	cmd.AddCommand(NewMultisigSimulateCommand(cli))
 	return cmd
 }
 
@@ -44,7 +44,8 @@ func NewGetComplianceCheckSignCommand(cli *Cli) *cobra.Command {
 
 func (c *GetComplianceCheckSignCommand) initXEndorserClient() error {
 	//nolint:staticcheck
-	conn, err := grpc.Dial(c.cli.RootOptions.Host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
+	options := []grpc.DialOption{grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20 - 1)}
+	conn, err := grpc.Dial(c.cli.RootOptions.Host, c.cli.withToken(options)...)
 	if err != nil {
 		return err
 	}
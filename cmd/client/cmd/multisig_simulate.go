@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// MultisigSimulateCommand multisig simulate struct
+type MultisigSimulateCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	input string
+}
+
+// NewMultisigSimulateCommand multisig simulate init method
+func NewMultisigSimulateCommand(cli *Cli) *cobra.Command {
+	c := new(MultisigSimulateCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate the raw transaction and show what it will change before signing.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.simulate(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *MultisigSimulateCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.input, "input", "i", "./tx.out", "Serialized transaction data file.")
+}
+
+func (c *MultisigSimulateCommand) simulate(ctx context.Context) error {
+	data, err := os.ReadFile(c.input)
+	if err != nil {
+		return err
+	}
+	tx := &pb.Transaction{}
+	err = proto.Unmarshal(data, tx)
+	if err != nil {
+		return err
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.SimulateTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: c.cli.RootOptions.Name,
+		Tx:     tx,
+	}
+	reply, err := client.SimulateTx(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	fmt.Println("balance changes:")
+	for _, change := range reply.GetBalanceChanges() {
+		fmt.Printf("  %s: %s -> %s (%s)\n", change.GetAddress(), change.GetBalance(),
+			change.GetNewBalance(), change.GetDelta())
+	}
+	if reply.GetFee() != "" {
+		fmt.Printf("fee: %s\n", reply.GetFee())
+	}
+	if len(reply.GetKeyChanges()) > 0 {
+		fmt.Println("contract key changes:")
+	}
+	for _, change := range reply.GetKeyChanges() {
+		if change.GetDeleted() {
+			fmt.Printf("  %s/%s: %s -> (deleted)\n", change.GetBucket(),
+				printable(change.GetKey()), printable(change.GetOldValue()))
+			continue
+		}
+		fmt.Printf("  %s/%s: %s -> %s\n", change.GetBucket(), printable(change.GetKey()),
+			printable(change.GetOldValue()), printable(change.GetNewValue()))
+	}
+	if len(reply.GetEvents()) > 0 {
+		fmt.Println("events:")
+	}
+	for _, event := range reply.GetEvents() {
+		fmt.Printf("  %s.%s: %s\n", event.GetContract(), event.GetName(), printable(event.GetBody()))
+	}
+	for _, res := range reply.GetResponses() {
+		fmt.Printf("contract response: %d %s\n", res.GetStatus(), printable(res.GetBody()))
+	}
+	fmt.Printf("gas used: %d\n", reply.GetGasUsed())
+	return nil
+}
+
+// printable 可见字符原样输出，否则按十六进制输出
+func printable(buf []byte) string {
+	for _, b := range buf {
+		if b < 0x20 || b > 0x7e {
+			return "0x" + hex.EncodeToString(buf)
+		}
+	}
+	return string(buf)
+}
@@ -6,10 +6,17 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	proposalUtils "github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+	"github.com/xuperchain/xupercore/lib/utils"
 )
 
 // ProposalQueryCommand proposal query cmd
@@ -17,10 +24,6 @@ type ProposalQueryCommand struct {
 	cli *Cli
 	cmd *cobra.Command
 
-	module     string
-	isMulti    bool
-	verbose    bool
-	multiAddrs string
 	proposalID string
 }
 
@@ -28,7 +31,6 @@ type ProposalQueryCommand struct {
 func NewProposalQueryCommand(cli *Cli) *cobra.Command {
 	c := new(ProposalQueryCommand)
 	c.cli = cli
-	c.module = "xkernel"
 	c.cmd = &cobra.Command{
 		Use:     "query",
 		Short:   "Query a proposal",
@@ -53,32 +55,50 @@ xchain-cli proposal query -p "your proposal id"
 }
 
 func (c *ProposalQueryCommand) query(ctx context.Context) error {
+	if c.proposalID == "" {
+		return fmt.Errorf("no proposal id found")
+	}
+
+	client := c.cli.XchainClient()
+	request := &pb.ProposalQueryRequest{
+		Header:     &pb.Header{Logid: utils.GenLogId()},
+		Bcname:     c.cli.RootOptions.Name,
+		ProposalId: c.proposalID,
+	}
+	reply, err := client.ProposalQuery(ctx, request)
+	if err != nil {
+		// 节点未升级时回退到通过xkernel合约预执行查询
+		if status.Code(err) == codes.Unimplemented {
+			return c.queryByPreExec(ctx)
+		}
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	output, err := json.MarshalIndent(reply.GetProposal(), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// queryByPreExec 通过预执行xkernel合约查询，兼容不支持查询接口的节点
+func (c *ProposalQueryCommand) queryByPreExec(ctx context.Context) error {
 	ct := &CommTrans{
 		ModuleName:   "xkernel",
-		ContractName: utils.ProposalKernelContract,
+		ContractName: proposalUtils.ProposalKernelContract,
 		MethodName:   "Query",
 		Args:         make(map[string][]byte),
-		IsQuick:      c.isMulti,
 		Keys:         c.cli.RootOptions.Keys,
-		MultiAddrs:   c.multiAddrs,
 
 		ChainName:    c.cli.RootOptions.Name,
 		XchainClient: c.cli.XchainClient(),
 	}
-
-	if c.proposalID == "" {
-		return fmt.Errorf("no proposal id found")
-	}
 	ct.Args["proposal_id"] = []byte(c.proposalID)
 
-	response, _, err := ct.GenPreExeRes(ctx)
-	if c.verbose {
-		for _, req := range response.GetResponse().GetRequests() {
-			limits := req.GetResourceLimits()
-			for _, limit := range limits {
-				fmt.Println(limit.Type.String(), ": ", limit.Limit)
-			}
-		}
-	}
+	_, _, err := ct.GenPreExeRes(ctx)
 	return err
 }
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// StatsCommand chain stats cmd
+type StatsCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	window int64
+	topN   int64
+}
+
+// NewStatsCommand new chain stats cmd
+func NewStatsCommand(cli *Cli) *cobra.Command {
+	s := new(StatsCommand)
+	s.cli = cli
+	s.cmd = &cobra.Command{
+		Use:   "stats [OPTIONS]",
+		Short: "Get throughput and block statistics over recent blocks.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return s.printChainStats(ctx)
+		},
+	}
+	s.addFlags()
+	return s.cmd
+}
+
+func (s *StatsCommand) addFlags() {
+	s.cmd.Flags().Int64VarP(&s.window, "window", "w", 50, "number of recent blocks to compute, max 100")
+	s.cmd.Flags().Int64VarP(&s.topN, "top", "t", 10, "number of most invoked contracts to show")
+}
+
+func (s *StatsCommand) printChainStats(ctx context.Context) error {
+	client := s.cli.XchainClient()
+	req := &pb.ChainStatsRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: s.cli.RootOptions.Name,
+		Window: s.window,
+		TopN:   s.topN,
+	}
+	reply, err := client.GetChainStats(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+	reply.Header = nil
+	output, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func init() {
+	AddCommand(NewStatsCommand)
+}
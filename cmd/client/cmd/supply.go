@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// SupplyCommand token supply cmd
+type SupplyCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	topN int64
+}
+
+// NewSupplyCommand new token supply cmd
+func NewSupplyCommand(cli *Cli) *cobra.Command {
+	s := new(SupplyCommand)
+	s.cli = cli
+	s.cmd = &cobra.Command{
+		Use:   "supply [OPTIONS]",
+		Short: "Get total, frozen and circulating native token and top holders.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return s.printTokenSupply(ctx)
+		},
+	}
+	s.addFlags()
+	return s.cmd
+}
+
+func (s *SupplyCommand) addFlags() {
+	s.cmd.Flags().Int64VarP(&s.topN, "top", "t", 20, "number of top holders to show, max 100")
+}
+
+func (s *SupplyCommand) printTokenSupply(ctx context.Context) error {
+	client := s.cli.XchainClient()
+	req := &pb.TokenSupplyRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: s.cli.RootOptions.Name,
+		TopN:   s.topN,
+	}
+	reply, err := client.GetTokenSupply(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+	reply.Header = nil
+	output, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func init() {
+	AddCommand(NewSupplyCommand)
+}
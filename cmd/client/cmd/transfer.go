@@ -7,6 +7,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -61,6 +62,7 @@ type TransferCommand struct {
 	from        string
 	accountPath string
 	debug       bool
+	wait        int64
 }
 
 // NewTransferCommand new transfer cmd
@@ -89,6 +91,7 @@ func (t *TransferCommand) addFlags() {
 	t.cmd.Flags().StringVar(&t.from, "from", "", "account name")
 	t.cmd.Flags().StringVar(&t.accountPath, "accountPath", "", "key path of account")
 	t.cmd.Flags().BoolVar(&t.debug, "debug", false, "debug print tx instead of posting")
+	t.cmd.Flags().Int64VarP(&t.wait, "wait", "", 0, "wait until tx is confirmed with given confirmations, 0 means not wait")
 }
 
 func readKeys(file string) (string, error) {
@@ -158,6 +161,20 @@ func (t *TransferCommand) transfer(ctx context.Context) error {
 		return err
 	}
 	fmt.Printf("%s\n", txid)
+	if t.wait <= 0 || t.debug {
+		return nil
+	}
+
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return err
+	}
+	reply, err := waitTx(ctx, t.cli.XchainClient(), opt.BlockchainName, rawTxid, t.wait, 0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Tx confirmed in block %x, height: %d, confirmations: %d\n",
+		reply.GetBlockid(), reply.GetHeight(), reply.GetConfirmations())
 	return nil
 }
 
@@ -12,9 +12,13 @@ import (
 func NewTxCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx",
-		Short: "Operate tx command, query",
+		Short: "Operate tx command, query|wait|pending|proof|verify-proof",
 	}
 	cmd.AddCommand(NewTxQueryCommand(cli))
+	cmd.AddCommand(NewTxWaitCommand(cli))
+	cmd.AddCommand(NewTxPendingCommand(cli))
+	cmd.AddCommand(NewTxProofCommand(cli))
+	cmd.AddCommand(NewTxVerifyProofCommand(cli))
 	return cmd
 }
 
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// TxPendingCommand tx pending cmd
+type TxPendingCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	initiator   string
+	contract    string
+	offset      int64
+	limit       int64
+	needContent bool
+}
+
+// NewTxPendingCommand new tx pending cmd
+func NewTxPendingCommand(cli *Cli) *cobra.Command {
+	t := new(TxPendingCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "pending [txid]",
+		Short: "list unconfirmed transactions in mempool, or show one of them by txid",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			if len(args) > 0 {
+				return t.getPendingTx(ctx, args[0])
+			}
+			return t.listPendingTxs(ctx)
+		},
+	}
+	t.addFlags()
+	return t.cmd
+}
+
+func (t *TxPendingCommand) addFlags() {
+	t.cmd.Flags().StringVarP(&t.initiator, "initiator", "", "", "only list txs of the initiator")
+	t.cmd.Flags().StringVarP(&t.contract, "contract", "", "", "only list txs invoking the contract")
+	t.cmd.Flags().Int64VarP(&t.offset, "offset", "", 0, "offset of the first tx")
+	t.cmd.Flags().Int64VarP(&t.limit, "limit", "l", 20, "max txs to list")
+	t.cmd.Flags().BoolVarP(&t.needContent, "content", "", false, "print tx content")
+}
+
+func (t *TxPendingCommand) listPendingTxs(ctx context.Context) error {
+	client := t.cli.XchainClient()
+	request := &pb.PendingTxsRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:      t.cli.RootOptions.Name,
+		Initiator:   t.initiator,
+		Contract:    t.contract,
+		Offset:      t.offset,
+		Limit:       t.limit,
+		NeedContent: t.needContent,
+	}
+	reply, err := client.ListPendingTxs(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	fmt.Printf("pool size: %d, matched: %d\n", reply.GetPoolSize(), reply.GetMatched())
+	for _, tx := range reply.GetTxs() {
+		t.printPendingTx(tx)
+	}
+	return nil
+}
+
+func (t *TxPendingCommand) getPendingTx(ctx context.Context, txid string) error {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+
+	client := t.cli.XchainClient()
+	request := &pb.PendingTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:      t.cli.RootOptions.Name,
+		Txid:        rawTxid,
+		NeedContent: t.needContent,
+	}
+	reply, err := client.GetPendingTx(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+	t.printPendingTx(reply.GetTx())
+	return nil
+}
+
+func (t *TxPendingCommand) printPendingTx(tx *pb.PendingTx) {
+	age := time.Duration(tx.GetAge()) * time.Millisecond
+	fmt.Printf("%x\tinitiator:%s\tage:%s\tstate:%s", tx.GetTxid(), tx.GetInitiator(),
+		age, strings.TrimPrefix(tx.GetState().String(), "PENDING_"))
+	if len(tx.GetContracts()) > 0 {
+		fmt.Printf("\tcontracts:%s", strings.Join(tx.GetContracts(), ","))
+	}
+	fmt.Println()
+	for _, parent := range tx.GetParents() {
+		fmt.Printf("  parent: %x\n", parent)
+	}
+	if tx.GetTx() != nil {
+		output, err := json.MarshalIndent(FromPBTx(tx.GetTx()), "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(output))
+	}
+}
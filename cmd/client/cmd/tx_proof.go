@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// TxProofCommand tx proof cmd
+type TxProofCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	output string
+}
+
+// NewTxProofCommand new tx proof cmd
+func NewTxProofCommand(cli *Cli) *cobra.Command {
+	t := new(TxProofCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "proof txid",
+		Short: "get merkle proof of a confirmed transaction",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("expect txid")
+			}
+			ctx := context.TODO()
+			return t.getProof(ctx, args[0])
+		},
+	}
+	t.addFlags()
+	return t.cmd
+}
+
+func (t *TxProofCommand) addFlags() {
+	t.cmd.Flags().StringVarP(&t.output, "output", "o", "", "write proof to file, which can be checked by verify-proof")
+}
+
+func (t *TxProofCommand) getProof(ctx context.Context, txid string) error {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+
+	client := t.cli.XchainClient()
+	request := &pb.TxProofRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: t.cli.RootOptions.Name,
+		Txid:   rawTxid,
+	}
+	reply, err := client.GetTxProof(ctx, request)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	// 证明文件只保留校验需要的内容
+	reply.Header = nil
+	output, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		return err
+	}
+	if t.output != "" {
+		return os.WriteFile(t.output, output, 0644)
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// TxVerifyProofCommand tx verify-proof cmd
+type TxVerifyProofCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewTxVerifyProofCommand new tx verify-proof cmd
+func NewTxVerifyProofCommand(cli *Cli) *cobra.Command {
+	t := new(TxVerifyProofCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "verify-proof proof_file",
+		Short: "verify merkle proof of a transaction offline",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("expect proof file")
+			}
+			return t.verifyProof(args[0])
+		},
+	}
+	return t.cmd
+}
+
+func (t *TxVerifyProofCommand) verifyProof(file string) error {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	proof := &pb.TxProofResponse{}
+	if err := json.Unmarshal(buf, proof); err != nil {
+		return fmt.Errorf("bad proof file:%v", err)
+	}
+	header := proof.GetBlockHeader()
+	if header == nil {
+		return errors.New("block header missing in proof")
+	}
+
+	// 交易沿merkle路径计算得到区块头中的merkle根
+	err = common.VerifyMerklePath(proof.GetTxid(), proof.GetTxIndex(), int64(header.GetTxCount()),
+		proof.GetMerklePath(), header.GetMerkleRoot())
+	if err != nil {
+		return fmt.Errorf("verify merkle path failed:%v", err)
+	}
+
+	// 区块头字段(含merkle根)计算得到的区块id与声明的一致
+	blockid, err := ledger.MakeBlockID(common.BlockToXledger(header))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockid, header.GetBlockid()) {
+		return fmt.Errorf("blockid mismatch, expect %x got %x", header.GetBlockid(), blockid)
+	}
+
+	fmt.Printf("txid: %x\n", proof.GetTxid())
+	fmt.Printf("blockid: %x\n", header.GetBlockid())
+	fmt.Printf("height: %d\n", header.GetHeight())
+	fmt.Println("verify proof succeed")
+	return nil
+}
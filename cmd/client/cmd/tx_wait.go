@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// TxWaitCommand tx wait cmd
+type TxWaitCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	confirmations int64
+	timeout       time.Duration
+}
+
+// NewTxWaitCommand new tx wait cmd
+func NewTxWaitCommand(cli *Cli) *cobra.Command {
+	t := new(TxWaitCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "wait txid",
+		Short: "wait until transaction is confirmed on trunk",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("expect txid")
+			}
+			ctx := context.TODO()
+			return t.waitTx(ctx, args[0])
+		},
+	}
+	t.addFlags()
+	return t.cmd
+}
+
+func (t *TxWaitCommand) addFlags() {
+	t.cmd.Flags().Int64VarP(&t.confirmations, "confirmations", "c", 1, "number of confirmations to wait, including the block of tx")
+	t.cmd.Flags().DurationVarP(&t.timeout, "timeout", "t", 30*time.Second, "max time to wait")
+}
+
+func (t *TxWaitCommand) waitTx(ctx context.Context, txid string) error {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+
+	reply, err := waitTx(ctx, t.cli.XchainClient(), t.cli.RootOptions.Name,
+		rawTxid, t.confirmations, t.timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("blockid: %x\n", reply.GetBlockid())
+	fmt.Printf("height: %d\n", reply.GetHeight())
+	fmt.Printf("confirmations: %d\n", reply.GetConfirmations())
+	return nil
+}
+
+// waitTx 调用WaitTx接口等待交易上链，timeout为0时使用服务端默认等待时间
+func waitTx(ctx context.Context, client pb.XchainClient, bcname string, txid []byte,
+	confirmations int64, timeout time.Duration) (*pb.WaitTxResponse, error) {
+	req := &pb.WaitTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:        bcname,
+		Txid:          txid,
+		Confirmations: confirmations,
+		Timeout:       timeout.Milliseconds(),
+	}
+	reply, err := client.WaitTx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if reply.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return nil, fmt.Errorf("wait tx failed:%s, status:%s, confirmations:%d, logid:%s",
+			reply.GetHeader().GetError(), reply.GetStatus(), reply.GetConfirmations(),
+			reply.GetHeader().GetLogid())
+	}
+	return reply, nil
+}
@@ -78,6 +78,8 @@ func (c *MergeUtxoCommand) mergeUtxo(_ context.Context) error {
 		CryptoType:   c.cli.RootOptions.Crypto,
 	}
 
+	// 交易未提交时提前释放锁定的utxo
+	defer ct.releaseUtxoLock(context.Background())
 	txInputs, txOutput, err := ct.GenTxInputsWithMergeUTXO(context.Background())
 	if err != nil {
 		return err
@@ -1,18 +1,50 @@
 package models
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
 	"math/big"
+	"sort"
+	"time"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	xCtx "github.com/xuperchain/xupercore/kernel/common/xcontext"
+	"github.com/xuperchain/xupercore/kernel/consensus"
+	"github.com/xuperchain/xupercore/kernel/contract/bridge"
+	"github.com/xuperchain/xupercore/kernel/contract/sandbox"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos/reader"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	kledger "github.com/xuperchain/xupercore/kernel/ledger"
 	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/protos"
 
 	sCtx "github.com/xuperchain/xuperchain/service/context"
+	"github.com/xuperchain/xuperchain/service/tracing"
+)
+
+// 释放utxo锁的签名有效期，允许客户端与节点间一定的时钟偏差
+const releaseSignExpire = 5 * time.Minute
+
+// 遍历合约存储时bucket上界的长度
+const maxContractKeyLen = 1024
+
+// 合约代码、描述和abi所在的bucket，key为合约名加.code、.desc、.abi后缀
+const contractBucket = "contract"
+
+// XPoA共识名及其内核合约保存候选人的key后缀，开启BFT时为xpoa，否则为poa
+const (
+	xpoaConsensus    = "xpoa"
+	poaConsensus     = "poa"
+	xpoaValidatesKey = "validates"
 )
 
 type ChainHandle struct {
@@ -41,21 +73,48 @@ func NewChainHandle(bcName string, reqCtx sCtx.ReqCtx) (*ChainHandle, error) {
 	return obj, nil
 }
 
-func (h *ChainHandle) SubmitTx(tx *lpb.Transaction) error {
+func (h *ChainHandle) SubmitTx(tx *lpb.Transaction) (err error) {
+	defer h.endSpan(h.startSpan("SubmitTx"), &err)
 	return h.chain.SubmitTx(h.ctx(), tx)
 }
 
 func (h *ChainHandle) PreExec(req []*protos.InvokeRequest,
-	initiator string, authRequires []string) (*protos.InvokeResponse, error) {
+	initiator string, authRequires []string) (_ *protos.InvokeResponse, err error) {
+	defer h.endSpan(h.startSpan("PreExec"), &err)
 	return h.chain.PreExec(h.ctx(), req, initiator, authRequires)
 }
 
-func (h *ChainHandle) QueryTx(txId []byte) (*xpb.TxInfo, error) {
+// GetPendingTxs 按打包顺序返回mempool中可打包的未确认交易，不含孤儿交易
+func (h *ChainHandle) GetPendingTxs() (_ []*lpb.Transaction, err error) {
+	defer h.endSpan(h.startSpan("GetPendingTxs"), &err)
+	return h.chain.Context().State.GetUnconfirmedTx(false, 0)
+}
+
+// GetPendingTx 从mempool中查询未确认交易，包括孤儿交易
+func (h *ChainHandle) GetPendingTx(txid []byte) (*lpb.Transaction, bool) {
+	defer h.endSpan(h.startSpan("GetPendingTx"), nil)
+	return h.chain.Context().State.GetUnconfirmedTxFromId(txid)
+}
+
+func (h *ChainHandle) IsTxInTrunk(txid []byte) bool {
+	defer h.endSpan(h.startSpan("IsTxInTrunk"), nil)
+	return h.chain.Context().Ledger.IsTxInTrunk(txid)
+}
+
+// GetGasPrice 当前生效的gas价格，未通过提案修改时取创世配置
+func (h *ChainHandle) GetGasPrice() *protos.GasPrice {
+	defer h.endSpan(h.startSpan("GetGasPrice"), nil)
+	return h.chain.Context().State.GetMeta().GetGasPrice()
+}
+
+func (h *ChainHandle) QueryTx(txId []byte) (_ *xpb.TxInfo, err error) {
+	defer h.endSpan(h.startSpan("QueryTx"), &err)
 	return h.ledgerReader().QueryTx(txId)
 }
 
 func (h *ChainHandle) SelectUtxo(account string, need *big.Int, isLock, isExclude bool,
-	pubKey string, sign []byte) (*lpb.UtxoOutput, error) {
+	pubKey string, sign []byte) (_ *lpb.UtxoOutput, err error) {
+	defer h.endSpan(h.startSpan("SelectUtxo"), &err)
 	// 如果需要临时锁定utxo，需要校验权限
 	ok := h.checkSelectUtxoSign(account, pubKey, sign, isLock, need)
 	if !ok {
@@ -68,7 +127,8 @@ func (h *ChainHandle) SelectUtxo(account string, need *big.Int, isLock, isExclud
 }
 
 func (h *ChainHandle) SelectUTXOBySize(account string, isLock, isExclude bool,
-	pubKey string, sign []byte) (*lpb.UtxoOutput, error) {
+	pubKey string, sign []byte) (_ *lpb.UtxoOutput, err error) {
+	defer h.endSpan(h.startSpan("SelectUTXOBySize"), &err)
 	// 如果需要临时锁定utxo，需要校验权限
 	ok := h.checkSelectUtxoSign(account, pubKey, sign, isLock, big.NewInt(0))
 	if !ok {
@@ -79,62 +139,412 @@ func (h *ChainHandle) SelectUTXOBySize(account string, isLock, isExclude bool,
 	return h.utxoReader().SelectUTXOBySize(account, isLock, isExclude)
 }
 
-func (h *ChainHandle) QueryContractStatData() (*protos.ContractStatData, error) {
+// ReleaseUtxoLock 提前释放SelectUtxo/SelectUTXOBySize临时锁定的utxo，未锁定的utxo忽略。
+// 签名覆盖utxo列表和签名时间，普通地址校验地址本身的签名，合约账户按账户ACL校验AK签名
+func (h *ChainHandle) ReleaseUtxoLock(account string, utxoList []*lpb.Utxo, timestamp int64,
+	signs []*protos.SignatureInfo) (err error) {
+	defer h.endSpan(h.startSpan("ReleaseUtxoLock"), &err)
+	if !h.checkReleaseUtxoSign(account, utxoList, timestamp, signs) {
+		h.reqCtx.GetLog().Warn("release utxo lock verify sign failed", "account", account, "timestamp", timestamp)
+		return common.ErrUnauthorized
+	}
+
+	utxoVM, ok := h.chain.Context().State.CreateUtxoReader().(*utxo.UtxoVM)
+	if !ok {
+		h.reqCtx.GetLog().Warn("unexpected utxo reader type")
+		return common.ErrInternal
+	}
+	for _, item := range utxoList {
+		utxoKey := utxo.GenUtxoKeyWithPrefix([]byte(account), item.GetRefTxid(), item.GetRefOffset())
+		utxoVM.UnlockKey([]byte(utxoKey))
+	}
+	return nil
+}
+
+// TokenHolder 地址持有的原生代币，Balance包含冻结部分
+type TokenHolder struct {
+	Address string
+	Balance *big.Int
+	Frozen  *big.Int
+}
+
+// ListTokenHolders 遍历utxo表汇总各地址持有的原生代币，按余额降序返回；
+// 冻结高度大于height或为-1的utxo计为冻结，与GetFrozenBalance一致。
+// 需要遍历整个utxo表，调用方应按高度缓存结果
+func (h *ChainHandle) ListTokenHolders(height int64) (_ []*TokenHolder, err error) {
+	defer h.endSpan(h.startSpan("ListTokenHolders"), &err)
+	utxoVM, ok := h.chain.Context().State.CreateUtxoReader().(*utxo.UtxoVM)
+	if !ok {
+		h.reqCtx.GetLog().Warn("unexpected utxo reader type")
+		return nil, common.ErrInternal
+	}
+
+	holders := make(map[string]*TokenHolder)
+	iter := utxoVM.ScanWithPrefix([]byte(lpb.UTXOTablePrefix))
+	defer iter.Release()
+	for iter.Next() {
+		address, ok := utxoKeyAddress(iter.Key())
+		if !ok {
+			continue
+		}
+		item := &utxo.UtxoItem{}
+		if err := item.Loads(iter.Value()); err != nil {
+			return nil, common.ErrInternal.More("%v", err)
+		}
+		holder, ok := holders[address]
+		if !ok {
+			holder = &TokenHolder{Address: address, Balance: big.NewInt(0), Frozen: big.NewInt(0)}
+			holders[address] = holder
+		}
+		holder.Balance.Add(holder.Balance, item.Amount)
+		if item.FrozenHeight > height || item.FrozenHeight == -1 {
+			holder.Frozen.Add(holder.Frozen, item.Amount)
+		}
+	}
+	if iter.Error() != nil {
+		return nil, common.ErrInternal.More("%v", iter.Error())
+	}
+
+	result := make([]*TokenHolder, 0, len(holders))
+	for _, holder := range holders {
+		result = append(result, holder)
+	}
+	sortTokenHolders(result)
+	return result, nil
+}
+
+func (h *ChainHandle) QueryContractStatData() (_ *protos.ContractStatData, err error) {
+	defer h.endSpan(h.startSpan("QueryContractStatData"), &err)
 	return h.contractReader().QueryContractStatData()
 }
 
-func (h *ChainHandle) QueryUtxoRecord(account string, count int64) (*lpb.UtxoRecordDetail, error) {
+func (h *ChainHandle) QueryUtxoRecord(account string, count int64) (_ *lpb.UtxoRecordDetail, err error) {
+	defer h.endSpan(h.startSpan("QueryUtxoRecord"), &err)
 	return h.utxoReader().QueryUtxoRecord(account, count)
 }
 
-func (h *ChainHandle) QueryAccountACL(account string) (*protos.Acl, error) {
+func (h *ChainHandle) QueryAccountACL(account string) (_ *protos.Acl, err error) {
+	defer h.endSpan(h.startSpan("QueryAccountACL"), &err)
 	return h.contractReader().QueryAccountACL(account)
 }
 
-func (h *ChainHandle) QueryContractMethodACL(contract, method string) (*protos.Acl, error) {
+func (h *ChainHandle) QueryContractMethodACL(contract, method string) (_ *protos.Acl, err error) {
+	defer h.endSpan(h.startSpan("QueryContractMethodACL"), &err)
 	return h.contractReader().QueryContractMethodACL(contract, method)
 }
 
-func (h *ChainHandle) GetAccountContracts(account string) ([]*protos.ContractStatus, error) {
+func (h *ChainHandle) GetAccountContracts(account string) (_ []*protos.ContractStatus, err error) {
+	defer h.endSpan(h.startSpan("GetAccountContracts"), &err)
 	return h.contractReader().GetAccountContracts(account)
 }
 
-func (h *ChainHandle) GetBalance(account string) (string, error) {
+func (h *ChainHandle) GetBalance(account string) (_ string, err error) {
+	defer h.endSpan(h.startSpan("GetBalance"), &err)
 	return h.utxoReader().GetBalance(account)
 }
 
-func (h *ChainHandle) GetFrozenBalance(account string) (string, error) {
+func (h *ChainHandle) GetFrozenBalance(account string) (_ string, err error) {
+	defer h.endSpan(h.startSpan("GetFrozenBalance"), &err)
 	return h.utxoReader().GetFrozenBalance(account)
 }
 
-func (h *ChainHandle) GetBalanceDetail(account string) ([]*lpb.BalanceDetailInfo, error) {
+func (h *ChainHandle) GetBalanceDetail(account string) (_ []*lpb.BalanceDetailInfo, err error) {
+	defer h.endSpan(h.startSpan("GetBalanceDetail"), &err)
 	return h.utxoReader().GetBalanceDetail(account)
 }
 
-func (h *ChainHandle) QueryBlock(blkId []byte, needContent bool) (*xpb.BlockInfo, error) {
+func (h *ChainHandle) QueryBlock(blkId []byte, needContent bool) (_ *xpb.BlockInfo, err error) {
+	defer h.endSpan(h.startSpan("QueryBlock"), &err)
 	return h.ledgerReader().QueryBlock(blkId, needContent)
 }
 
-func (h *ChainHandle) QueryChainStatus() (*xpb.ChainStatus, error) {
+func (h *ChainHandle) QueryChainStatus() (_ *xpb.ChainStatus, err error) {
+	defer h.endSpan(h.startSpan("QueryChainStatus"), &err)
 	return h.chainReader().GetChainStatus()
 }
 
-func (h *ChainHandle) QueryConsensusStatus() (*xpb.ConsensusStatus, error) {
+func (h *ChainHandle) QueryConsensusStatus() (_ *xpb.ConsensusStatus, err error) {
+	defer h.endSpan(h.startSpan("QueryConsensusStatus"), &err)
 	return h.chainReader().GetConsensusStatus()
 }
 
-func (h *ChainHandle) IsTrunkTipBlock(blockId []byte) (bool, error) {
+// XpoaStatus XPoA/PoA共识的当前状态
+type XpoaStatus struct {
+	ConsensusName string
+	Version       int64
+	StartHeight   int64
+	Term          int64
+	Miner         string
+	// 当前生效的候选人
+	Validators []string
+	// 合约中已写入但尚未生效的候选人，没有变更时为空
+	PendingValidators []string
+}
+
+// QueryXpoaStatus 查询XPoA/PoA共识状态，当前共识不是XPoA/PoA时返回ErrForbidden。
+// 候选人变更在包含变更交易的区块之后3个区块生效，生效前合约存储中的候选人即为待生效候选人
+func (h *ChainHandle) QueryXpoaStatus() (_ *XpoaStatus, err error) {
+	defer h.endSpan(h.startSpan("QueryXpoaStatus"), &err)
+	status, err := h.chain.Context().Consensus.GetConsensusStatus()
+	if err != nil {
+		return nil, common.ErrConsensusStatus.More("%v", err)
+	}
+	return xpoaStatus(status, h.chain.Context().State.CreateXMReader())
+}
+
+// xpoaStatus 根据共识状态和合约存储中的候选人生成XPoA/PoA共识状态
+func xpoaStatus(status consensus.ConsensusStatus, reader kledger.XMReader) (*XpoaStatus, error) {
+	name := status.GetConsensusName()
+	if name != xpoaConsensus && name != poaConsensus {
+		return nil, common.ErrForbidden.More("current consensus is %s", name)
+	}
+
+	info := &struct {
+		Validators []string `json:"validators"`
+		Miner      string   `json:"miner"`
+	}{}
+	if err := json.Unmarshal(status.GetCurrentValidatorsInfo(), info); err != nil {
+		return nil, common.ErrInternal.More("%v", err)
+	}
+	result := &XpoaStatus{
+		ConsensusName: name,
+		Version:       status.GetVersion(),
+		StartHeight:   status.GetConsensusBeginInfo(),
+		Term:          status.GetCurrentTerm(),
+		Miner:         info.Miner,
+		Validators:    info.Validators,
+	}
+
+	// 与共识内核合约一致，候选人保存在$xpoa或$poa下的{version}_validates
+	key := fmt.Sprintf("%d_%s", result.Version, xpoaValidatesKey)
+	value, err := contractValue(reader, "$"+name, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		stored := &struct {
+			Address []string `json:"address"`
+		}{}
+		if err := json.Unmarshal(value, stored); err != nil {
+			return nil, common.ErrInternal.More("%v", err)
+		}
+		if !stringsEqual(stored.Address, result.Validators) {
+			result.PendingValidators = stored.Address
+		}
+	}
+	return result, nil
+}
+
+func (h *ChainHandle) IsTrunkTipBlock(blockId []byte) (_ bool, err error) {
+	defer h.endSpan(h.startSpan("IsTrunkTipBlock"), &err)
 	return h.chainReader().IsTrunkTipBlock(blockId)
 }
 
-func (h *ChainHandle) QueryBlockByHeight(height int64, needContent bool) (*xpb.BlockInfo, error) {
+func (h *ChainHandle) QueryBlockByHeight(height int64, needContent bool) (_ *xpb.BlockInfo, err error) {
+	defer h.endSpan(h.startSpan("QueryBlockByHeight"), &err)
 	return h.ledgerReader().QueryBlockByHeight(height, needContent)
 }
 
-func (h *ChainHandle) GetAccountByAK(address string) ([]string, error) {
+func (h *ChainHandle) QueryBlockHeader(blkId []byte) (_ *xpb.BlockInfo, err error) {
+	defer h.endSpan(h.startSpan("QueryBlockHeader"), &err)
+	return h.ledgerReader().QueryBlockHeader(blkId)
+}
+
+func (h *ChainHandle) QueryBlockHeaderByHeight(height int64) (_ *xpb.BlockInfo, err error) {
+	defer h.endSpan(h.startSpan("QueryBlockHeaderByHeight"), &err)
+	return h.ledgerReader().QueryBlockHeaderByHeight(height)
+}
+
+// GetSnapshotValue 读取指定区块时的存储快照值，key不存在或已删除时返回空值
+func (h *ChainHandle) GetSnapshotValue(bucket string, key, blkId []byte) (_ []byte, err error) {
+	defer h.endSpan(h.startSpan("GetSnapshotValue"), &err)
+	snapshot, err := h.chain.Context().State.CreateXMSnapshotReader(blkId)
+	if err != nil {
+		return nil, common.ErrInternal.More("%v", err)
+	}
+
+	value, err := snapshot.Get(bucket, key)
+	if err != nil {
+		return nil, common.ErrInternal.More("%v", err)
+	}
+	if sandbox.IsDelFlag(value) {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// GetContractValue 读取合约存储的最新值，key不存在或已删除时返回空值
+func (h *ChainHandle) GetContractValue(bucket string, key []byte) (_ []byte, err error) {
+	defer h.endSpan(h.startSpan("GetContractValue"), &err)
+	return contractValue(h.chain.Context().State.CreateXMReader(), bucket, key)
+}
+
+// contractValue 从reader读取合约存储值，key不存在或已删除时返回空值
+func contractValue(reader kledger.XMReader, bucket string, key []byte) ([]byte, error) {
+	data, err := reader.Get(bucket, key)
+	if err != nil {
+		return nil, common.ErrInternal.More("%v", err)
+	}
+
+	value := data.GetPureData().GetValue()
+	if sandbox.IsDelFlag(value) {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// KeyVersion 合约存储key的一个历史版本
+type KeyVersion struct {
+	Value     []byte           // 该版本的值，删除时为空
+	Tx        *lpb.Transaction // 写入该版本的交易
+	Confirmed bool
+}
+
+// QueryKeyHistory 沿交易读集回溯key的历史版本，按从新到旧返回最多limit个；
+// 合约写入key前都会先读取旧值，key不存在时读集中记录空版本，因此写入交易的读集中总包含上一版本。
+// 未读取就直接写入的交易不引用上一版本，回溯在此中断，truncated表示更早的版本可能缺失
+func (h *ChainHandle) QueryKeyHistory(bucket string, key []byte,
+	limit int) (_ []*KeyVersion, truncated bool, err error) {
+	defer h.endSpan(h.startSpan("QueryKeyHistory"), &err)
+	state := h.chain.Context().State
+	data, err := state.CreateXMReader().Get(bucket, key)
+	if err != nil {
+		return nil, false, common.ErrInternal.More("%v", err)
+	}
+	return keyHistory(state.QueryTx, bucket, key, data, limit)
+}
+
+// keyHistory 从data引用的版本开始回溯key的历史版本
+func keyHistory(queryTx func(txid []byte) (*lpb.Transaction, bool, error), bucket string, key []byte,
+	data *kledger.VersionedData, limit int) ([]*KeyVersion, bool, error) {
+	var result []*KeyVersion
+	refTxid, refOffset := data.GetRefTxid(), data.GetRefOffset()
+	for len(refTxid) > 0 && len(result) < limit {
+		tx, confirmed, err := queryTx(refTxid)
+		if err != nil {
+			return nil, false, common.ErrInternal.More("query tx %x failed: %v", refTxid, err)
+		}
+		if refOffset < 0 || int(refOffset) >= len(tx.GetTxOutputsExt()) {
+			return nil, false, common.ErrInternal.More("invalid version %x_%d", refTxid, refOffset)
+		}
+
+		value := tx.GetTxOutputsExt()[refOffset].GetValue()
+		if sandbox.IsDelFlag(value) {
+			value = nil
+		}
+		result = append(result, &KeyVersion{
+			Value:     value,
+			Tx:        tx,
+			Confirmed: confirmed,
+		})
+
+		var input *protos.TxInputExt
+		for _, item := range tx.GetTxInputsExt() {
+			if item.GetBucket() == bucket && bytes.Equal(item.GetKey(), key) {
+				input = item
+				break
+			}
+		}
+		if input == nil {
+			return result, true, nil
+		}
+		refTxid, refOffset = input.GetRefTxid(), input.GetRefOffset()
+	}
+	return result, false, nil
+}
+
+// ContractInfo 合约部署信息
+type ContractInfo struct {
+	Desc      *protos.WasmCodeDesc
+	Account   string           // 部署合约的账户
+	DeployTx  *lpb.Transaction // 部署合约的交易
+	UpgradeTx *lpb.Transaction // 最近一次升级交易，未升级时为空
+	Abi       []byte
+	Code      []byte
+}
+
+// GetContractInfo 读取合约部署信息，合约不存在时返回空
+func (h *ChainHandle) GetContractInfo(contractName string,
+	needCode bool) (_ *ContractInfo, err error) {
+	defer h.endSpan(h.startSpan("GetContractInfo"), &err)
+	descKey := bridge.ContractCodeDescKey(contractName)
+	descBuf, err := h.GetContractValue(contractBucket, descKey)
+	if err != nil {
+		return nil, err
+	}
+	if descBuf == nil {
+		return nil, nil
+	}
+	info := &ContractInfo{
+		Desc: &protos.WasmCodeDesc{},
+	}
+	if err = proto.Unmarshal(descBuf, info.Desc); err != nil {
+		return nil, common.ErrInternal.More("%v", err)
+	}
+
+	account, err := h.GetContractValue(aclUtils.GetContract2AccountBucket(), []byte(contractName))
+	if err != nil {
+		return nil, err
+	}
+	info.Account = string(account)
+
+	// 部署和每次升级都会重写代码描述，最早的版本即为部署交易，回溯中断时部署交易未知
+	versions, truncated, err := h.QueryKeyHistory(contractBucket, descKey, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) > 0 && !truncated {
+		info.DeployTx = versions[len(versions)-1].Tx
+	}
+	if len(versions) > 1 {
+		info.UpgradeTx = versions[0].Tx
+	}
+
+	info.Abi, err = h.GetContractValue(contractBucket, []byte(contractName+".abi"))
+	if err != nil {
+		return nil, err
+	}
+	if needCode {
+		info.Code, err = h.GetContractValue(contractBucket, []byte(contractName+".code"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
+func (h *ChainHandle) GetAccountByAK(address string) (_ []string, err error) {
+	defer h.endSpan(h.startSpan("GetAccountByAK"), &err)
 	return h.contractReader().GetAccountByAK(address)
 }
 
+// ListContractKeys 按前缀遍历合约存储，返回start（包含）之后最多limit个未删除的key，
+// height小于0时读取最新状态，否则读取该主干高度的快照
+func (h *ChainHandle) ListContractKeys(bucket string, prefix, start []byte, limit int,
+	height int64) (_ []*kledger.PureData, _ bool, err error) {
+	defer h.endSpan(h.startSpan("ListContractKeys"), &err)
+	chainCtx := h.chain.Context()
+
+	// 快照读取不支持Select，按最新状态遍历key后逐个读取快照值，
+	// 已删除的key在最新状态中保留删除标记，因此不会遗漏历史上存在的key
+	var snapshot kledger.XMSnapshotReader
+	if height >= 0 {
+		blkInfo, err := h.QueryBlockHeaderByHeight(height)
+		if err != nil {
+			return nil, false, err
+		}
+		if blkInfo.GetStatus() != lpb.BlockStatus_BLOCK_TRUNK {
+			return nil, false, common.ErrBlockNotExist
+		}
+		snapshot, err = chainCtx.State.CreateXMSnapshotReader(blkInfo.GetBlock().GetBlockid())
+		if err != nil {
+			return nil, false, common.ErrInternal.More("%v", err)
+		}
+	}
+
+	return selectContractKeys(chainCtx.State.CreateXMReader(), snapshot, bucket, prefix, start, limit)
+}
+
 // Helper functions
 
 // contractReader generate a new contract reader
@@ -157,6 +567,22 @@ func (h *ChainHandle) utxoReader() reader.UtxoReader {
 	return reader.NewUtxoReader(h.chain.Context(), h.ctx())
 }
 
+// startSpan 以请求上下文中的span为父span创建子span
+func (h *ChainHandle) startSpan(name string) trace.Span {
+	_, span := tracing.Start(h.reqCtx, "ChainHandle."+name,
+		trace.WithAttributes(attribute.String("xchain.bcname", h.bcName)))
+	return span
+}
+
+// endSpan 与startSpan配合在defer中使用，err为方法的命名返回值，方法没有错误返回时传nil
+func (h *ChainHandle) endSpan(span trace.Span, err *error) {
+	if err == nil {
+		tracing.End(span, nil)
+		return
+	}
+	tracing.End(span, *err)
+}
+
 func (h *ChainHandle) ctx() xCtx.XContext {
 	return &xCtx.BaseCtx{
 		XLog:  h.reqCtx.GetLog(),
@@ -171,15 +597,61 @@ func (h *ChainHandle) checkSelectUtxoSign(account, pubKey string, sign []byte,
 		return true
 	}
 
+	lockedUtxo := NewLockedUtxo(h.bcName, account, need)
+	return h.verifyUtxoSign(account, pubKey, sign, lockedUtxo.Hash())
+}
+
+func (h *ChainHandle) checkReleaseUtxoSign(account string, utxoList []*lpb.Utxo, timestamp int64,
+	signs []*protos.SignatureInfo) bool {
+	// 签名过期后拒绝，避免截获的签名被重复使用
+	if math.Abs(float64(time.Now().Unix()-timestamp)) > releaseSignExpire.Seconds() || len(signs) == 0 {
+		return false
+	}
+
+	release := NewUtxoRelease(h.bcName, account, timestamp)
+	for _, item := range utxoList {
+		release.AddUtxo(item.GetRefTxid(), item.GetRefOffset())
+	}
+	hash := release.Hash()
+	if !aclUtils.IsAccount(account) {
+		return h.verifyUtxoSign(account, signs[0].GetPublicKey(), signs[0].GetSign(), hash)
+	}
+
+	// 合约账户需要满足账户ACL的AK签名
+	crypto := h.chain.Context().Crypto
+	akURIs := make([]string, 0, len(signs))
+	for _, sign := range signs {
+		publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(sign.GetPublicKey())
+		if err != nil {
+			return false
+		}
+		passed, err := crypto.VerifyECDSA(publicKey, sign.GetSign(), hash)
+		if err != nil || !passed {
+			return false
+		}
+		address, err := crypto.GetAddressFromPublicKey(publicKey)
+		if err != nil {
+			return false
+		}
+		akURIs = append(akURIs, account+"/"+address)
+	}
+	passed, err := aclUtils.IdentifyAccount(h.chain.Context().Acl, account, akURIs)
+	if err != nil {
+		h.reqCtx.GetLog().Warn("identify account failed", "account", account, "err", err)
+		return false
+	}
+	return passed
+}
+
+func (h *ChainHandle) verifyUtxoSign(account, pubKey string, sign, hash []byte) bool {
 	crypto := h.chain.Context().Crypto
 	publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(pubKey)
 	if err != nil {
 		return false
 	}
-	lockedUtxo := NewLockedUtxo(h.bcName, account, need)
 
 	// verify sign
-	passed, err := crypto.VerifyECDSA(publicKey, sign, lockedUtxo.Hash())
+	passed, err := crypto.VerifyECDSA(publicKey, sign, hash)
 	if err != nil {
 		return false
 	}
@@ -191,3 +663,98 @@ func (h *ChainHandle) checkSelectUtxoSign(account, pubKey string, sign []byte,
 	matched, _ := crypto.VerifyAddressUsingPublicKey(account, publicKey)
 	return matched
 }
+
+// utxoKeyAddress 从utxo表的key中解析地址，key格式为U{address}_{hex txid}_{offset}
+func utxoKeyAddress(key []byte) (string, bool) {
+	key = bytes.TrimPrefix(key, []byte(lpb.UTXOTablePrefix))
+	offsetPos := bytes.LastIndexByte(key, '_')
+	if offsetPos <= 0 {
+		return "", false
+	}
+	txidPos := bytes.LastIndexByte(key[:offsetPos], '_')
+	if txidPos <= 0 {
+		return "", false
+	}
+	return string(key[:txidPos]), true
+}
+
+// sortTokenHolders 按余额降序排列，余额相同按地址排序
+func sortTokenHolders(holders []*TokenHolder) {
+	sort.Slice(holders, func(i, j int) bool {
+		if cmp := holders[i].Balance.Cmp(holders[j].Balance); cmp != 0 {
+			return cmp > 0
+		}
+		return holders[i].Address < holders[j].Address
+	})
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// selectContractKeys 遍历reader中以prefix开头、不小于start的key，返回最多limit个未删除的key，
+// snapshot不为空时读取快照值
+func selectContractKeys(reader kledger.XMReader, snapshot kledger.XMSnapshotReader, bucket string,
+	prefix, start []byte, limit int) ([]*kledger.PureData, bool, error) {
+	if bytes.Compare(start, prefix) < 0 {
+		start = prefix
+	}
+	end := prefixEnd(prefix)
+	if end == nil {
+		// xmodel不支持开区间遍历，使用足够大的key作为bucket的上界
+		end = bytes.Repeat([]byte{0xff}, maxContractKeyLen)
+	}
+	iter, err := reader.Select(bucket, start, end)
+	if err != nil {
+		return nil, false, common.ErrInternal.More("%v", err)
+	}
+	defer iter.Close()
+
+	result := make([]*kledger.PureData, 0, limit)
+	for iter.Next() {
+		value := iter.Value().GetPureData().GetValue()
+		if snapshot != nil {
+			value, err = snapshot.Get(bucket, iter.Key())
+			if err != nil {
+				return nil, false, common.ErrInternal.More("%v", err)
+			}
+		}
+		if len(value) == 0 || sandbox.IsDelFlag(value) {
+			continue
+		}
+		if len(result) >= limit {
+			return result, true, nil
+		}
+		result = append(result, &kledger.PureData{
+			Bucket: bucket,
+			Key:    iter.Key(),
+			Value:  value,
+		})
+	}
+	if iter.Error() != nil {
+		return nil, false, common.ErrInternal.More("%v", iter.Error())
+	}
+
+	return result, false, nil
+}
+
+// prefixEnd 返回第一个大于所有以prefix开头的key的值，prefix为空或全为0xff时返回nil
+func prefixEnd(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			limit := make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i]++
+			return limit
+		}
+	}
+	return nil
+}
@@ -0,0 +1,592 @@
+package models
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/crypto/common/account"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/contract/sandbox"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	kledger "github.com/xuperchain/xupercore/kernel/ledger"
+	"github.com/xuperchain/xupercore/lib/crypto/client"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/protos"
+
+	sCtx "github.com/xuperchain/xuperchain/service/context"
+)
+
+func TestUtxoKeyAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want string
+		ok   bool
+	}{
+		{
+			name: "address",
+			key:  utxo.GenUtxoKeyWithPrefix([]byte("TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"), []byte{0x5f, 0x01}, 2),
+			want: "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY",
+			ok:   true,
+		},
+		{
+			name: "account",
+			key:  utxo.GenUtxoKeyWithPrefix([]byte("XC1111111111111111@xuper"), []byte{0xab}, 0),
+			want: "XC1111111111111111@xuper",
+			ok:   true,
+		},
+		{
+			name: "invalid",
+			key:  "Uabc_0",
+			ok:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := utxoKeyAddress([]byte(tt.key))
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("utxoKeyAddress() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSortTokenHolders(t *testing.T) {
+	holders := []*TokenHolder{
+		{Address: "c", Balance: big.NewInt(5)},
+		{Address: "b", Balance: big.NewInt(10)},
+		{Address: "a", Balance: big.NewInt(5)},
+	}
+	sortTokenHolders(holders)
+	for i, want := range []string{"b", "a", "c"} {
+		if holders[i].Address != want {
+			t.Fatalf("holders[%d] = %s, want %s", i, holders[i].Address, want)
+		}
+	}
+}
+
+// fakeChain 只提供校验签名需要的链上下文
+type fakeChain struct {
+	common.Chain
+	ctx *common.ChainCtx
+}
+
+func (c *fakeChain) Context() *common.ChainCtx {
+	return c.ctx
+}
+
+// fakeAcl 只有一个合约账户的ACL
+type fakeAcl struct {
+	account string
+	acl     *protos.Acl
+}
+
+func (a *fakeAcl) GetAccountACL(accountName string) (*protos.Acl, error) {
+	if accountName == a.account {
+		return a.acl, nil
+	}
+	return nil, nil
+}
+
+func (a *fakeAcl) GetContractMethodACL(contractName, methodName string) (*protos.Acl, error) {
+	return nil, nil
+}
+
+func (a *fakeAcl) GetAccountAddresses(accountName string) ([]string, error) {
+	return nil, nil
+}
+
+type fakeReqCtx struct {
+	sCtx.ReqCtx
+	log logs.Logger
+}
+
+func (c *fakeReqCtx) GetLog() logs.Logger {
+	return c.log
+}
+
+func TestCheckReleaseUtxoSign(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newAK := func() *account.ECDSAAccount {
+		ak, err := crypto.CreateNewAccountWithMnemonic(1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ak
+	}
+	ak1, ak2, other := newAK(), newAK(), newAK()
+	contractAccount := "XC1111111111111111@xuper"
+	acl := &fakeAcl{
+		account: contractAccount,
+		acl: &protos.Acl{
+			Pm:        &protos.PermissionModel{Rule: protos.PermissionRule_SIGN_THRESHOLD, AcceptValue: 1},
+			AksWeight: map[string]float64{ak1.Address: 0.5, ak2.Address: 0.5},
+		},
+	}
+	log, _ := logs.NewLogger("", "models_test")
+	h := &ChainHandle{
+		bcName: "xuper",
+		reqCtx: &fakeReqCtx{log: log},
+		chain:  &fakeChain{ctx: &common.ChainCtx{Crypto: crypto, Acl: acl}},
+	}
+
+	utxoList := []*lpb.Utxo{{RefTxid: []byte{0x01}, RefOffset: 0}, {RefTxid: []byte{0x02}, RefOffset: 1}}
+	sign := func(ak *account.ECDSAAccount, address string, list []*lpb.Utxo, timestamp int64) *protos.SignatureInfo {
+		release := NewUtxoRelease("xuper", address, timestamp)
+		for _, item := range list {
+			release.AddUtxo(item.GetRefTxid(), item.GetRefOffset())
+		}
+		privateKey, err := crypto.GetEcdsaPrivateKeyFromJsonStr(ak.JsonPrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := crypto.SignECDSA(privateKey, release.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &protos.SignatureInfo{PublicKey: ak.JsonPublicKey, Sign: s}
+	}
+
+	now := time.Now().Unix()
+	expired := now - int64(releaseSignExpire.Seconds()) - 1
+	tests := []struct {
+		name      string
+		account   string
+		timestamp int64
+		signs     []*protos.SignatureInfo
+		want      bool
+	}{
+		{
+			name:      "address",
+			account:   ak1.Address,
+			timestamp: now,
+			signs:     []*protos.SignatureInfo{sign(ak1, ak1.Address, utxoList, now)},
+			want:      true,
+		},
+		{
+			name:      "expired",
+			account:   ak1.Address,
+			timestamp: expired,
+			signs:     []*protos.SignatureInfo{sign(ak1, ak1.Address, utxoList, expired)},
+		},
+		{
+			name:      "other utxo list",
+			account:   ak1.Address,
+			timestamp: now,
+			signs:     []*protos.SignatureInfo{sign(ak1, ak1.Address, utxoList[:1], now)},
+		},
+		{
+			name:      "other address",
+			account:   ak1.Address,
+			timestamp: now,
+			signs:     []*protos.SignatureInfo{sign(other, ak1.Address, utxoList, now)},
+		},
+		{
+			name:      "no sign",
+			account:   ak1.Address,
+			timestamp: now,
+		},
+		{
+			name:      "account",
+			account:   contractAccount,
+			timestamp: now,
+			signs: []*protos.SignatureInfo{sign(ak1, contractAccount, utxoList, now),
+				sign(ak2, contractAccount, utxoList, now)},
+			want: true,
+		},
+		{
+			name:      "account threshold unmet",
+			account:   contractAccount,
+			timestamp: now,
+			signs:     []*protos.SignatureInfo{sign(ak1, contractAccount, utxoList, now)},
+		},
+		{
+			name:      "account ak not in acl",
+			account:   contractAccount,
+			timestamp: now,
+			signs: []*protos.SignatureInfo{sign(ak1, contractAccount, utxoList, now),
+				sign(other, contractAccount, utxoList, now)},
+		},
+		{
+			name:      "account anonymous",
+			account:   contractAccount,
+			timestamp: now,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.checkReleaseUtxoSign(tt.account, utxoList, tt.timestamp, tt.signs); got != tt.want {
+				t.Errorf("checkReleaseUtxoSign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix []byte
+		want   []byte
+	}{
+		{name: "empty", prefix: nil, want: nil},
+		{name: "normal", prefix: []byte("ab"), want: []byte("ac")},
+		{name: "trailing 0xff", prefix: []byte{'a', 0xff, 0xff}, want: []byte{'b'}},
+		{name: "all 0xff", prefix: []byte{0xff, 0xff}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := prefixEnd(tt.prefix); !bytes.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Fatalf("prefixEnd(%x) = %x, want %x", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeXMReader 按key有序保存一个bucket的数据，记录最近一次Select的区间
+type fakeXMReader struct {
+	kledger.XMReader
+	keys       []string
+	values     map[string][]byte
+	start, end []byte
+}
+
+func newFakeXMReader(kvs map[string]string) *fakeXMReader {
+	r := &fakeXMReader{values: make(map[string][]byte)}
+	for k, v := range kvs {
+		r.keys = append(r.keys, k)
+		r.values[k] = []byte(v)
+	}
+	sort.Strings(r.keys)
+	return r
+}
+
+func (r *fakeXMReader) Select(bucket string, start, end []byte) (kledger.XMIterator, error) {
+	r.start, r.end = start, end
+	iter := &fakeXMIterator{index: -1}
+	for _, k := range r.keys {
+		if bytes.Compare([]byte(k), start) >= 0 && bytes.Compare([]byte(k), end) < 0 {
+			iter.data = append(iter.data, &kledger.VersionedData{
+				PureData: &kledger.PureData{Bucket: bucket, Key: []byte(k), Value: r.values[k]},
+			})
+		}
+	}
+	return iter, nil
+}
+
+type fakeXMIterator struct {
+	data  []*kledger.VersionedData
+	index int
+}
+
+func (i *fakeXMIterator) Key() []byte                   { return i.data[i.index].PureData.Key }
+func (i *fakeXMIterator) Value() *kledger.VersionedData { return i.data[i.index] }
+func (i *fakeXMIterator) Error() error                  { return nil }
+func (i *fakeXMIterator) Close()                        {}
+func (i *fakeXMIterator) Next() bool {
+	i.index++
+	return i.index < len(i.data)
+}
+
+// fakeSnapshot 快照中只包含values中的key
+type fakeSnapshot map[string]string
+
+func (s fakeSnapshot) Get(bucket string, key []byte) ([]byte, error) {
+	return []byte(s[string(key)]), nil
+}
+
+func TestSelectContractKeys(t *testing.T) {
+	reader := newFakeXMReader(map[string]string{
+		"a":                "0",
+		"k1":               "1",
+		"k2":               sandbox.DelFlag,
+		"k3":               "3",
+		"k4":               "4",
+		"\xff\xff":         "f1",
+		"\xff\xff\x01":     "f2",
+		"\xff\xff\xff\x7f": "f3",
+	})
+	maxEnd := bytes.Repeat([]byte{0xff}, maxContractKeyLen)
+
+	tests := []struct {
+		name     string
+		snapshot kledger.XMSnapshotReader
+		prefix   string
+		start    string
+		limit    int
+		want     []string
+		wantMore bool
+		wantEnd  []byte
+	}{
+		{
+			name:   "prefix skips deleted key",
+			prefix: "k", limit: 10,
+			want:    []string{"k1", "k3", "k4"},
+			wantEnd: []byte("l"),
+		},
+		{
+			name:   "limit reached with remaining",
+			prefix: "k", limit: 2,
+			want: []string{"k1", "k3"}, wantMore: true,
+			wantEnd: []byte("l"),
+		},
+		{
+			name:   "limit reached without remaining",
+			prefix: "k", limit: 3,
+			want:    []string{"k1", "k3", "k4"},
+			wantEnd: []byte("l"),
+		},
+		{
+			name:   "start before prefix",
+			prefix: "k", start: "a", limit: 10,
+			want:    []string{"k1", "k3", "k4"},
+			wantEnd: []byte("l"),
+		},
+		{
+			name:   "empty prefix scans whole bucket",
+			prefix: "", limit: 10,
+			want:    []string{"a", "k1", "k3", "k4", "\xff\xff", "\xff\xff\x01", "\xff\xff\xff\x7f"},
+			wantEnd: maxEnd,
+		},
+		{
+			name:   "all 0xff prefix",
+			prefix: "\xff\xff", limit: 10,
+			want:    []string{"\xff\xff", "\xff\xff\x01", "\xff\xff\xff\x7f"},
+			wantEnd: maxEnd,
+		},
+		{
+			name:     "snapshot value",
+			snapshot: fakeSnapshot{"k1": "old", "k4": sandbox.DelFlag},
+			prefix:   "k", limit: 10,
+			want:    []string{"k1"},
+			wantEnd: []byte("l"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kvs, more, err := selectContractKeys(reader, tt.snapshot, "counter",
+				[]byte(tt.prefix), []byte(tt.start), tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, kv := range kvs {
+				got = append(got, string(kv.Key))
+			}
+			if !reflect.DeepEqual(got, tt.want) || more != tt.wantMore {
+				t.Fatalf("keys = %q,%v, want %q,%v", got, more, tt.want, tt.wantMore)
+			}
+			if !bytes.Equal(reader.end, tt.wantEnd) {
+				t.Fatalf("select end = %x, want %x", reader.end, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestSelectContractKeysResume(t *testing.T) {
+	reader := newFakeXMReader(map[string]string{
+		"k":     "0",
+		"k\x00": "1",
+		"k1":    "2",
+		"k2":    sandbox.DelFlag,
+		"k3":    "3",
+		"l":     "4",
+	})
+	want := []string{"k", "k\x00", "k1", "k3"}
+	for limit := 1; limit <= len(want); limit++ {
+		var got []string
+		var start []byte
+		for pages := 0; ; pages++ {
+			if pages > len(want) {
+				t.Fatalf("limit %d: too many pages", limit)
+			}
+			kvs, more, err := selectContractKeys(reader, nil, "counter", []byte("k"), start, limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, kv := range kvs {
+				got = append(got, string(kv.Key))
+			}
+			if !more {
+				break
+			}
+			// 与ListContractKeys接口一致，从游标的下一个key开始
+			start = append(append([]byte{}, kvs[len(kvs)-1].Key...), 0)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("limit %d: keys = %q, want %q", limit, got, want)
+		}
+	}
+}
+
+type fakeConsensusStatus struct {
+	name    string
+	version int64
+	info    string
+}
+
+func (s *fakeConsensusStatus) GetVersion() int64                { return s.version }
+func (s *fakeConsensusStatus) GetConsensusBeginInfo() int64     { return 100 }
+func (s *fakeConsensusStatus) GetStepConsensusIndex() int       { return 1 }
+func (s *fakeConsensusStatus) GetConsensusName() string         { return s.name }
+func (s *fakeConsensusStatus) GetCurrentTerm() int64            { return 3 }
+func (s *fakeConsensusStatus) GetCurrentValidatorsInfo() []byte { return []byte(s.info) }
+
+// bucketXMReader 按bucket/key保存合约存储，不存在的key返回空值
+type bucketXMReader struct {
+	kledger.XMReader
+	values map[string]string
+}
+
+func (r *bucketXMReader) Get(bucket string, key []byte) (*kledger.VersionedData, error) {
+	data := &kledger.PureData{Bucket: bucket, Key: key}
+	if v, ok := r.values[bucket+"/"+string(key)]; ok {
+		data.Value = []byte(v)
+	}
+	return &kledger.VersionedData{PureData: data}, nil
+}
+
+func TestXpoaStatus(t *testing.T) {
+	const info = `{"validators":["a","b"],"miner":"a"}`
+	tests := []struct {
+		name        string
+		status      *fakeConsensusStatus
+		values      map[string]string
+		wantErr     bool
+		wantPending []string
+	}{
+		{
+			name:   "xpoa without change",
+			status: &fakeConsensusStatus{name: "xpoa", version: 1, info: info},
+			values: map[string]string{"$xpoa/1_validates": `{"address":["a","b"]}`},
+		},
+		{
+			name:        "xpoa pending validators",
+			status:      &fakeConsensusStatus{name: "xpoa", version: 1, info: info},
+			values:      map[string]string{"$xpoa/1_validates": `{"address":["a","b","c"]}`},
+			wantPending: []string{"a", "b", "c"},
+		},
+		{
+			name:        "poa reads $poa bucket",
+			status:      &fakeConsensusStatus{name: "poa", version: 2, info: info},
+			values:      map[string]string{"$poa/2_validates": `{"address":["b"]}`},
+			wantPending: []string{"b"},
+		},
+		{
+			name:   "other consensus bucket ignored",
+			status: &fakeConsensusStatus{name: "xpoa", version: 2, info: info},
+			values: map[string]string{"$poa/2_validates": `{"address":["b"]}`},
+		},
+		{
+			name:   "other version key ignored",
+			status: &fakeConsensusStatus{name: "xpoa", version: 2, info: info},
+			values: map[string]string{"$xpoa/1_validates": `{"address":["b"]}`},
+		},
+		{
+			name:   "deleted validates",
+			status: &fakeConsensusStatus{name: "xpoa", version: 1, info: info},
+			values: map[string]string{"$xpoa/1_validates": sandbox.DelFlag},
+		},
+		{
+			name:    "not xpoa",
+			status:  &fakeConsensusStatus{name: "tdpos", version: 1, info: info},
+			wantErr: true,
+		},
+		{
+			name:    "invalid validators info",
+			status:  &fakeConsensusStatus{name: "xpoa", version: 1, info: "{"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid stored validates",
+			status:  &fakeConsensusStatus{name: "xpoa", version: 1, info: info},
+			values:  map[string]string{"$xpoa/1_validates": "{"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xpoaStatus(tt.status, &bucketXMReader{values: tt.values})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("xpoaStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := &XpoaStatus{
+				ConsensusName:     tt.status.name,
+				Version:           tt.status.version,
+				StartHeight:       100,
+				Term:              3,
+				Miner:             "a",
+				Validators:        []string{"a", "b"},
+				PendingValidators: tt.wantPending,
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("xpoaStatus() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestKeyHistory(t *testing.T) {
+	bucket, key := "acl", []byte("XC1111111111111111@xuper")
+	// newTx 写入value，ref为nil时不读取旧值直接写入
+	newTx := func(txid, value string, ref *protos.TxInputExt) *lpb.Transaction {
+		tx := &lpb.Transaction{
+			Txid:         []byte(txid),
+			TxOutputsExt: []*protos.TxOutputExt{{Bucket: bucket, Key: key, Value: []byte(value)}},
+			// 其他bucket的同名key不影响回溯
+			TxInputsExt: []*protos.TxInputExt{{Bucket: "other", Key: key, RefTxid: []byte("x")}},
+		}
+		if ref != nil {
+			tx.TxInputsExt = append(tx.TxInputsExt, ref)
+		}
+		return tx
+	}
+	txs := map[string]*lpb.Transaction{
+		// tx1创建key，读集中记录空版本
+		"tx1": newTx("tx1", "v1", &protos.TxInputExt{Bucket: bucket, Key: key}),
+		"tx2": newTx("tx2", "v2", &protos.TxInputExt{Bucket: bucket, Key: key, RefTxid: []byte("tx1")}),
+		"tx3": newTx("tx3", "v3", nil),
+		"tx4": newTx("tx4", "v4", &protos.TxInputExt{Bucket: bucket, Key: key, RefTxid: []byte("tx3")}),
+	}
+	queryTx := func(txid []byte) (*lpb.Transaction, bool, error) {
+		return txs[string(txid)], true, nil
+	}
+
+	tests := []struct {
+		name          string
+		latest        string
+		limit         int
+		want          []string
+		wantTruncated bool
+	}{
+		{name: "complete", latest: "tx2", limit: 10, want: []string{"v2", "v1"}},
+		{name: "write without reading", latest: "tx4", limit: 10, want: []string{"v4", "v3"}, wantTruncated: true},
+		{name: "limit reached", latest: "tx4", limit: 1, want: []string{"v4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &kledger.VersionedData{RefTxid: []byte(tt.latest)}
+			versions, truncated, err := keyHistory(queryTx, bucket, key, data, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var values []string
+			for _, version := range versions {
+				values = append(values, string(version.Value))
+			}
+			if !reflect.DeepEqual(values, tt.want) || truncated != tt.wantTruncated {
+				t.Fatalf("keyHistory() = %v, %v, want %v, %v", values, truncated, tt.want, tt.wantTruncated)
+			}
+		})
+	}
+}
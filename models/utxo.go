@@ -1,8 +1,11 @@
 package models
 
 import (
+	"encoding/hex"
 	"math/big"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/xuperchain/xupercore/lib/crypto/hash"
 )
@@ -34,3 +37,35 @@ func (o *LockedUtxo) Hash() []byte {
 	hashValue := hash.DoubleSha256([]byte(hashKey))
 	return hashValue
 }
+
+// UtxoRelease locked UTXO to be released before the lock expires
+type UtxoRelease struct {
+	bcName    string // blockchain name
+	address   string // address for UTXO belongs to
+	utxoRefs  []string
+	timestamp int64 // unix timestamp in seconds when signing
+}
+
+// NewUtxoRelease creates a release of locked UTXO of the address in the blockchain
+func NewUtxoRelease(bcName, address string, timestamp int64) *UtxoRelease {
+	return &UtxoRelease{
+		bcName:    bcName,
+		address:   address,
+		timestamp: timestamp,
+	}
+}
+
+// AddUtxo adds an UTXO to release by its reference
+func (r *UtxoRelease) AddUtxo(refTxid []byte, refOffset int32) {
+	r.utxoRefs = append(r.utxoRefs, hex.EncodeToString(refTxid)+":"+strconv.Itoa(int(refOffset)))
+}
+
+// Hash gets hash value of UTXO release for signature,
+// binds the UTXO list and timestamp so that a signature can not be replayed for other UTXO
+func (r *UtxoRelease) Hash() []byte {
+	refs := make([]string, len(r.utxoRefs))
+	copy(refs, r.utxoRefs)
+	sort.Strings(refs)
+	hashKey := r.bcName + r.address + strings.Join(refs, ",") + strconv.FormatInt(r.timestamp, 10)
+	return hash.DoubleSha256([]byte(hashKey))
+}
@@ -1,9 +1,12 @@
 package models
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math/big"
 	"testing"
+
+	"github.com/xuperchain/xupercore/lib/crypto/hash"
 )
 
 func TestLockedUtxo_Hash(t *testing.T) {
@@ -43,3 +46,34 @@ func TestLockedUtxo_Hash(t *testing.T) {
 		})
 	}
 }
+
+func TestUtxoRelease_Hash(t *testing.T) {
+	release := NewUtxoRelease("xuper", "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY", 1700000000)
+	release.AddUtxo([]byte{0x01, 0x02}, 1)
+	release.AddUtxo([]byte{0xab}, 0)
+	want := hex.EncodeToString(hash.DoubleSha256(
+		[]byte("xuperTeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY0102:1,ab:01700000000")))
+	if got := hex.EncodeToString(release.Hash()); got != want {
+		t.Errorf("UtxoRelease.Hash() = %v,\nwant %v", got, want)
+	}
+
+	// utxo顺序不影响签名
+	reordered := NewUtxoRelease("xuper", "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY", 1700000000)
+	reordered.AddUtxo([]byte{0xab}, 0)
+	reordered.AddUtxo([]byte{0x01, 0x02}, 1)
+	if !bytes.Equal(reordered.Hash(), release.Hash()) {
+		t.Error("release hash should not depend on utxo order")
+	}
+
+	// utxo列表或时间不同时签名不能复用
+	other := NewUtxoRelease("xuper", "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY", 1700000000)
+	other.AddUtxo([]byte{0x01, 0x02}, 1)
+	later := NewUtxoRelease("xuper", "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY", 1700000001)
+	later.AddUtxo([]byte{0x01, 0x02}, 1)
+	later.AddUtxo([]byte{0xab}, 0)
+	for _, h := range [][]byte{other.Hash(), later.Hash(), NewLockedUtxoAll("xuper", "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY").Hash()} {
+		if bytes.Equal(h, release.Hash()) {
+			t.Error("release hash should bind utxo list and timestamp")
+		}
+	}
+}
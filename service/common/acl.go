@@ -0,0 +1,60 @@
+package common
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// ParseAclJSON 解析合约存储中以json保存的ACL，值为空表示ACL已删除
+func ParseAclJSON(value []byte) (*protos.Acl, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	acl := &protos.Acl{}
+	if err := json.Unmarshal(value, acl); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+// AclAddresses 返回ACL中登记的全部AK，按字典序排列。
+// 账户的AK到账户映射由ACL中的AK生成，因此可用于推导映射变化
+func AclAddresses(acl *protos.Acl) []string {
+	aks := make(map[string]bool)
+	for ak := range acl.GetAksWeight() {
+		aks[ak] = true
+	}
+	for _, set := range acl.GetAkSets().GetSets() {
+		for _, ak := range set.GetAks() {
+			aks[ak] = true
+		}
+	}
+
+	result := make([]string, 0, len(aks))
+	for ak := range aks {
+		result = append(result, ak)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// DiffAddresses 比较两组有序地址，返回after相对before新增和移除的地址
+func DiffAddresses(before, after []string) (added, removed []string) {
+	i, j := 0, 0
+	for i < len(before) || j < len(after) {
+		switch {
+		case j >= len(after) || (i < len(before) && before[i] < after[j]):
+			removed = append(removed, before[i])
+			i++
+		case i >= len(before) || before[i] > after[j]:
+			added = append(added, after[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestAclAddresses(t *testing.T) {
+	acl, err := ParseAclJSON([]byte(`{"pm":{"rule":1,"acceptValue":1},"aksWeight":{"bob":0.5,"alice":0.5}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := AclAddresses(acl); !reflect.DeepEqual(got, []string{"alice", "bob"}) {
+		t.Fatalf("unexpected aks %v", got)
+	}
+
+	acl = &protos.Acl{
+		AkSets: &protos.AkSets{
+			Sets: map[string]*protos.AkSet{
+				"1": {Aks: []string{"carol", "alice"}},
+				"2": {Aks: []string{"alice"}},
+			},
+		},
+	}
+	if got := AclAddresses(acl); !reflect.DeepEqual(got, []string{"alice", "carol"}) {
+		t.Fatalf("unexpected aks %v", got)
+	}
+
+	if acl, err = ParseAclJSON(nil); acl != nil || err != nil {
+		t.Fatal("expect nil acl for deleted value")
+	}
+	if len(AclAddresses(nil)) != 0 {
+		t.Fatal("expect no aks for nil acl")
+	}
+}
+
+func TestDiffAddresses(t *testing.T) {
+	added, removed := DiffAddresses([]string{"alice", "bob", "dave"}, []string{"bob", "carol", "erin"})
+	if !reflect.DeepEqual(added, []string{"carol", "erin"}) {
+		t.Fatalf("unexpected added %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"alice", "dave"}) {
+		t.Fatalf("unexpected removed %v", removed)
+	}
+
+	added, removed = DiffAddresses(nil, []string{"alice"})
+	if !reflect.DeepEqual(added, []string{"alice"}) || removed != nil {
+		t.Fatalf("unexpected diff %v %v", added, removed)
+	}
+}
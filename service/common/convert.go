@@ -6,6 +6,7 @@ import (
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/txindex"
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	"github.com/xuperchain/xupercore/protos"
 )
@@ -90,6 +91,43 @@ func BlockToXchain(block *xldgpb.InternalBlock) *pb.InternalBlock {
 	return &newBlock
 }
 
+// 只转换区块头字段，避免序列化交易内容；Justify结构嵌套较深，沿用序列化方式转换
+func BlockHeaderToXchain(block *xldgpb.InternalBlock) *pb.BlockHeader {
+	if block == nil {
+		return nil
+	}
+
+	header := &pb.BlockHeader{
+		Version:     block.GetVersion(),
+		Nonce:       block.GetNonce(),
+		Blockid:     block.GetBlockid(),
+		PreHash:     block.GetPreHash(),
+		Proposer:    block.GetProposer(),
+		Sign:        block.GetSign(),
+		Pubkey:      block.GetPubkey(),
+		MerkleRoot:  block.GetMerkleRoot(),
+		Height:      block.GetHeight(),
+		Timestamp:   block.GetTimestamp(),
+		TxCount:     block.GetTxCount(),
+		CurTerm:     block.GetCurTerm(),
+		CurBlockNum: block.GetCurBlockNum(),
+		FailedTxs:   block.GetFailedTxs(),
+		TargetBits:  block.GetTargetBits(),
+	}
+	if block.GetJustify() != nil {
+		qcBuf, err := proto.Marshal(block.GetJustify())
+		if err != nil {
+			return nil
+		}
+		header.Justify = &pb.QuorumCert{}
+		if err = proto.Unmarshal(qcBuf, header.Justify); err != nil {
+			return nil
+		}
+	}
+
+	return header
+}
+
 func ConvertInvokeReq(reqs []*pb.InvokeRequest) ([]*protos.InvokeRequest, error) {
 	if reqs == nil {
 		return nil, nil
@@ -359,3 +397,23 @@ func ConvertEventSubType(typ pb.SubscribeType) protos.SubscribeType {
 
 	return protos.SubscribeType_BLOCK
 }
+
+func TxIndexRolesToXchain(roles int) []pb.TxRole {
+	roleMap := []struct {
+		flag int
+		role pb.TxRole
+	}{
+		{txindex.RoleInitiator, pb.TxRole_ROLE_INITIATOR},
+		{txindex.RoleInput, pb.TxRole_ROLE_INPUT},
+		{txindex.RoleOutput, pb.TxRole_ROLE_OUTPUT},
+		{txindex.RoleAuthRequire, pb.TxRole_ROLE_AUTH_REQUIRE},
+	}
+
+	result := make([]pb.TxRole, 0, len(roleMap))
+	for _, item := range roleMap {
+		if roles&item.flag != 0 {
+			result = append(result, item.role)
+		}
+	}
+	return result
+}
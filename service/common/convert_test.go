@@ -0,0 +1,64 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+)
+
+func TestBlockHeaderToXchain(t *testing.T) {
+	block := &xldgpb.InternalBlock{
+		Version:    1,
+		Nonce:      7,
+		PreHash:    []byte("prehash"),
+		Proposer:   []byte("proposer"),
+		Timestamp:  1600000000,
+		Height:     10,
+		TxCount:    1,
+		MerkleRoot: []byte("root"),
+		FailedTxs:  map[string]string{"tx": "failed"},
+		Justify: &xldgpb.QuorumCert{
+			ProposalId: []byte("proposal"),
+			SignInfos: &xldgpb.QCSignInfos{
+				QCSignInfos: []*xldgpb.SignInfo{{Address: "addr", Sign: []byte("sign")}},
+			},
+		},
+		Transactions: []*xldgpb.Transaction{{Txid: []byte("tx")}},
+	}
+	blockid, err := ledger.MakeBlockID(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Blockid = blockid
+
+	header := BlockHeaderToXchain(block)
+	if header == nil {
+		t.Fatal("convert block header failed")
+	}
+	if header.GetJustify().GetSignInfos().GetQCSignInfos()[0].GetAddress() != "addr" {
+		t.Fatalf("unexpected justify: %v", header.GetJustify())
+	}
+
+	// 区块头序列化后按区块解析，应能重新计算出相同的blockid
+	buf, err := proto.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &xldgpb.InternalBlock{}
+	if err := proto.Unmarshal(buf, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.GetTransactions()) != 0 {
+		t.Fatal("header should not contain transactions")
+	}
+	newBlockid, err := ledger.MakeBlockID(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(newBlockid, blockid) {
+		t.Fatalf("blockid mismatch, expect %x got %x", blockid, newBlockid)
+	}
+}
@@ -5,6 +5,16 @@ import (
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 )
 
+// 服务层扩展错误，不属于内核定义的标准错误
+var (
+	ErrWaitTxTimeout    = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40100, Msg: "wait tx timeout"}
+	ErrContractNotExist = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40101, Msg: "contract not exist"}
+	ErrProposalNotExist = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40102, Msg: "proposal not exist"}
+	ErrRateLimited      = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40103, Msg: "request rate limited"}
+	ErrReadOnly         = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40104, Msg: "node is read only"}
+	ErrTxNotConfirmed   = &ecom.Error{Status: ecom.ErrStatusRefused, Code: 40105, Msg: "tx not confirmed"}
+)
+
 // 错误映射配置
 var StdErrToXchainErrMap = map[int]pb.XChainErrorEnum{
 	ecom.ErrSuccess.Code:                  pb.XChainErrorEnum_SUCCESS,
@@ -38,4 +48,10 @@ var StdErrToXchainErrMap = map[int]pb.XChainErrorEnum{
 	ecom.ErrNewNetworkFailed.Code:         pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrSendMessageFailed.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNetworkNoResponse.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
+	ErrWaitTxTimeout.Code:                 pb.XChainErrorEnum_NOT_READY_ERROR,
+	ErrContractNotExist.Code:              pb.XChainErrorEnum_CONTRACT_NOT_FOUND_ERROR,
+	ErrProposalNotExist.Code:              pb.XChainErrorEnum_PROPOSAL_NOT_FOUND_ERROR,
+	ErrRateLimited.Code:                   pb.XChainErrorEnum_SERVICE_REFUSED_ERROR,
+	ErrReadOnly.Code:                      pb.XChainErrorEnum_SERVICE_REFUSED_ERROR,
+	ErrTxNotConfirmed.Code:                pb.XChainErrorEnum_NOT_READY_ERROR,
 }
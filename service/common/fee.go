@@ -0,0 +1,52 @@
+package common
+
+import (
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/kernel/contract"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// FeeSafetyMargin 建议费用在预估费用基础上的冗余百分比，
+// 预执行与上链执行之间状态可能变化，实际消耗会有浮动
+const FeeSafetyMargin = 10
+
+// EstimateFee 按资源类型计算预执行结果的费用，requests为预执行返回的需要计费的请求，
+// 与账本一致，每个请求的各项资源分别按gas价格向上取整
+func EstimateFee(requests []*protos.InvokeRequest, gasPrice *protos.GasPrice) *pb.EstimateFeeResponse {
+	resp := &pb.EstimateFeeResponse{
+		GasPrice: &pb.GasPrice{
+			CpuRate:  gasPrice.GetCpuRate(),
+			MemRate:  gasPrice.GetMemRate(),
+			DiskRate: gasPrice.GetDiskRate(),
+			XfeeRate: gasPrice.GetXfeeRate(),
+		},
+		SafetyMargin: FeeSafetyMargin,
+	}
+
+	used := contract.Limits{}
+	for _, req := range requests {
+		limits := contract.FromPbLimits(req.GetResourceLimits())
+		used.Add(limits)
+		resp.CpuFee += roundup(limits.Cpu, gasPrice.GetCpuRate())
+		resp.MemFee += roundup(limits.Memory, gasPrice.GetMemRate())
+		resp.DiskFee += roundup(limits.Disk, gasPrice.GetDiskRate())
+		resp.XfeeFee += roundup(limits.XFee, gasPrice.GetXfeeRate())
+	}
+	for _, limit := range contract.ToPbLimits(used) {
+		resp.ResourceUsed = append(resp.ResourceUsed, &pb.ResourceLimit{
+			Type:  pb.ResourceType(limit.GetType()),
+			Limit: limit.GetLimit(),
+		})
+	}
+
+	resp.TotalFee = resp.CpuFee + resp.MemFee + resp.DiskFee + resp.XfeeFee
+	resp.SuggestedFee = resp.TotalFee + roundup(resp.TotalFee*FeeSafetyMargin, 100)
+	return resp
+}
+
+func roundup(n, scale int64) int64 {
+	if scale == 0 {
+		return 0
+	}
+	return (n + scale - 1) / scale
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/xuperchain/xupercore/kernel/contract"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestEstimateFee(t *testing.T) {
+	gasPrice := &protos.GasPrice{CpuRate: 1000, MemRate: 1000000, DiskRate: 1, XfeeRate: 1}
+	limits := []contract.Limits{
+		{Cpu: 1500, Memory: 10, Disk: 20, XFee: 3},
+		{Cpu: 999, Memory: 2000001, Disk: 0, XFee: 0},
+	}
+	var requests []*protos.InvokeRequest
+	var gasUsed int64
+	for i := range limits {
+		requests = append(requests, &protos.InvokeRequest{ResourceLimits: contract.ToPbLimits(limits[i])})
+		gasUsed += limits[i].TotalGas(gasPrice)
+	}
+
+	resp := EstimateFee(requests, gasPrice)
+	if resp.GetCpuFee() != 3 || resp.GetMemFee() != 4 || resp.GetDiskFee() != 20 || resp.GetXfeeFee() != 3 {
+		t.Fatalf("unexpected fee: %+v", resp)
+	}
+	if resp.GetTotalFee() != gasUsed {
+		t.Fatalf("total fee %d not equal to gas used %d", resp.GetTotalFee(), gasUsed)
+	}
+	if resp.GetSuggestedFee() != 33 {
+		t.Fatalf("unexpected suggested fee: %d", resp.GetSuggestedFee())
+	}
+	if resp.GetResourceUsed()[0].GetLimit() != 2499 {
+		t.Fatalf("unexpected cpu used: %d", resp.GetResourceUsed()[0].GetLimit())
+	}
+
+	if resp := EstimateFee(nil, gasPrice); resp.GetSuggestedFee() != 0 {
+		t.Fatalf("expect zero fee, got %d", resp.GetSuggestedFee())
+	}
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	putils "github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+)
+
+// GovernTokenBalanceToXchain 解析$govern_token合约存储的账户余额，值为空表示账户未持有治理代币
+func GovernTokenBalanceToXchain(value []byte) (*pb.GovernTokenBalanceResponse, error) {
+	balance := putils.NewGovernTokenBalance()
+	if len(value) != 0 {
+		if err := json.Unmarshal(value, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pb.GovernTokenBalanceResponse{
+		TotalBalance:   balance.TotalBalance.String(),
+		LockedBalances: make(map[string]string, len(balance.LockedBalance)),
+	}
+	for lockType, amount := range balance.LockedBalance {
+		resp.LockedBalances[lockType] = amount.String()
+	}
+	return resp, nil
+}
+
+// ProposalToXchain 解析$proposal合约存储的提案，并按治理代币总量计算通过所需票数，
+// 与$proposal合约的CheckVoteResult一致
+func ProposalToXchain(proposalID string, value []byte, totalSupply *big.Int) (*pb.ProposalInfo, error) {
+	proposal, err := putils.Parse(string(value))
+	if err != nil {
+		return nil, err
+	}
+	args, err := json.Marshal(proposal.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.ProposalInfo{
+		ProposalId:     proposalID,
+		Proposer:       proposal.Proposer,
+		Status:         proposal.Status,
+		VoteAmount:     "0",
+		MinVotePercent: stringArg(proposal.Args, "min_vote_percent"),
+		Args:           string(args),
+	}
+	if proposal.VoteAmount != nil {
+		info.VoteAmount = proposal.VoteAmount.String()
+	}
+	if height := stringArg(proposal.Args, "stop_vote_height"); height != "" {
+		info.StopVoteHeight, err = strconv.ParseInt(height, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stop_vote_height: %v", err)
+		}
+	}
+	if percent, ok := new(big.Int).SetString(info.MinVotePercent, 10); ok && totalSupply != nil {
+		threshold := new(big.Int).Mul(totalSupply, percent)
+		info.VoteThreshold = threshold.Div(threshold, big.NewInt(100)).String()
+	}
+	if trigger := proposal.Trigger; trigger != nil {
+		triggerArgs, err := json.Marshal(trigger.Args)
+		if err != nil {
+			return nil, err
+		}
+		info.Trigger = &pb.ProposalTrigger{
+			Height:   trigger.Height,
+			Module:   trigger.Module,
+			Contract: trigger.Contract,
+			Method:   trigger.Method,
+			Args:     string(triggerArgs),
+		}
+	}
+	return info, nil
+}
+
+func stringArg(args map[string]interface{}, key string) string {
+	value, _ := args[key].(string)
+	return value
+}
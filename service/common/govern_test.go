@@ -0,0 +1,47 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGovernTokenBalanceToXchain(t *testing.T) {
+	resp, err := GovernTokenBalanceToXchain([]byte(`{"total_balance":1000,"locked_balances":{"ordinary":30,"tdpos":0}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetTotalBalance() != "1000" || resp.GetLockedBalances()["ordinary"] != "30" ||
+		resp.GetLockedBalances()["tdpos"] != "0" {
+		t.Fatalf("unexpected balance: %+v", resp)
+	}
+
+	resp, err = GovernTokenBalanceToXchain(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetTotalBalance() != "0" || resp.GetLockedBalances()["ordinary"] != "0" {
+		t.Fatalf("expect zero balance, got %+v", resp)
+	}
+}
+
+func TestProposalToXchain(t *testing.T) {
+	value := []byte(`{"args":{"min_vote_percent":"51","stop_vote_height":"120"},` +
+		`"trigger":{"height":130,"module":"xkernel","contract":"$consensus","method":"updateConsensus","args":{"name":"tdpos"}},` +
+		`"vote_amount":600,"status":"voting","proposer":"TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"}`)
+	info, err := ProposalToXchain("1", value, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetProposalId() != "1" || info.GetStatus() != "voting" || info.GetVoteAmount() != "600" ||
+		info.GetStopVoteHeight() != 120 || info.GetVoteThreshold() != "510" {
+		t.Fatalf("unexpected proposal: %+v", info)
+	}
+	trigger := info.GetTrigger()
+	if trigger.GetHeight() != 130 || trigger.GetMethod() != "updateConsensus" || trigger.GetArgs() != `{"name":"tdpos"}` {
+		t.Fatalf("unexpected trigger: %+v", trigger)
+	}
+
+	if _, err := ProposalToXchain("1", []byte("invalid"), big.NewInt(1000)); err == nil {
+		t.Fatal("expect parse error")
+	}
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// merkle树节点长度，与账本中叶子节点长度一致
+const merkleNodeSize = 32
+
+// MakeMerklePath 根据区块merkle树生成交易的merkle路径，按从叶子到根的顺序返回兄弟节点
+// 树的布局与账本MakeMerkleTree一致：叶子补齐为2的次幂，节点i的父节点为leafSize+i/2
+func MakeMerklePath(tree [][]byte, txIndex int) ([][]byte, error) {
+	treeSize := len(tree)
+	if treeSize == 0 || treeSize%2 == 0 {
+		return nil, fmt.Errorf("invalid merkle tree size:%d", treeSize)
+	}
+	leafSize := (treeSize + 1) / 2
+	if txIndex < 0 || txIndex >= leafSize || tree[txIndex] == nil {
+		return nil, fmt.Errorf("tx index out of range:%d", txIndex)
+	}
+
+	var path [][]byte
+	for i := txIndex; i < treeSize-1; i = leafSize + i/2 {
+		sibling := tree[i^1]
+		if sibling == nil {
+			// 没有右孩子时父节点由左孩子与自身计算
+			sibling = tree[i]
+		}
+		path = append(path, sibling)
+	}
+	return path, nil
+}
+
+// VerifyMerklePath 校验交易id沿merkle路径计算得到的根与merkleRoot是否一致
+func VerifyMerklePath(txid []byte, txIndex, txCount int64, path [][]byte, merkleRoot []byte) error {
+	if txCount <= 0 || txIndex < 0 || txIndex >= txCount {
+		return fmt.Errorf("tx index %d out of range, tx count %d", txIndex, txCount)
+	}
+	depth := 0
+	for leafSize := int64(1); leafSize < txCount; leafSize <<= 1 {
+		depth++
+	}
+	if len(path) != depth {
+		return fmt.Errorf("merkle path length mismatch, expect %d got %d", depth, len(path))
+	}
+
+	node := make([]byte, merkleNodeSize)
+	copy(node, txid)
+	for level, sibling := range path {
+		if (txIndex>>uint(level))&1 == 0 {
+			node = merkleDoubleSha256(node, sibling)
+		} else {
+			node = merkleDoubleSha256(sibling, node)
+		}
+	}
+	if !bytes.Equal(node, merkleRoot) {
+		return fmt.Errorf("merkle root mismatch")
+	}
+	return nil
+}
+
+func merkleDoubleSha256(left, right []byte) []byte {
+	sum1 := sha256.New()
+	sum1.Write(left)
+	sum1.Write(right)
+	sum2 := sha256.Sum256(sum1.Sum(nil))
+	return sum2[:]
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+)
+
+func TestMerklePath(t *testing.T) {
+	for _, txCount := range []int{1, 2, 3, 5, 8} {
+		var txs []*xldgpb.Transaction
+		for i := 0; i < txCount; i++ {
+			txs = append(txs, &xldgpb.Transaction{Txid: []byte(fmt.Sprintf("tx-%d", i))})
+		}
+		tree := ledger.MakeMerkleTree(txs)
+		root := tree[len(tree)-1]
+
+		for i, tx := range txs {
+			path, err := MakeMerklePath(tree, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = VerifyMerklePath(tx.Txid, int64(i), int64(txCount), path, root)
+			if err != nil {
+				t.Fatalf("tx count %d index %d: %v", txCount, i, err)
+			}
+			if txCount > 1 {
+				err = VerifyMerklePath([]byte("other"), int64(i), int64(txCount), path, root)
+				if err == nil {
+					t.Fatalf("tx count %d index %d: expect verify fail", txCount, i)
+				}
+			}
+		}
+	}
+
+	if _, err := MakeMerklePath(nil, 0); err == nil {
+		t.Fatal("expect error for empty tree")
+	}
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// UtxoBalanceDeltas 统计交易utxo输入输出引起的各地址余额变化，
+// 手续费输出累计在utxo.FeePlaceholder下
+func UtxoBalanceDeltas(inputs []*protos.TxInput, outputs []*protos.TxOutput) map[string]*big.Int {
+	deltas := make(map[string]*big.Int)
+	add := func(addr string, amount *big.Int) {
+		if _, ok := deltas[addr]; !ok {
+			deltas[addr] = new(big.Int)
+		}
+		deltas[addr].Add(deltas[addr], amount)
+	}
+	for _, input := range inputs {
+		amount := new(big.Int).SetBytes(input.GetAmount())
+		add(string(input.GetFromAddr()), amount.Neg(amount))
+	}
+	for _, output := range outputs {
+		add(string(output.GetToAddr()), new(big.Int).SetBytes(output.GetAmount()))
+	}
+
+	// 找零后余额不变的地址不属于变化
+	for addr, delta := range deltas {
+		if delta.Sign() == 0 && addr != utxo.FeePlaceholder {
+			delete(deltas, addr)
+		}
+	}
+	return deltas
+}
+
+// SortedAddresses 按字典序返回余额变化的地址，不含手续费占位地址
+func SortedAddresses(deltas map[string]*big.Int) []string {
+	addrs := make([]string, 0, len(deltas))
+	for addr := range deltas {
+		if addr == utxo.FeePlaceholder {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+func ContractEventsToXchain(events []*protos.ContractEvent) []*pb.ContractEvent {
+	var ret []*pb.ContractEvent
+	for _, event := range events {
+		ret = append(ret, &pb.ContractEvent{
+			Contract: event.GetContract(),
+			Name:     event.GetName(),
+			Body:     event.GetBody(),
+		})
+	}
+	return ret
+}
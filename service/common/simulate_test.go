@@ -0,0 +1,42 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestUtxoBalanceDeltas(t *testing.T) {
+	amount := func(n int64) []byte {
+		return big.NewInt(n).Bytes()
+	}
+	inputs := []*protos.TxInput{
+		{FromAddr: []byte("alice"), Amount: amount(100)},
+		{FromAddr: []byte("alice"), Amount: amount(50)},
+		{FromAddr: []byte("carol"), Amount: amount(10)},
+	}
+	outputs := []*protos.TxOutput{
+		{ToAddr: []byte("bob"), Amount: amount(120)},
+		{ToAddr: []byte(utxo.FeePlaceholder), Amount: amount(20)},
+		{ToAddr: []byte("alice"), Amount: amount(10)},
+		{ToAddr: []byte("carol"), Amount: amount(10)},
+	}
+
+	deltas := UtxoBalanceDeltas(inputs, outputs)
+	if deltas["alice"].Int64() != -140 || deltas["bob"].Int64() != 120 {
+		t.Fatalf("unexpected deltas: %v", deltas)
+	}
+	if deltas[utxo.FeePlaceholder].Int64() != 20 {
+		t.Fatalf("unexpected fee: %v", deltas[utxo.FeePlaceholder])
+	}
+	if _, ok := deltas["carol"]; ok {
+		t.Fatal("unchanged address should be removed")
+	}
+
+	addrs := SortedAddresses(deltas)
+	if len(addrs) != 2 || addrs[0] != "alice" || addrs[1] != "bob" {
+		t.Fatalf("unexpected addresses: %v", addrs)
+	}
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+)
+
+// ChainStats 统计一段连续区块的吞吐量、出块间隔、区块大小和合约调用次数，
+// blocks需按高度升序排列且包含交易内容
+func ChainStats(blocks []*xldgpb.InternalBlock, topN int) *pb.ChainStatsResponse {
+	resp := ChainSpeeds(blocks)
+	if len(blocks) == 0 {
+		return resp
+	}
+
+	var totalSize int64
+	calls := make(map[string]int64)
+	for _, block := range blocks {
+		totalSize += int64(proto.Size(block))
+		for _, tx := range block.GetTransactions() {
+			for _, req := range tx.GetContractRequests() {
+				if req.GetContractName() != "" {
+					calls[req.GetContractName()]++
+				}
+			}
+		}
+	}
+	resp.AvgBlockSize = float64(totalSize) / float64(resp.BlockCount)
+	resp.TopContracts = topContracts(calls, topN)
+	return resp
+}
+
+// ChainSpeeds 统计一段连续区块的吞吐量和出块间隔，只用到高度、时间戳和交易数，
+// blocks需按高度升序排列，可以只包含区块头
+func ChainSpeeds(blocks []*xldgpb.InternalBlock) *pb.ChainStatsResponse {
+	resp := &pb.ChainStatsResponse{
+		BlockInterval: &pb.BlockIntervalStats{},
+	}
+	if len(blocks) == 0 {
+		return resp
+	}
+
+	intervals := make([]int64, 0, len(blocks)-1)
+	for i, block := range blocks {
+		resp.TxCount += blockTxCount(block)
+		if i > 0 {
+			interval := (block.GetTimestamp() - blocks[i-1].GetTimestamp()) / int64(time.Millisecond)
+			intervals = append(intervals, interval)
+		}
+	}
+
+	first, last := blocks[0], blocks[len(blocks)-1]
+	resp.StartHeight = first.GetHeight()
+	resp.EndHeight = last.GetHeight()
+	resp.BlockCount = int64(len(blocks))
+	resp.AvgTxsPerBlock = float64(resp.TxCount) / float64(resp.BlockCount)
+	// 首个区块的交易产生于窗口开始之前，不计入TPS
+	if duration := last.GetTimestamp() - first.GetTimestamp(); duration > 0 {
+		txs := resp.TxCount - blockTxCount(first)
+		resp.Tps = float64(txs) / time.Duration(duration).Seconds()
+	}
+	resp.BlockInterval = intervalStats(intervals)
+	return resp
+}
+
+// blockTxCount 区块头不包含交易内容，按tx_count计数
+func blockTxCount(block *xldgpb.InternalBlock) int64 {
+	if len(block.GetTransactions()) > 0 {
+		return int64(len(block.GetTransactions()))
+	}
+	return int64(block.GetTxCount())
+}
+
+func intervalStats(intervals []int64) *pb.BlockIntervalStats {
+	stats := &pb.BlockIntervalStats{}
+	if len(intervals) == 0 {
+		return stats
+	}
+
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+	var sum int64
+	for _, interval := range intervals {
+		sum += interval
+	}
+	stats.Min = intervals[0]
+	stats.Max = intervals[len(intervals)-1]
+	stats.Avg = float64(sum) / float64(len(intervals))
+	stats.P50 = percentile(intervals, 50)
+	stats.P90 = percentile(intervals, 90)
+	stats.P99 = percentile(intervals, 99)
+	return stats
+}
+
+// percentile 按最近秩法取百分位数，sorted需升序且非空
+func percentile(sorted []int64, p int) int64 {
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// topContracts 按调用次数降序返回前topN个合约，次数相同按合约名排序
+func topContracts(calls map[string]int64, topN int) []*pb.ContractInvokeStat {
+	result := make([]*pb.ContractInvokeStat, 0, len(calls))
+	for name, count := range calls {
+		result = append(result, &pb.ContractInvokeStat{ContractName: name, InvokeCount: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].InvokeCount != result[j].InvokeCount {
+			return result[i].InvokeCount > result[j].InvokeCount
+		}
+		return result[i].ContractName < result[j].ContractName
+	})
+	if len(result) > topN {
+		result = result[:topN]
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestChainStats(t *testing.T) {
+	invoke := func(names ...string) *xldgpb.Transaction {
+		tx := &xldgpb.Transaction{}
+		for _, name := range names {
+			tx.ContractRequests = append(tx.ContractRequests, &protos.InvokeRequest{ContractName: name})
+		}
+		return tx
+	}
+	base := time.Now().UnixNano()
+	blocks := []*xldgpb.InternalBlock{
+		{Height: 10, Timestamp: base, Transactions: []*xldgpb.Transaction{invoke("a")}},
+		{Height: 11, Timestamp: base + int64(time.Second), Transactions: []*xldgpb.Transaction{invoke("a", "b"), invoke()}},
+		{Height: 12, Timestamp: base + int64(4*time.Second), Transactions: []*xldgpb.Transaction{invoke("c"), invoke("b")}},
+	}
+
+	resp := ChainStats(blocks, 2)
+	if resp.GetStartHeight() != 10 || resp.GetEndHeight() != 12 || resp.GetBlockCount() != 3 || resp.GetTxCount() != 5 {
+		t.Fatalf("unexpected range: %+v", resp)
+	}
+	if resp.GetTps() != 1 {
+		t.Fatalf("unexpected tps: %v", resp.GetTps())
+	}
+	interval := resp.GetBlockInterval()
+	if interval.GetMin() != 1000 || interval.GetMax() != 3000 || interval.GetAvg() != 2000 ||
+		interval.GetP50() != 1000 || interval.GetP90() != 3000 {
+		t.Fatalf("unexpected interval: %+v", interval)
+	}
+	top := resp.GetTopContracts()
+	if len(top) != 2 || top[0].GetContractName() != "a" || top[0].GetInvokeCount() != 2 ||
+		top[1].GetContractName() != "b" || top[1].GetInvokeCount() != 2 {
+		t.Fatalf("unexpected top contracts: %+v", top)
+	}
+
+	if resp := ChainStats(nil, 10); resp.GetBlockCount() != 0 || resp.GetTps() != 0 {
+		t.Fatalf("expect empty stats, got %+v", resp)
+	}
+}
+
+func TestChainSpeeds(t *testing.T) {
+	// 区块头不包含交易，按tx_count计数
+	base := time.Now().UnixNano()
+	headers := []*xldgpb.InternalBlock{
+		{Height: 10, Timestamp: base, TxCount: 1},
+		{Height: 11, Timestamp: base + int64(time.Second), TxCount: 2},
+		{Height: 12, Timestamp: base + int64(4*time.Second), TxCount: 2},
+	}
+	resp := ChainSpeeds(headers)
+	if resp.GetBlockCount() != 3 || resp.GetTxCount() != 5 || resp.GetTps() != 1 ||
+		resp.GetBlockInterval().GetAvg() != 2000 {
+		t.Fatalf("unexpected speeds: %+v", resp)
+	}
+	if len(resp.GetTopContracts()) != 0 || resp.GetAvgBlockSize() != 0 {
+		t.Fatalf("expect no block content stats, got %+v", resp)
+	}
+}
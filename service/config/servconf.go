@@ -28,6 +28,67 @@ type ServConf struct {
 	InitConnWindowSize int32    `yaml:"initConnWindowSize,omitempty"`
 	TlsServerName      string   `yaml:"tlsServerName,omitempty"`
 	EventAddrMaxConn   int      `yaml:"eventAddrMaxConn,omitempty"`
+	EnableTxIndex      bool     `yaml:"enableTxIndex,omitempty"`
+	// GetTokenSupply需要遍历utxo表，结果按链高度缓存
+	EnableTokenSupply bool `yaml:"enableTokenSupply,omitempty"`
+	// 按客户端ip和rpc方法限流，未开启时不限制
+	RateLimit RateLimitConf `yaml:"rateLimit,omitempty"`
+	// 基于bearer token的访问控制，未开启时不校验
+	Auth AuthConf `yaml:"auth,omitempty"`
+	// 只读节点拒绝提交交易和锁定utxo
+	ReadOnly bool `yaml:"readOnly,omitempty"`
+	// 按grpc完整方法名过滤请求，如/pb.Xchain/PostTx，
+	// AllowMethods不为空时只允许列表中的方法，DenyMethods优先
+	AllowMethods []string `yaml:"allowMethods,omitempty"`
+	DenyMethods  []string `yaml:"denyMethods,omitempty"`
+	// 链路追踪，未开启时只透传上游的trace context
+	Trace TraceConf `yaml:"trace,omitempty"`
+}
+
+// TraceConf 链路追踪配置
+type TraceConf struct {
+	Enable      bool   `yaml:"enable,omitempty"`
+	ServiceName string `yaml:"serviceName,omitempty"`
+	// 导出方式，内置stdout和file，可通过tracing.RegisterExporter扩展
+	Exporter string `yaml:"exporter,omitempty"`
+	// file导出方式写入的文件
+	FilePath string `yaml:"filePath,omitempty"`
+	// 没有上游trace context时的采样率，取值0到1
+	SampleRatio float64 `yaml:"sampleRatio,omitempty"`
+}
+
+// RateLimitConf 令牌桶限流配置，桶按客户端ip划分
+type RateLimitConf struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// 每个ip所有方法合计的限制
+	PerIP LimitConf `yaml:"perIP,omitempty"`
+	// 每个ip调用单个方法的限制，key为方法名，如PreExec，不区分大小写
+	Methods map[string]LimitConf `yaml:"methods,omitempty"`
+}
+
+// AuthConf 访问控制配置，token通过grpc metadata或http头authorization以"Bearer <token>"传递，
+// 方法名不区分大小写，*表示全部方法
+type AuthConf struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// 无需token即可调用的方法
+	PublicMethods []string `yaml:"publicMethods,omitempty"`
+	// 静态api key及其可调用的方法
+	ApiKeys []ApiKeyConf `yaml:"apiKeys,omitempty"`
+	// HS256签名JWT的密钥，为空时不接受JWT，JWT的methods声明为可调用的方法
+	JwtSecret string `yaml:"jwtSecret,omitempty"`
+}
+
+// ApiKeyConf 静态api key
+type ApiKeyConf struct {
+	Key     string   `yaml:"key,omitempty"`
+	Methods []string `yaml:"methods,omitempty"`
+}
+
+// LimitConf 令牌桶参数，Rate为每秒补充的令牌数，为0表示不限制；
+// Burst为桶容量，不大于0时取Rate向上取整
+type LimitConf struct {
+	Rate  float64 `yaml:"rate,omitempty"`
+	Burst int     `yaml:"burst,omitempty"`
 }
 
 func LoadServConf(cfgFile string) (*ServConf, error) {
@@ -59,6 +120,13 @@ func GetDefServConf() *ServConf {
 		InitConnWindowSize: 64 << 10,
 		TlsServerName:      "localhost",
 		EventAddrMaxConn:   5,
+		EnableTxIndex:      false,
+		EnableTokenSupply:  false,
+		Trace: TraceConf{
+			ServiceName: "xchain",
+			Exporter:    "stdout",
+			SampleRatio: 1,
+		},
 	}
 }
 
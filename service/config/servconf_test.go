@@ -15,6 +15,20 @@ func TestLoadServConf(t *testing.T) {
 	}
 
 	fmt.Println(envCfg)
+
+	// viper读取时map的key会转为小写
+	rateLimit := envCfg.RateLimit
+	if !rateLimit.Enable || rateLimit.PerIP.Rate != 100 || rateLimit.Methods["preexec"].Burst != 20 {
+		t.Fatalf("unexpected rate limit conf: %+v", rateLimit)
+	}
+	auth := envCfg.Auth
+	if !auth.Enable || len(auth.PublicMethods) != 1 || len(auth.ApiKeys) != 1 ||
+		auth.ApiKeys[0].Key != "test-key" || len(auth.ApiKeys[0].Methods) != 2 {
+		t.Fatalf("unexpected auth conf: %+v", auth)
+	}
+	if !envCfg.ReadOnly || len(envCfg.DenyMethods) != 1 || len(envCfg.AllowMethods) != 0 {
+		t.Fatalf("unexpected method access conf: %+v", envCfg)
+	}
 }
 
 func getConfFile() string {
@@ -9,11 +9,15 @@ import (
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	scom "github.com/xuperchain/xuperchain/service/common"
 	sconf "github.com/xuperchain/xuperchain/service/config"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/tracing"
 	"github.com/xuperchain/xupercore/lib/logs"
 )
 
@@ -74,7 +78,8 @@ func (t *Gateway) runGateway() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	// grpc-gateway在x-forwarded-for最后追加http客户端ip，rpc服务据此识别客户端
+	mux := runtime.NewServeMux(runtime.WithMetadata(traceMetadata))
 	opts := []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(t.scfg.MaxRecvMsgSize)),
@@ -131,15 +136,28 @@ func (t *Gateway) interrupt(h http.Handler) http.Handler {
 			}
 		}
 
-		h.ServeHTTP(w, r)
+		// 开始或延续W3C trace context，通过grpc metadata传给rpc服务
+		ctx, span := tracing.Start(tracing.ExtractHTTP(r.Context(), r.Header), r.URL.Path,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.method", r.Method)))
+		defer span.End()
+
+		h.ServeHTTP(w, r.WithContext(ctx))
 
 		// Request log
 		t.log.Trace("gateway access request", "ip", r.RemoteAddr, "method", r.Method, "url", r.URL.Path)
 	})
 }
 
+// traceMetadata 网关的span作为rpc服务端span的父span
+func traceMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	tracing.Inject(ctx, md)
+	return md
+}
+
 func (t *Gateway) preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
+	headers := []string{"Content-Type", "Accept", "Authorization"}
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
@@ -10,6 +11,7 @@ import (
 	sconf "github.com/xuperchain/xuperchain/service/config"
 	gw "github.com/xuperchain/xuperchain/service/gateway"
 	"github.com/xuperchain/xuperchain/service/rpc"
+	"github.com/xuperchain/xuperchain/service/tracing"
 )
 
 // 由于需要同时启动多个服务组件，采用注册机制管理
@@ -23,6 +25,8 @@ type ServMG struct {
 	scfg    *sconf.ServConf
 	log     logs.Logger
 	servers []ServCom
+	// 退出时导出剩余的span
+	traceShutdown func(context.Context) error
 }
 
 func NewServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*ServMG, error) {
@@ -30,11 +34,18 @@ func NewServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*ServMG, error) {
 		return nil, fmt.Errorf("param error")
 	}
 
+	// 链路追踪需要在各服务启动前初始化
+	traceShutdown, err := tracing.Init(scfg.Trace)
+	if err != nil {
+		return nil, err
+	}
+
 	log, _ := logs.NewLogger("", scom.SubModName)
 	obj := &ServMG{
-		scfg:    scfg,
-		log:     log,
-		servers: make([]ServCom, 0),
+		scfg:          scfg,
+		log:           log,
+		servers:       make([]ServCom, 0),
+		traceShutdown: traceShutdown,
 	}
 
 	// 实例化rpc服务
@@ -87,4 +98,8 @@ func (t *ServMG) Exit() {
 			s.Exit()
 		}(serv)
 	}
+
+	if err := t.traceShutdown(context.Background()); err != nil {
+		t.log.Warn("shutdown trace provider failed", "err", err)
+	}
 }
@@ -60,6 +60,8 @@ const (
 	XChainErrorEnum_COMPLIANCE_CHECK_NOT_APPROVED  XChainErrorEnum = 37
 	XChainErrorEnum_ACCOUNT_CONTRACT_STATUS_ERROR  XChainErrorEnum = 38
 	XChainErrorEnum_TX_VERIFICATION_ERROR          XChainErrorEnum = 40
+	XChainErrorEnum_PROPOSAL_NOT_FOUND_ERROR       XChainErrorEnum = 41
+	XChainErrorEnum_CONTRACT_NOT_FOUND_ERROR       XChainErrorEnum = 42
 )
 
 var XChainErrorEnum_name = map[int32]string{
@@ -95,6 +97,8 @@ var XChainErrorEnum_name = map[int32]string{
 	37: "COMPLIANCE_CHECK_NOT_APPROVED",
 	38: "ACCOUNT_CONTRACT_STATUS_ERROR",
 	40: "TX_VERIFICATION_ERROR",
+	41: "PROPOSAL_NOT_FOUND_ERROR",
+	42: "CONTRACT_NOT_FOUND_ERROR",
 }
 
 var XChainErrorEnum_value = map[string]int32{
@@ -130,6 +134,8 @@ var XChainErrorEnum_value = map[string]int32{
 	"COMPLIANCE_CHECK_NOT_APPROVED":  37,
 	"ACCOUNT_CONTRACT_STATUS_ERROR":  38,
 	"TX_VERIFICATION_ERROR":          40,
+	"PROPOSAL_NOT_FOUND_ERROR":       41,
+	"CONTRACT_NOT_FOUND_ERROR":       42,
 }
 
 func (x XChainErrorEnum) String() string {
@@ -296,6 +302,104 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{4}
 }
 
+type AccountChangeType int32
+
+const (
+	AccountChangeType_ACCOUNT_CHANGE_UNDEFINE   AccountChangeType = 0
+	AccountChangeType_ACCOUNT_CHANGE_ACL        AccountChangeType = 1
+	AccountChangeType_ACCOUNT_CHANGE_METHOD_ACL AccountChangeType = 2
+)
+
+var AccountChangeType_name = map[int32]string{
+	0: "ACCOUNT_CHANGE_UNDEFINE",
+	1: "ACCOUNT_CHANGE_ACL",
+	2: "ACCOUNT_CHANGE_METHOD_ACL",
+}
+
+var AccountChangeType_value = map[string]int32{
+	"ACCOUNT_CHANGE_UNDEFINE":   0,
+	"ACCOUNT_CHANGE_ACL":        1,
+	"ACCOUNT_CHANGE_METHOD_ACL": 2,
+}
+
+func (x AccountChangeType) String() string {
+	return proto.EnumName(AccountChangeType_name, int32(x))
+}
+
+func (AccountChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{5}
+}
+
+// TxRole is the role of an address in a transaction
+type TxRole int32
+
+const (
+	TxRole_ROLE_UNDEFINE     TxRole = 0
+	TxRole_ROLE_INITIATOR    TxRole = 1
+	TxRole_ROLE_INPUT        TxRole = 2
+	TxRole_ROLE_OUTPUT       TxRole = 3
+	TxRole_ROLE_AUTH_REQUIRE TxRole = 4
+)
+
+var TxRole_name = map[int32]string{
+	0: "ROLE_UNDEFINE",
+	1: "ROLE_INITIATOR",
+	2: "ROLE_INPUT",
+	3: "ROLE_OUTPUT",
+	4: "ROLE_AUTH_REQUIRE",
+}
+
+var TxRole_value = map[string]int32{
+	"ROLE_UNDEFINE":     0,
+	"ROLE_INITIATOR":    1,
+	"ROLE_INPUT":        2,
+	"ROLE_OUTPUT":       3,
+	"ROLE_AUTH_REQUIRE": 4,
+}
+
+func (x TxRole) String() string {
+	return proto.EnumName(TxRole_name, int32(x))
+}
+
+func (TxRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{6}
+}
+
+// 未确认交易的依赖状态
+type PendingTxState int32
+
+const (
+	PendingTxState_PENDING_UNDEFINE PendingTxState = 0
+	// 依赖的交易均已上链，可以被打包
+	PendingTxState_PENDING_READY PendingTxState = 1
+	// 依赖的交易还在mempool中未确认
+	PendingTxState_PENDING_WAIT_PARENT PendingTxState = 2
+	// 依赖的交易在账本和mempool中都不存在
+	PendingTxState_PENDING_ORPHAN PendingTxState = 3
+)
+
+var PendingTxState_name = map[int32]string{
+	0: "PENDING_UNDEFINE",
+	1: "PENDING_READY",
+	2: "PENDING_WAIT_PARENT",
+	3: "PENDING_ORPHAN",
+}
+
+var PendingTxState_value = map[string]int32{
+	"PENDING_UNDEFINE":    0,
+	"PENDING_READY":       1,
+	"PENDING_WAIT_PARENT": 2,
+	"PENDING_ORPHAN":      3,
+}
+
+func (x PendingTxState) String() string {
+	return proto.EnumName(PendingTxState_name, int32(x))
+}
+
+func (PendingTxState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{7}
+}
+
 type Block_EBlockStatus int32
 
 const (
@@ -324,7 +428,7 @@ func (x Block_EBlockStatus) String() string {
 }
 
 func (Block_EBlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{5, 0}
+	return fileDescriptor_db0991b9525664ca, []int{10, 0}
 }
 
 type Header struct {
@@ -701,2643 +805,6610 @@ func (m *BatchTxs) GetTxs() []*TxStatus {
 	return nil
 }
 
-type Block struct {
-	Header               *Header            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string             `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Blockid              []byte             `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
-	Status               Block_EBlockStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pb.Block_EBlockStatus" json:"status,omitempty"`
-	Block                *InternalBlock     `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type BatchTxRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txids                [][]byte `protobuf:"bytes,3,rep,name=txids,proto3" json:"txids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
+func (m *BatchTxRequest) Reset()         { *m = BatchTxRequest{} }
+func (m *BatchTxRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxRequest) ProtoMessage()    {}
+func (*BatchTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{5}
 }
 
-func (m *Block) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block.Unmarshal(m, b)
+func (m *BatchTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTxRequest.Unmarshal(m, b)
 }
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+func (m *BatchTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTxRequest.Marshal(b, m, deterministic)
 }
-func (m *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(m, src)
+func (m *BatchTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxRequest.Merge(m, src)
 }
-func (m *Block) XXX_Size() int {
-	return xxx_messageInfo_Block.Size(m)
+func (m *BatchTxRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchTxRequest.Size(m)
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+func (m *BatchTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxRequest proto.InternalMessageInfo
 
-func (m *Block) GetHeader() *Header {
+func (m *BatchTxRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *Block) GetBcname() string {
+func (m *BatchTxRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *Block) GetBlockid() []byte {
-	if m != nil {
-		return m.Blockid
-	}
-	return nil
-}
-
-func (m *Block) GetStatus() Block_EBlockStatus {
-	if m != nil {
-		return m.Status
-	}
-	return Block_ERROR
-}
-
-func (m *Block) GetBlock() *InternalBlock {
+func (m *BatchTxRequest) GetTxids() [][]byte {
 	if m != nil {
-		return m.Block
+		return m.Txids
 	}
 	return nil
 }
 
-type BlockID struct {
-	Header  *Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname  string  `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Blockid []byte  `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
-	// if need content
-	NeedContent          bool     `protobuf:"varint,3,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
+type WaitTxRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Confirmations        int64    `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Timeout              int64    `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockID) Reset()         { *m = BlockID{} }
-func (m *BlockID) String() string { return proto.CompactTextString(m) }
-func (*BlockID) ProtoMessage()    {}
-func (*BlockID) Descriptor() ([]byte, []int) {
+func (m *WaitTxRequest) Reset()         { *m = WaitTxRequest{} }
+func (m *WaitTxRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitTxRequest) ProtoMessage()    {}
+func (*WaitTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{6}
 }
 
-func (m *BlockID) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockID.Unmarshal(m, b)
+func (m *WaitTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitTxRequest.Unmarshal(m, b)
 }
-func (m *BlockID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockID.Marshal(b, m, deterministic)
+func (m *WaitTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitTxRequest.Marshal(b, m, deterministic)
 }
-func (m *BlockID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockID.Merge(m, src)
+func (m *WaitTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitTxRequest.Merge(m, src)
 }
-func (m *BlockID) XXX_Size() int {
-	return xxx_messageInfo_BlockID.Size(m)
+func (m *WaitTxRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitTxRequest.Size(m)
 }
-func (m *BlockID) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockID.DiscardUnknown(m)
+func (m *WaitTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockID proto.InternalMessageInfo
+var xxx_messageInfo_WaitTxRequest proto.InternalMessageInfo
 
-func (m *BlockID) GetHeader() *Header {
+func (m *WaitTxRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *BlockID) GetBcname() string {
+func (m *WaitTxRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *BlockID) GetBlockid() []byte {
+func (m *WaitTxRequest) GetTxid() []byte {
 	if m != nil {
-		return m.Blockid
+		return m.Txid
 	}
 	return nil
 }
 
-func (m *BlockID) GetNeedContent() bool {
+func (m *WaitTxRequest) GetConfirmations() int64 {
 	if m != nil {
-		return m.NeedContent
+		return m.Confirmations
 	}
-	return false
+	return 0
 }
 
-type BlockHeight struct {
-	Header               *Header  `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WaitTxRequest) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
 }
 
-func (m *BlockHeight) Reset()         { *m = BlockHeight{} }
-func (m *BlockHeight) String() string { return proto.CompactTextString(m) }
-func (*BlockHeight) ProtoMessage()    {}
-func (*BlockHeight) Descriptor() ([]byte, []int) {
+type WaitTxResponse struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte            `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
+	Blockid              []byte            `protobuf:"bytes,5,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Height               int64             `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Confirmations        int64             `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WaitTxResponse) Reset()         { *m = WaitTxResponse{} }
+func (m *WaitTxResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitTxResponse) ProtoMessage()    {}
+func (*WaitTxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{7}
 }
 
-func (m *BlockHeight) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockHeight.Unmarshal(m, b)
+func (m *WaitTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitTxResponse.Unmarshal(m, b)
 }
-func (m *BlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockHeight.Marshal(b, m, deterministic)
+func (m *WaitTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitTxResponse.Marshal(b, m, deterministic)
 }
-func (m *BlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeight.Merge(m, src)
+func (m *WaitTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitTxResponse.Merge(m, src)
 }
-func (m *BlockHeight) XXX_Size() int {
-	return xxx_messageInfo_BlockHeight.Size(m)
+func (m *WaitTxResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitTxResponse.Size(m)
 }
-func (m *BlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeight.DiscardUnknown(m)
+func (m *WaitTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeight proto.InternalMessageInfo
+var xxx_messageInfo_WaitTxResponse proto.InternalMessageInfo
 
-func (m *BlockHeight) GetHeader() *Header {
+func (m *WaitTxResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *BlockHeight) GetBcname() string {
+func (m *WaitTxResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *BlockHeight) GetHeight() int64 {
+func (m *WaitTxResponse) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *WaitTxResponse) GetStatus() TransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransactionStatus_UNDEFINE
+}
+
+func (m *WaitTxResponse) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *WaitTxResponse) GetHeight() int64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-type CommonReply struct {
+func (m *WaitTxResponse) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type TxProofRequest struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CommonReply) Reset()         { *m = CommonReply{} }
-func (m *CommonReply) String() string { return proto.CompactTextString(m) }
-func (*CommonReply) ProtoMessage()    {}
-func (*CommonReply) Descriptor() ([]byte, []int) {
+func (m *TxProofRequest) Reset()         { *m = TxProofRequest{} }
+func (m *TxProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TxProofRequest) ProtoMessage()    {}
+func (*TxProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{8}
 }
 
-func (m *CommonReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CommonReply.Unmarshal(m, b)
+func (m *TxProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofRequest.Unmarshal(m, b)
 }
-func (m *CommonReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CommonReply.Marshal(b, m, deterministic)
+func (m *TxProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofRequest.Marshal(b, m, deterministic)
 }
-func (m *CommonReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommonReply.Merge(m, src)
+func (m *TxProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofRequest.Merge(m, src)
 }
-func (m *CommonReply) XXX_Size() int {
-	return xxx_messageInfo_CommonReply.Size(m)
+func (m *TxProofRequest) XXX_Size() int {
+	return xxx_messageInfo_TxProofRequest.Size(m)
 }
-func (m *CommonReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommonReply.DiscardUnknown(m)
+func (m *TxProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommonReply proto.InternalMessageInfo
+var xxx_messageInfo_TxProofRequest proto.InternalMessageInfo
 
-func (m *CommonReply) GetHeader() *Header {
+func (m *TxProofRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-type CommonIn struct {
-	Header               *Header    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	ViewOption           ViewOption `protobuf:"varint,2,opt,name=view_option,json=viewOption,proto3,enum=pb.ViewOption" json:"view_option,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *TxProofRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
 }
 
-func (m *CommonIn) Reset()         { *m = CommonIn{} }
-func (m *CommonIn) String() string { return proto.CompactTextString(m) }
-func (*CommonIn) ProtoMessage()    {}
-func (*CommonIn) Descriptor() ([]byte, []int) {
+func (m *TxProofRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+type TxProofResponse struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string         `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte         `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	BlockHeader          *InternalBlock `protobuf:"bytes,4,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	TxIndex              int64          `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	MerklePath           [][]byte       `protobuf:"bytes,6,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TxProofResponse) Reset()         { *m = TxProofResponse{} }
+func (m *TxProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TxProofResponse) ProtoMessage()    {}
+func (*TxProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{9}
 }
 
-func (m *CommonIn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CommonIn.Unmarshal(m, b)
+func (m *TxProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofResponse.Unmarshal(m, b)
 }
-func (m *CommonIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CommonIn.Marshal(b, m, deterministic)
+func (m *TxProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofResponse.Marshal(b, m, deterministic)
 }
-func (m *CommonIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommonIn.Merge(m, src)
+func (m *TxProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofResponse.Merge(m, src)
 }
-func (m *CommonIn) XXX_Size() int {
-	return xxx_messageInfo_CommonIn.Size(m)
+func (m *TxProofResponse) XXX_Size() int {
+	return xxx_messageInfo_TxProofResponse.Size(m)
 }
-func (m *CommonIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommonIn.DiscardUnknown(m)
+func (m *TxProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommonIn proto.InternalMessageInfo
+var xxx_messageInfo_TxProofResponse proto.InternalMessageInfo
 
-func (m *CommonIn) GetHeader() *Header {
+func (m *TxProofResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *CommonIn) GetViewOption() ViewOption {
+func (m *TxProofResponse) GetBcname() string {
 	if m != nil {
-		return m.ViewOption
+		return m.Bcname
 	}
-	return ViewOption_NONE
+	return ""
 }
 
-type TokenDetail struct {
-	Bcname               string          `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Balance              string          `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	Error                XChainErrorEnum `protobuf:"varint,3,opt,name=error,proto3,enum=pb.XChainErrorEnum" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *TxProofResponse) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
 }
 
-func (m *TokenDetail) Reset()         { *m = TokenDetail{} }
-func (m *TokenDetail) String() string { return proto.CompactTextString(m) }
-func (*TokenDetail) ProtoMessage()    {}
-func (*TokenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{10}
-}
+func (m *TxProofResponse) GetBlockHeader() *InternalBlock {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return nil
+}
 
-func (m *TokenDetail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TokenDetail.Unmarshal(m, b)
+func (m *TxProofResponse) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
 }
-func (m *TokenDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TokenDetail.Marshal(b, m, deterministic)
+
+func (m *TxProofResponse) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
 }
-func (m *TokenDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenDetail.Merge(m, src)
+
+type Block struct {
+	Header               *Header            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string             `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid              []byte             `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Status               Block_EBlockStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pb.Block_EBlockStatus" json:"status,omitempty"`
+	Block                *InternalBlock     `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *TokenDetail) XXX_Size() int {
-	return xxx_messageInfo_TokenDetail.Size(m)
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{10}
 }
-func (m *TokenDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenDetail.DiscardUnknown(m)
+
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenDetail proto.InternalMessageInfo
+var xxx_messageInfo_Block proto.InternalMessageInfo
 
-func (m *TokenDetail) GetBcname() string {
+func (m *Block) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Block) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TokenDetail) GetBalance() string {
+func (m *Block) GetBlockid() []byte {
 	if m != nil {
-		return m.Balance
+		return m.Blockid
 	}
-	return ""
+	return nil
 }
 
-func (m *TokenDetail) GetError() XChainErrorEnum {
+func (m *Block) GetStatus() Block_EBlockStatus {
 	if m != nil {
-		return m.Error
+		return m.Status
 	}
-	return XChainErrorEnum_SUCCESS
+	return Block_ERROR
 }
 
-type AddressStatus struct {
-	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Address              string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Bcs                  []*TokenDetail `protobuf:"bytes,3,rep,name=bcs,proto3" json:"bcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *Block) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
 }
 
-func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
-func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
-func (*AddressStatus) ProtoMessage()    {}
-func (*AddressStatus) Descriptor() ([]byte, []int) {
+type BlockID struct {
+	Header  *Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname  string  `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid []byte  `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// if need content
+	NeedContent          bool     `protobuf:"varint,3,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockID) Reset()         { *m = BlockID{} }
+func (m *BlockID) String() string { return proto.CompactTextString(m) }
+func (*BlockID) ProtoMessage()    {}
+func (*BlockID) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{11}
 }
 
-func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddressStatus.Unmarshal(m, b)
+func (m *BlockID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockID.Unmarshal(m, b)
 }
-func (m *AddressStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddressStatus.Marshal(b, m, deterministic)
+func (m *BlockID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockID.Marshal(b, m, deterministic)
 }
-func (m *AddressStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddressStatus.Merge(m, src)
+func (m *BlockID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockID.Merge(m, src)
 }
-func (m *AddressStatus) XXX_Size() int {
-	return xxx_messageInfo_AddressStatus.Size(m)
+func (m *BlockID) XXX_Size() int {
+	return xxx_messageInfo_BlockID.Size(m)
 }
-func (m *AddressStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddressStatus.DiscardUnknown(m)
+func (m *BlockID) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockID.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddressStatus proto.InternalMessageInfo
+var xxx_messageInfo_BlockID proto.InternalMessageInfo
 
-func (m *AddressStatus) GetHeader() *Header {
+func (m *BlockID) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *AddressStatus) GetAddress() string {
+func (m *BlockID) GetBcname() string {
 	if m != nil {
-		return m.Address
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *AddressStatus) GetBcs() []*TokenDetail {
+func (m *BlockID) GetBlockid() []byte {
 	if m != nil {
-		return m.Bcs
+		return m.Blockid
 	}
 	return nil
 }
 
-type TokenFrozenDetail struct {
-	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	IsFrozen             bool     `protobuf:"varint,2,opt,name=isFrozen,proto3" json:"isFrozen,omitempty"`
+func (m *BlockID) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
+}
+
+type BlockHeight struct {
+	Header               *Header  `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TokenFrozenDetail) Reset()         { *m = TokenFrozenDetail{} }
-func (m *TokenFrozenDetail) String() string { return proto.CompactTextString(m) }
-func (*TokenFrozenDetail) ProtoMessage()    {}
-func (*TokenFrozenDetail) Descriptor() ([]byte, []int) {
+func (m *BlockHeight) Reset()         { *m = BlockHeight{} }
+func (m *BlockHeight) String() string { return proto.CompactTextString(m) }
+func (*BlockHeight) ProtoMessage()    {}
+func (*BlockHeight) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{12}
 }
 
-func (m *TokenFrozenDetail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TokenFrozenDetail.Unmarshal(m, b)
+func (m *BlockHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeight.Unmarshal(m, b)
 }
-func (m *TokenFrozenDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TokenFrozenDetail.Marshal(b, m, deterministic)
+func (m *BlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeight.Marshal(b, m, deterministic)
 }
-func (m *TokenFrozenDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenFrozenDetail.Merge(m, src)
+func (m *BlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeight.Merge(m, src)
 }
-func (m *TokenFrozenDetail) XXX_Size() int {
-	return xxx_messageInfo_TokenFrozenDetail.Size(m)
+func (m *BlockHeight) XXX_Size() int {
+	return xxx_messageInfo_BlockHeight.Size(m)
 }
-func (m *TokenFrozenDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenFrozenDetail.DiscardUnknown(m)
+func (m *BlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenFrozenDetail proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeight proto.InternalMessageInfo
 
-func (m *TokenFrozenDetail) GetBalance() string {
+func (m *BlockHeight) GetHeader() *Header {
 	if m != nil {
-		return m.Balance
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockHeight) GetBcname() string {
+	if m != nil {
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TokenFrozenDetail) GetIsFrozen() bool {
+func (m *BlockHeight) GetHeight() int64 {
 	if m != nil {
-		return m.IsFrozen
+		return m.Height
 	}
-	return false
+	return 0
 }
 
-type TokenFrozenDetails struct {
-	Bcname               string               `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Tfd                  []*TokenFrozenDetail `protobuf:"bytes,2,rep,name=tfd,proto3" json:"tfd,omitempty"`
-	Error                XChainErrorEnum      `protobuf:"varint,3,opt,name=error,proto3,enum=pb.XChainErrorEnum" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type BlockHeadersRequest struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// start height, inclusive
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end height, inclusive; heights beyond the trunk are clamped to the trunk
+	EndHeight            int64    `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TokenFrozenDetails) Reset()         { *m = TokenFrozenDetails{} }
-func (m *TokenFrozenDetails) String() string { return proto.CompactTextString(m) }
-func (*TokenFrozenDetails) ProtoMessage()    {}
-func (*TokenFrozenDetails) Descriptor() ([]byte, []int) {
+func (m *BlockHeadersRequest) Reset()         { *m = BlockHeadersRequest{} }
+func (m *BlockHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockHeadersRequest) ProtoMessage()    {}
+func (*BlockHeadersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{13}
 }
 
-func (m *TokenFrozenDetails) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TokenFrozenDetails.Unmarshal(m, b)
+func (m *BlockHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeadersRequest.Unmarshal(m, b)
 }
-func (m *TokenFrozenDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TokenFrozenDetails.Marshal(b, m, deterministic)
+func (m *BlockHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeadersRequest.Marshal(b, m, deterministic)
 }
-func (m *TokenFrozenDetails) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenFrozenDetails.Merge(m, src)
+func (m *BlockHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeadersRequest.Merge(m, src)
 }
-func (m *TokenFrozenDetails) XXX_Size() int {
-	return xxx_messageInfo_TokenFrozenDetails.Size(m)
+func (m *BlockHeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockHeadersRequest.Size(m)
 }
-func (m *TokenFrozenDetails) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenFrozenDetails.DiscardUnknown(m)
+func (m *BlockHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeadersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenFrozenDetails proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeadersRequest proto.InternalMessageInfo
 
-func (m *TokenFrozenDetails) GetBcname() string {
+func (m *BlockHeadersRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockHeadersRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TokenFrozenDetails) GetTfd() []*TokenFrozenDetail {
+func (m *BlockHeadersRequest) GetStartHeight() int64 {
 	if m != nil {
-		return m.Tfd
+		return m.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *TokenFrozenDetails) GetError() XChainErrorEnum {
+func (m *BlockHeadersRequest) GetEndHeight() int64 {
 	if m != nil {
-		return m.Error
+		return m.EndHeight
 	}
-	return XChainErrorEnum_SUCCESS
+	return 0
 }
 
-type AddressBalanceStatus struct {
-	Header               *Header               `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Address              string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Tfds                 []*TokenFrozenDetails `protobuf:"bytes,3,rep,name=tfds,proto3" json:"tfds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// BlockHeader 区块头，包含计算blockid所需的全部字段，不含交易内容
+// 字段编号与InternalBlock保持一致，序列化结果可以直接按InternalBlock解析
+type BlockHeader struct {
+	Version              int32             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Nonce                int32             `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Blockid              []byte            `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	PreHash              []byte            `protobuf:"bytes,4,opt,name=pre_hash,json=preHash,proto3" json:"pre_hash,omitempty"`
+	Proposer             []byte            `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Sign                 []byte            `protobuf:"bytes,6,opt,name=sign,proto3" json:"sign,omitempty"`
+	Pubkey               []byte            `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	MerkleRoot           []byte            `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Height               int64             `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp            int64             `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TxCount              int32             `protobuf:"varint,12,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	CurTerm              int64             `protobuf:"varint,16,opt,name=cur_term,json=curTerm,proto3" json:"cur_term,omitempty"`
+	CurBlockNum          int64             `protobuf:"varint,17,opt,name=cur_block_num,json=curBlockNum,proto3" json:"cur_block_num,omitempty"`
+	FailedTxs            map[string]string `protobuf:"bytes,18,rep,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TargetBits           int32             `protobuf:"varint,19,opt,name=target_bits,json=targetBits,proto3" json:"target_bits,omitempty"`
+	Justify              *QuorumCert       `protobuf:"bytes,20,opt,name=justify,proto3" json:"justify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AddressBalanceStatus) Reset()         { *m = AddressBalanceStatus{} }
-func (m *AddressBalanceStatus) String() string { return proto.CompactTextString(m) }
-func (*AddressBalanceStatus) ProtoMessage()    {}
-func (*AddressBalanceStatus) Descriptor() ([]byte, []int) {
+func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
+func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BlockHeader) ProtoMessage()    {}
+func (*BlockHeader) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{14}
 }
 
-func (m *AddressBalanceStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddressBalanceStatus.Unmarshal(m, b)
+func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
 }
-func (m *AddressBalanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddressBalanceStatus.Marshal(b, m, deterministic)
+func (m *BlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeader.Marshal(b, m, deterministic)
 }
-func (m *AddressBalanceStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddressBalanceStatus.Merge(m, src)
+func (m *BlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeader.Merge(m, src)
 }
-func (m *AddressBalanceStatus) XXX_Size() int {
-	return xxx_messageInfo_AddressBalanceStatus.Size(m)
+func (m *BlockHeader) XXX_Size() int {
+	return xxx_messageInfo_BlockHeader.Size(m)
 }
-func (m *AddressBalanceStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddressBalanceStatus.DiscardUnknown(m)
+func (m *BlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeader.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddressBalanceStatus proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeader proto.InternalMessageInfo
 
-func (m *AddressBalanceStatus) GetHeader() *Header {
+func (m *BlockHeader) GetVersion() int32 {
 	if m != nil {
-		return m.Header
+		return m.Version
 	}
-	return nil
+	return 0
 }
 
-func (m *AddressBalanceStatus) GetAddress() string {
+func (m *BlockHeader) GetNonce() int32 {
 	if m != nil {
-		return m.Address
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (m *AddressBalanceStatus) GetTfds() []*TokenFrozenDetails {
+func (m *BlockHeader) GetBlockid() []byte {
 	if m != nil {
-		return m.Tfds
+		return m.Blockid
 	}
 	return nil
 }
 
-// Transaction input
-type TxInput struct {
-	// The transaction id referenced to
-	RefTxid []byte `protobuf:"bytes,1,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
-	// The output offset of the transaction referenced to
-	RefOffset int32 `protobuf:"varint,2,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
-	// The address of the launcher
-	FromAddr []byte `protobuf:"bytes,5,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
-	// The amount of the transaction
-	Amount []byte `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Frozen height
-	FrozenHeight         int64    `protobuf:"varint,7,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TxInput) Reset()         { *m = TxInput{} }
-func (m *TxInput) String() string { return proto.CompactTextString(m) }
-func (*TxInput) ProtoMessage()    {}
-func (*TxInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{15}
-}
-
-func (m *TxInput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxInput.Unmarshal(m, b)
-}
-func (m *TxInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxInput.Marshal(b, m, deterministic)
-}
-func (m *TxInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxInput.Merge(m, src)
-}
-func (m *TxInput) XXX_Size() int {
-	return xxx_messageInfo_TxInput.Size(m)
-}
-func (m *TxInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxInput.DiscardUnknown(m)
+func (m *BlockHeader) GetPreHash() []byte {
+	if m != nil {
+		return m.PreHash
+	}
+	return nil
 }
 
-var xxx_messageInfo_TxInput proto.InternalMessageInfo
-
-func (m *TxInput) GetRefTxid() []byte {
+func (m *BlockHeader) GetProposer() []byte {
 	if m != nil {
-		return m.RefTxid
+		return m.Proposer
 	}
 	return nil
 }
 
-func (m *TxInput) GetRefOffset() int32 {
+func (m *BlockHeader) GetSign() []byte {
 	if m != nil {
-		return m.RefOffset
+		return m.Sign
 	}
-	return 0
+	return nil
 }
 
-func (m *TxInput) GetFromAddr() []byte {
+func (m *BlockHeader) GetPubkey() []byte {
 	if m != nil {
-		return m.FromAddr
+		return m.Pubkey
 	}
 	return nil
 }
 
-func (m *TxInput) GetAmount() []byte {
+func (m *BlockHeader) GetMerkleRoot() []byte {
 	if m != nil {
-		return m.Amount
+		return m.MerkleRoot
 	}
 	return nil
 }
 
-func (m *TxInput) GetFrozenHeight() int64 {
+func (m *BlockHeader) GetHeight() int64 {
 	if m != nil {
-		return m.FrozenHeight
+		return m.Height
 	}
 	return 0
 }
 
-// Transaction output
-type TxOutput struct {
-	// The amount of the transaction
-	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The address of the launcher
-	ToAddr []byte `protobuf:"bytes,2,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
-	// Fronzen height
-	FrozenHeight         int64    `protobuf:"varint,4,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BlockHeader) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *TxOutput) Reset()         { *m = TxOutput{} }
-func (m *TxOutput) String() string { return proto.CompactTextString(m) }
-func (*TxOutput) ProtoMessage()    {}
-func (*TxOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{16}
+func (m *BlockHeader) GetTxCount() int32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
 }
 
-func (m *TxOutput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxOutput.Unmarshal(m, b)
-}
-func (m *TxOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxOutput.Marshal(b, m, deterministic)
-}
-func (m *TxOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxOutput.Merge(m, src)
-}
-func (m *TxOutput) XXX_Size() int {
-	return xxx_messageInfo_TxOutput.Size(m)
-}
-func (m *TxOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxOutput.DiscardUnknown(m)
+func (m *BlockHeader) GetCurTerm() int64 {
+	if m != nil {
+		return m.CurTerm
+	}
+	return 0
 }
 
-var xxx_messageInfo_TxOutput proto.InternalMessageInfo
-
-func (m *TxOutput) GetAmount() []byte {
+func (m *BlockHeader) GetCurBlockNum() int64 {
 	if m != nil {
-		return m.Amount
+		return m.CurBlockNum
 	}
-	return nil
+	return 0
 }
 
-func (m *TxOutput) GetToAddr() []byte {
+func (m *BlockHeader) GetFailedTxs() map[string]string {
 	if m != nil {
-		return m.ToAddr
+		return m.FailedTxs
 	}
 	return nil
 }
 
-func (m *TxOutput) GetFrozenHeight() int64 {
+func (m *BlockHeader) GetTargetBits() int32 {
 	if m != nil {
-		return m.FrozenHeight
+		return m.TargetBits
 	}
 	return 0
 }
 
-// Unified Xuper Signature
-type XuperSignature struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BlockHeader) GetJustify() *QuorumCert {
+	if m != nil {
+		return m.Justify
+	}
+	return nil
 }
 
-func (m *XuperSignature) Reset()         { *m = XuperSignature{} }
-func (m *XuperSignature) String() string { return proto.CompactTextString(m) }
-func (*XuperSignature) ProtoMessage()    {}
-func (*XuperSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{17}
+type BlockHeaders struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string         `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Headers              []*BlockHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *XuperSignature) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_XuperSignature.Unmarshal(m, b)
+func (m *BlockHeaders) Reset()         { *m = BlockHeaders{} }
+func (m *BlockHeaders) String() string { return proto.CompactTextString(m) }
+func (*BlockHeaders) ProtoMessage()    {}
+func (*BlockHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{15}
 }
-func (m *XuperSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_XuperSignature.Marshal(b, m, deterministic)
+
+func (m *BlockHeaders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeaders.Unmarshal(m, b)
 }
-func (m *XuperSignature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_XuperSignature.Merge(m, src)
+func (m *BlockHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeaders.Marshal(b, m, deterministic)
 }
-func (m *XuperSignature) XXX_Size() int {
-	return xxx_messageInfo_XuperSignature.Size(m)
+func (m *BlockHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeaders.Merge(m, src)
 }
-func (m *XuperSignature) XXX_DiscardUnknown() {
-	xxx_messageInfo_XuperSignature.DiscardUnknown(m)
+func (m *BlockHeaders) XXX_Size() int {
+	return xxx_messageInfo_BlockHeaders.Size(m)
+}
+func (m *BlockHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeaders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_XuperSignature proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeaders proto.InternalMessageInfo
 
-func (m *XuperSignature) GetPublicKeys() [][]byte {
+func (m *BlockHeaders) GetHeader() *Header {
 	if m != nil {
-		return m.PublicKeys
+		return m.Header
 	}
 	return nil
 }
 
-func (m *XuperSignature) GetSignature() []byte {
+func (m *BlockHeaders) GetBcname() string {
 	if m != nil {
-		return m.Signature
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockHeaders) GetHeaders() []*BlockHeader {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-// Transaction is the information of the transaction
-type Transaction struct {
-	// txid is the id of this transaction
-	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	// the blockid the transaction belong to
-	Blockid []byte `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
-	// Transaction input list
-	TxInputs []*TxInput `protobuf:"bytes,3,rep,name=tx_inputs,json=txInputs,proto3" json:"tx_inputs,omitempty"`
-	// Transaction output list
-	TxOutputs []*TxOutput `protobuf:"bytes,4,rep,name=tx_outputs,json=txOutputs,proto3" json:"tx_outputs,omitempty"`
-	// Transaction description or system contract
-	Desc []byte `protobuf:"bytes,6,opt,name=desc,proto3" json:"desc,omitempty"`
-	// Mining rewards
-	Coinbase bool `protobuf:"varint,7,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
-	// Random number used to avoid replay attacks
-	Nonce string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// Timestamp to launch the transaction
-	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// tx format version; tx格式版本号
-	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
-	// auto generated tx
-	Autogen          bool             `protobuf:"varint,11,opt,name=autogen,proto3" json:"autogen,omitempty"`
-	TxInputsExt      []*TxInputExt    `protobuf:"bytes,23,rep,name=tx_inputs_ext,json=txInputsExt,proto3" json:"tx_inputs_ext,omitempty"`
-	TxOutputsExt     []*TxOutputExt   `protobuf:"bytes,24,rep,name=tx_outputs_ext,json=txOutputsExt,proto3" json:"tx_outputs_ext,omitempty"`
-	ContractRequests []*InvokeRequest `protobuf:"bytes,25,rep,name=contract_requests,json=contractRequests,proto3" json:"contract_requests,omitempty"`
-	// 权限系统新增字段
-	// 交易发起者, 可以是一个Address或者一个Account
-	Initiator string `protobuf:"bytes,26,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	// 交易发起需要被收集签名的AddressURL集合信息，包括用于utxo转账和用于合约调用
-	AuthRequire []string `protobuf:"bytes,27,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
-	// 交易发起者对交易元数据签名，签名的内容包括auth_require字段
-	InitiatorSigns []*SignatureInfo `protobuf:"bytes,28,rep,name=initiator_signs,json=initiatorSigns,proto3" json:"initiator_signs,omitempty"`
-	// 收集到的签名
-	AuthRequireSigns []*SignatureInfo `protobuf:"bytes,29,rep,name=auth_require_signs,json=authRequireSigns,proto3" json:"auth_require_signs,omitempty"`
-	// 节点收到tx的时间戳，不参与签名
-	ReceivedTimestamp int64 `protobuf:"varint,30,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
-	// 统一签名(支持多重签名/环签名等，与initiator_signs/auth_require_signs不同时使用)
-	XuperSign *XuperSignature `protobuf:"bytes,31,opt,name=xuper_sign,json=xuperSign,proto3" json:"xuper_sign,omitempty"`
-	// 可修改区块链标记
-	ModifyBlock *ModifyBlock `protobuf:"bytes,32,opt,name=modify_block,json=modifyBlock,proto3" json:"modify_block,omitempty"`
-	// HD加解密相关信息
-	HDInfo               *HDInfo  `protobuf:"bytes,33,opt,name=HD_info,json=HDInfo,proto3" json:"HD_info,omitempty"`
+type ContractEventsRequest struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// 过滤条件为正则表达式，与EventService.Subscribe的BlockFilter规则一致，为空时不过滤
+	Contract  string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName string `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator string `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// start height, inclusive
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end height, inclusive; heights beyond the trunk are clamped to the trunk
+	EndHeight            int64    `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Skip                 int64    `protobuf:"varint,8,opt,name=skip,proto3" json:"skip,omitempty"`
+	Limit                int64    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Transaction) Reset()         { *m = Transaction{} }
-func (m *Transaction) String() string { return proto.CompactTextString(m) }
-func (*Transaction) ProtoMessage()    {}
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{18}
+func (m *ContractEventsRequest) Reset()         { *m = ContractEventsRequest{} }
+func (m *ContractEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractEventsRequest) ProtoMessage()    {}
+func (*ContractEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{16}
 }
 
-func (m *Transaction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+func (m *ContractEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventsRequest.Unmarshal(m, b)
 }
-func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+func (m *ContractEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *Transaction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction.Merge(m, src)
+func (m *ContractEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventsRequest.Merge(m, src)
 }
-func (m *Transaction) XXX_Size() int {
-	return xxx_messageInfo_Transaction.Size(m)
+func (m *ContractEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ContractEventsRequest.Size(m)
 }
-func (m *Transaction) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction.DiscardUnknown(m)
+func (m *ContractEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transaction proto.InternalMessageInfo
+var xxx_messageInfo_ContractEventsRequest proto.InternalMessageInfo
 
-func (m *Transaction) GetTxid() []byte {
+func (m *ContractEventsRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Txid
+		return m.Header
 	}
 	return nil
 }
 
-func (m *Transaction) GetBlockid() []byte {
+func (m *ContractEventsRequest) GetBcname() string {
 	if m != nil {
-		return m.Blockid
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *Transaction) GetTxInputs() []*TxInput {
+func (m *ContractEventsRequest) GetContract() string {
 	if m != nil {
-		return m.TxInputs
+		return m.Contract
 	}
-	return nil
+	return ""
 }
 
-func (m *Transaction) GetTxOutputs() []*TxOutput {
+func (m *ContractEventsRequest) GetEventName() string {
 	if m != nil {
-		return m.TxOutputs
+		return m.EventName
 	}
-	return nil
+	return ""
 }
 
-func (m *Transaction) GetDesc() []byte {
+func (m *ContractEventsRequest) GetInitiator() string {
 	if m != nil {
-		return m.Desc
+		return m.Initiator
 	}
-	return nil
+	return ""
 }
 
-func (m *Transaction) GetCoinbase() bool {
+func (m *ContractEventsRequest) GetStartHeight() int64 {
 	if m != nil {
-		return m.Coinbase
+		return m.StartHeight
 	}
-	return false
+	return 0
 }
 
-func (m *Transaction) GetNonce() string {
+func (m *ContractEventsRequest) GetEndHeight() int64 {
 	if m != nil {
-		return m.Nonce
+		return m.EndHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *Transaction) GetTimestamp() int64 {
+func (m *ContractEventsRequest) GetSkip() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.Skip
 	}
 	return 0
 }
 
-func (m *Transaction) GetVersion() int32 {
+func (m *ContractEventsRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Version
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *Transaction) GetAutogen() bool {
+type ContractEventInfo struct {
+	Height               int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Blockid              []byte         `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Txid                 []byte         `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Event                *ContractEvent `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ContractEventInfo) Reset()         { *m = ContractEventInfo{} }
+func (m *ContractEventInfo) String() string { return proto.CompactTextString(m) }
+func (*ContractEventInfo) ProtoMessage()    {}
+func (*ContractEventInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{17}
+}
+
+func (m *ContractEventInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventInfo.Unmarshal(m, b)
+}
+func (m *ContractEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventInfo.Marshal(b, m, deterministic)
+}
+func (m *ContractEventInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventInfo.Merge(m, src)
+}
+func (m *ContractEventInfo) XXX_Size() int {
+	return xxx_messageInfo_ContractEventInfo.Size(m)
+}
+func (m *ContractEventInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventInfo proto.InternalMessageInfo
+
+func (m *ContractEventInfo) GetHeight() int64 {
 	if m != nil {
-		return m.Autogen
+		return m.Height
 	}
-	return false
+	return 0
 }
 
-func (m *Transaction) GetTxInputsExt() []*TxInputExt {
+func (m *ContractEventInfo) GetBlockid() []byte {
 	if m != nil {
-		return m.TxInputsExt
+		return m.Blockid
 	}
 	return nil
 }
 
-func (m *Transaction) GetTxOutputsExt() []*TxOutputExt {
+func (m *ContractEventInfo) GetTxid() []byte {
 	if m != nil {
-		return m.TxOutputsExt
+		return m.Txid
 	}
 	return nil
 }
 
-func (m *Transaction) GetContractRequests() []*InvokeRequest {
+func (m *ContractEventInfo) GetEvent() *ContractEvent {
 	if m != nil {
-		return m.ContractRequests
+		return m.Event
 	}
 	return nil
 }
 
-func (m *Transaction) GetInitiator() string {
-	if m != nil {
-		return m.Initiator
-	}
-	return ""
+type ContractEventsResponse struct {
+	Header               *Header              `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string               `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Events               []*ContractEventInfo `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	HasMore              bool                 `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextHeight           int64                `protobuf:"varint,5,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	NextSkip             int64                `protobuf:"varint,6,opt,name=next_skip,json=nextSkip,proto3" json:"next_skip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Transaction) GetAuthRequire() []string {
-	if m != nil {
-		return m.AuthRequire
-	}
-	return nil
+func (m *ContractEventsResponse) Reset()         { *m = ContractEventsResponse{} }
+func (m *ContractEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractEventsResponse) ProtoMessage()    {}
+func (*ContractEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{18}
 }
 
-func (m *Transaction) GetInitiatorSigns() []*SignatureInfo {
+func (m *ContractEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventsResponse.Unmarshal(m, b)
+}
+func (m *ContractEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ContractEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventsResponse.Merge(m, src)
+}
+func (m *ContractEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ContractEventsResponse.Size(m)
+}
+func (m *ContractEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventsResponse proto.InternalMessageInfo
+
+func (m *ContractEventsResponse) GetHeader() *Header {
 	if m != nil {
-		return m.InitiatorSigns
+		return m.Header
 	}
 	return nil
 }
 
-func (m *Transaction) GetAuthRequireSigns() []*SignatureInfo {
+func (m *ContractEventsResponse) GetBcname() string {
 	if m != nil {
-		return m.AuthRequireSigns
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *Transaction) GetReceivedTimestamp() int64 {
+func (m *ContractEventsResponse) GetEvents() []*ContractEventInfo {
 	if m != nil {
-		return m.ReceivedTimestamp
+		return m.Events
 	}
-	return 0
+	return nil
 }
 
-func (m *Transaction) GetXuperSign() *XuperSignature {
+func (m *ContractEventsResponse) GetHasMore() bool {
 	if m != nil {
-		return m.XuperSign
+		return m.HasMore
 	}
-	return nil
+	return false
 }
 
-func (m *Transaction) GetModifyBlock() *ModifyBlock {
+func (m *ContractEventsResponse) GetNextHeight() int64 {
 	if m != nil {
-		return m.ModifyBlock
+		return m.NextHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *Transaction) GetHDInfo() *HDInfo {
+func (m *ContractEventsResponse) GetNextSkip() int64 {
 	if m != nil {
-		return m.HDInfo
+		return m.NextSkip
 	}
-	return nil
+	return 0
 }
 
-// Ledger metadata
-type LedgerMeta struct {
-	// root block id
-	RootBlockid []byte `protobuf:"bytes,1,opt,name=root_blockid,json=rootBlockid,proto3" json:"root_blockid,omitempty"`
-	// tip block id
-	TipBlockid []byte `protobuf:"bytes,2,opt,name=tip_blockid,json=tipBlockid,proto3" json:"tip_blockid,omitempty"`
-	// the height of the trunk
-	TrunkHeight          int64    `protobuf:"varint,3,opt,name=trunk_height,json=trunkHeight,proto3" json:"trunk_height,omitempty"`
+type BlockRangeRequest struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// start height, inclusive
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end height, inclusive; heights beyond the trunk are clamped to the trunk
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// if need content
+	NeedContent          bool     `protobuf:"varint,5,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LedgerMeta) Reset()         { *m = LedgerMeta{} }
-func (m *LedgerMeta) String() string { return proto.CompactTextString(m) }
-func (*LedgerMeta) ProtoMessage()    {}
-func (*LedgerMeta) Descriptor() ([]byte, []int) {
+func (m *BlockRangeRequest) Reset()         { *m = BlockRangeRequest{} }
+func (m *BlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeRequest) ProtoMessage()    {}
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{19}
 }
 
-func (m *LedgerMeta) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LedgerMeta.Unmarshal(m, b)
+func (m *BlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeRequest.Unmarshal(m, b)
 }
-func (m *LedgerMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LedgerMeta.Marshal(b, m, deterministic)
+func (m *BlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeRequest.Marshal(b, m, deterministic)
 }
-func (m *LedgerMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LedgerMeta.Merge(m, src)
+func (m *BlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeRequest.Merge(m, src)
 }
-func (m *LedgerMeta) XXX_Size() int {
-	return xxx_messageInfo_LedgerMeta.Size(m)
+func (m *BlockRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeRequest.Size(m)
 }
-func (m *LedgerMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_LedgerMeta.DiscardUnknown(m)
+func (m *BlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LedgerMeta proto.InternalMessageInfo
+var xxx_messageInfo_BlockRangeRequest proto.InternalMessageInfo
 
-func (m *LedgerMeta) GetRootBlockid() []byte {
+func (m *BlockRangeRequest) GetHeader() *Header {
 	if m != nil {
-		return m.RootBlockid
+		return m.Header
 	}
 	return nil
 }
 
-func (m *LedgerMeta) GetTipBlockid() []byte {
+func (m *BlockRangeRequest) GetBcname() string {
 	if m != nil {
-		return m.TipBlockid
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *LedgerMeta) GetTrunkHeight() int64 {
+func (m *BlockRangeRequest) GetStartHeight() int64 {
 	if m != nil {
-		return m.TrunkHeight
+		return m.StartHeight
 	}
 	return 0
 }
 
-// Utxo metadata
-type UtxoMeta struct {
-	LatestBlockid            []byte           `protobuf:"bytes,1,opt,name=latest_blockid,json=latestBlockid,proto3" json:"latest_blockid,omitempty"`
-	LockKeyList              []string         `protobuf:"bytes,2,rep,name=lock_key_list,json=lockKeyList,proto3" json:"lock_key_list,omitempty"`
-	UtxoTotal                string           `protobuf:"bytes,3,opt,name=utxo_total,json=utxoTotal,proto3" json:"utxo_total,omitempty"`
-	AvgDelay                 int64            `protobuf:"varint,4,opt,name=avgDelay,proto3" json:"avgDelay,omitempty"`
-	UnconfirmTxAmount        int64            `protobuf:"varint,5,opt,name=unconfirmTxAmount,proto3" json:"unconfirmTxAmount,omitempty"`
-	MaxBlockSize             int64            `protobuf:"varint,6,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
-	ReservedContracts        []*InvokeRequest `protobuf:"bytes,7,rep,name=reserved_contracts,json=reservedContracts,proto3" json:"reserved_contracts,omitempty"`
-	ForbiddenContract        *InvokeRequest   `protobuf:"bytes,8,opt,name=forbidden_contract,json=forbiddenContract,proto3" json:"forbidden_contract,omitempty"`
-	NewAccountResourceAmount int64            `protobuf:"varint,9,opt,name=new_account_resource_amount,json=newAccountResourceAmount,proto3" json:"new_account_resource_amount,omitempty"`
-	IrreversibleBlockHeight  int64            `protobuf:"varint,10,opt,name=irreversibleBlockHeight,proto3" json:"irreversibleBlockHeight,omitempty"`
-	IrreversibleSlideWindow  int64            `protobuf:"varint,11,opt,name=irreversibleSlideWindow,proto3" json:"irreversibleSlideWindow,omitempty"`
-	GasPrice                 *GasPrice        `protobuf:"bytes,12,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
-	GroupChainContract       *InvokeRequest   `protobuf:"bytes,13,opt,name=group_chain_contract,json=groupChainContract,proto3" json:"group_chain_contract,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}         `json:"-"`
-	XXX_unrecognized         []byte           `json:"-"`
-	XXX_sizecache            int32            `json:"-"`
+func (m *BlockRangeRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *UtxoMeta) Reset()         { *m = UtxoMeta{} }
-func (m *UtxoMeta) String() string { return proto.CompactTextString(m) }
-func (*UtxoMeta) ProtoMessage()    {}
-func (*UtxoMeta) Descriptor() ([]byte, []int) {
+func (m *BlockRangeRequest) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
+}
+
+type CommonReply struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommonReply) Reset()         { *m = CommonReply{} }
+func (m *CommonReply) String() string { return proto.CompactTextString(m) }
+func (*CommonReply) ProtoMessage()    {}
+func (*CommonReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{20}
 }
 
-func (m *UtxoMeta) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoMeta.Unmarshal(m, b)
+func (m *CommonReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonReply.Unmarshal(m, b)
 }
-func (m *UtxoMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoMeta.Marshal(b, m, deterministic)
+func (m *CommonReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonReply.Marshal(b, m, deterministic)
 }
-func (m *UtxoMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoMeta.Merge(m, src)
+func (m *CommonReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonReply.Merge(m, src)
 }
-func (m *UtxoMeta) XXX_Size() int {
-	return xxx_messageInfo_UtxoMeta.Size(m)
+func (m *CommonReply) XXX_Size() int {
+	return xxx_messageInfo_CommonReply.Size(m)
 }
-func (m *UtxoMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoMeta.DiscardUnknown(m)
+func (m *CommonReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UtxoMeta proto.InternalMessageInfo
+var xxx_messageInfo_CommonReply proto.InternalMessageInfo
 
-func (m *UtxoMeta) GetLatestBlockid() []byte {
+func (m *CommonReply) GetHeader() *Header {
 	if m != nil {
-		return m.LatestBlockid
+		return m.Header
 	}
 	return nil
 }
 
-func (m *UtxoMeta) GetLockKeyList() []string {
-	if m != nil {
-		return m.LockKeyList
-	}
-	return nil
+type CommonIn struct {
+	Header               *Header    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ViewOption           ViewOption `protobuf:"varint,2,opt,name=view_option,json=viewOption,proto3,enum=pb.ViewOption" json:"view_option,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *UtxoMeta) GetUtxoTotal() string {
-	if m != nil {
-		return m.UtxoTotal
-	}
-	return ""
+func (m *CommonIn) Reset()         { *m = CommonIn{} }
+func (m *CommonIn) String() string { return proto.CompactTextString(m) }
+func (*CommonIn) ProtoMessage()    {}
+func (*CommonIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{21}
 }
 
-func (m *UtxoMeta) GetAvgDelay() int64 {
-	if m != nil {
-		return m.AvgDelay
-	}
-	return 0
+func (m *CommonIn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonIn.Unmarshal(m, b)
+}
+func (m *CommonIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonIn.Marshal(b, m, deterministic)
+}
+func (m *CommonIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonIn.Merge(m, src)
+}
+func (m *CommonIn) XXX_Size() int {
+	return xxx_messageInfo_CommonIn.Size(m)
+}
+func (m *CommonIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonIn.DiscardUnknown(m)
 }
 
-func (m *UtxoMeta) GetUnconfirmTxAmount() int64 {
+var xxx_messageInfo_CommonIn proto.InternalMessageInfo
+
+func (m *CommonIn) GetHeader() *Header {
 	if m != nil {
-		return m.UnconfirmTxAmount
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *UtxoMeta) GetMaxBlockSize() int64 {
+func (m *CommonIn) GetViewOption() ViewOption {
 	if m != nil {
-		return m.MaxBlockSize
+		return m.ViewOption
 	}
-	return 0
+	return ViewOption_NONE
 }
 
-func (m *UtxoMeta) GetReservedContracts() []*InvokeRequest {
+type TokenDetail struct {
+	Bcname               string          `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Balance              string          `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Error                XChainErrorEnum `protobuf:"varint,3,opt,name=error,proto3,enum=pb.XChainErrorEnum" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenDetail) Reset()         { *m = TokenDetail{} }
+func (m *TokenDetail) String() string { return proto.CompactTextString(m) }
+func (*TokenDetail) ProtoMessage()    {}
+func (*TokenDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{22}
+}
+
+func (m *TokenDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenDetail.Unmarshal(m, b)
+}
+func (m *TokenDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenDetail.Marshal(b, m, deterministic)
+}
+func (m *TokenDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDetail.Merge(m, src)
+}
+func (m *TokenDetail) XXX_Size() int {
+	return xxx_messageInfo_TokenDetail.Size(m)
+}
+func (m *TokenDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDetail proto.InternalMessageInfo
+
+func (m *TokenDetail) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenDetail) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *TokenDetail) GetError() XChainErrorEnum {
+	if m != nil {
+		return m.Error
+	}
+	return XChainErrorEnum_SUCCESS
+}
+
+type AddressStatus struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Address              string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Bcs                  []*TokenDetail `protobuf:"bytes,3,rep,name=bcs,proto3" json:"bcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
+func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
+func (*AddressStatus) ProtoMessage()    {}
+func (*AddressStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{23}
+}
+
+func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressStatus.Unmarshal(m, b)
+}
+func (m *AddressStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressStatus.Marshal(b, m, deterministic)
+}
+func (m *AddressStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressStatus.Merge(m, src)
+}
+func (m *AddressStatus) XXX_Size() int {
+	return xxx_messageInfo_AddressStatus.Size(m)
+}
+func (m *AddressStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressStatus proto.InternalMessageInfo
+
+func (m *AddressStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressStatus) GetBcs() []*TokenDetail {
+	if m != nil {
+		return m.Bcs
+	}
+	return nil
+}
+
+type TokenFrozenDetail struct {
+	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	IsFrozen             bool     `protobuf:"varint,2,opt,name=isFrozen,proto3" json:"isFrozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenFrozenDetail) Reset()         { *m = TokenFrozenDetail{} }
+func (m *TokenFrozenDetail) String() string { return proto.CompactTextString(m) }
+func (*TokenFrozenDetail) ProtoMessage()    {}
+func (*TokenFrozenDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{24}
+}
+
+func (m *TokenFrozenDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFrozenDetail.Unmarshal(m, b)
+}
+func (m *TokenFrozenDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFrozenDetail.Marshal(b, m, deterministic)
+}
+func (m *TokenFrozenDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFrozenDetail.Merge(m, src)
+}
+func (m *TokenFrozenDetail) XXX_Size() int {
+	return xxx_messageInfo_TokenFrozenDetail.Size(m)
+}
+func (m *TokenFrozenDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFrozenDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFrozenDetail proto.InternalMessageInfo
+
+func (m *TokenFrozenDetail) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *TokenFrozenDetail) GetIsFrozen() bool {
+	if m != nil {
+		return m.IsFrozen
+	}
+	return false
+}
+
+type TokenFrozenDetails struct {
+	Bcname               string               `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Tfd                  []*TokenFrozenDetail `protobuf:"bytes,2,rep,name=tfd,proto3" json:"tfd,omitempty"`
+	Error                XChainErrorEnum      `protobuf:"varint,3,opt,name=error,proto3,enum=pb.XChainErrorEnum" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TokenFrozenDetails) Reset()         { *m = TokenFrozenDetails{} }
+func (m *TokenFrozenDetails) String() string { return proto.CompactTextString(m) }
+func (*TokenFrozenDetails) ProtoMessage()    {}
+func (*TokenFrozenDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{25}
+}
+
+func (m *TokenFrozenDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFrozenDetails.Unmarshal(m, b)
+}
+func (m *TokenFrozenDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFrozenDetails.Marshal(b, m, deterministic)
+}
+func (m *TokenFrozenDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFrozenDetails.Merge(m, src)
+}
+func (m *TokenFrozenDetails) XXX_Size() int {
+	return xxx_messageInfo_TokenFrozenDetails.Size(m)
+}
+func (m *TokenFrozenDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFrozenDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFrozenDetails proto.InternalMessageInfo
+
+func (m *TokenFrozenDetails) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenFrozenDetails) GetTfd() []*TokenFrozenDetail {
+	if m != nil {
+		return m.Tfd
+	}
+	return nil
+}
+
+func (m *TokenFrozenDetails) GetError() XChainErrorEnum {
+	if m != nil {
+		return m.Error
+	}
+	return XChainErrorEnum_SUCCESS
+}
+
+type AddressBalanceStatus struct {
+	Header               *Header               `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Address              string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Tfds                 []*TokenFrozenDetails `protobuf:"bytes,3,rep,name=tfds,proto3" json:"tfds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddressBalanceStatus) Reset()         { *m = AddressBalanceStatus{} }
+func (m *AddressBalanceStatus) String() string { return proto.CompactTextString(m) }
+func (*AddressBalanceStatus) ProtoMessage()    {}
+func (*AddressBalanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{26}
+}
+
+func (m *AddressBalanceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBalanceStatus.Unmarshal(m, b)
+}
+func (m *AddressBalanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBalanceStatus.Marshal(b, m, deterministic)
+}
+func (m *AddressBalanceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBalanceStatus.Merge(m, src)
+}
+func (m *AddressBalanceStatus) XXX_Size() int {
+	return xxx_messageInfo_AddressBalanceStatus.Size(m)
+}
+func (m *AddressBalanceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBalanceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBalanceStatus proto.InternalMessageInfo
+
+func (m *AddressBalanceStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressBalanceStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressBalanceStatus) GetTfds() []*TokenFrozenDetails {
+	if m != nil {
+		return m.Tfds
+	}
+	return nil
+}
+
+type TokenSupplyRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	TopN                 int64    `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenSupplyRequest) Reset()         { *m = TokenSupplyRequest{} }
+func (m *TokenSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenSupplyRequest) ProtoMessage()    {}
+func (*TokenSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{27}
+}
+
+func (m *TokenSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenSupplyRequest.Unmarshal(m, b)
+}
+func (m *TokenSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenSupplyRequest.Marshal(b, m, deterministic)
+}
+func (m *TokenSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSupplyRequest.Merge(m, src)
+}
+func (m *TokenSupplyRequest) XXX_Size() int {
+	return xxx_messageInfo_TokenSupplyRequest.Size(m)
+}
+func (m *TokenSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSupplyRequest proto.InternalMessageInfo
+
+func (m *TokenSupplyRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TokenSupplyRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenSupplyRequest) GetTopN() int64 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+type TokenHolder struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Frozen               string   `protobuf:"bytes,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{28}
+}
+
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenHolder.Unmarshal(m, b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return xxx_messageInfo_TokenHolder.Size(m)
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetFrozen() string {
+	if m != nil {
+		return m.Frozen
+	}
+	return ""
+}
+
+type TokenSupplyResponse struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string         `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Height               int64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Total                string         `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Frozen               string         `protobuf:"bytes,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Circulating          string         `protobuf:"bytes,6,opt,name=circulating,proto3" json:"circulating,omitempty"`
+	HolderCount          int64          `protobuf:"varint,7,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	TopHolders           []*TokenHolder `protobuf:"bytes,8,rep,name=top_holders,json=topHolders,proto3" json:"top_holders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TokenSupplyResponse) Reset()         { *m = TokenSupplyResponse{} }
+func (m *TokenSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenSupplyResponse) ProtoMessage()    {}
+func (*TokenSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{29}
+}
+
+func (m *TokenSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenSupplyResponse.Unmarshal(m, b)
+}
+func (m *TokenSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenSupplyResponse.Marshal(b, m, deterministic)
+}
+func (m *TokenSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSupplyResponse.Merge(m, src)
+}
+func (m *TokenSupplyResponse) XXX_Size() int {
+	return xxx_messageInfo_TokenSupplyResponse.Size(m)
+}
+func (m *TokenSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSupplyResponse proto.InternalMessageInfo
+
+func (m *TokenSupplyResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TokenSupplyResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenSupplyResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TokenSupplyResponse) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *TokenSupplyResponse) GetFrozen() string {
+	if m != nil {
+		return m.Frozen
+	}
+	return ""
+}
+
+func (m *TokenSupplyResponse) GetCirculating() string {
+	if m != nil {
+		return m.Circulating
+	}
+	return ""
+}
+
+func (m *TokenSupplyResponse) GetHolderCount() int64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
+}
+
+func (m *TokenSupplyResponse) GetTopHolders() []*TokenHolder {
+	if m != nil {
+		return m.TopHolders
+	}
+	return nil
+}
+
+// Transaction input
+type TxInput struct {
+	// The transaction id referenced to
+	RefTxid []byte `protobuf:"bytes,1,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
+	// The output offset of the transaction referenced to
+	RefOffset int32 `protobuf:"varint,2,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
+	// The address of the launcher
+	FromAddr []byte `protobuf:"bytes,5,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	// The amount of the transaction
+	Amount []byte `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Frozen height
+	FrozenHeight         int64    `protobuf:"varint,7,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxInput) Reset()         { *m = TxInput{} }
+func (m *TxInput) String() string { return proto.CompactTextString(m) }
+func (*TxInput) ProtoMessage()    {}
+func (*TxInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{30}
+}
+
+func (m *TxInput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxInput.Unmarshal(m, b)
+}
+func (m *TxInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxInput.Marshal(b, m, deterministic)
+}
+func (m *TxInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInput.Merge(m, src)
+}
+func (m *TxInput) XXX_Size() int {
+	return xxx_messageInfo_TxInput.Size(m)
+}
+func (m *TxInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxInput proto.InternalMessageInfo
+
+func (m *TxInput) GetRefTxid() []byte {
+	if m != nil {
+		return m.RefTxid
+	}
+	return nil
+}
+
+func (m *TxInput) GetRefOffset() int32 {
+	if m != nil {
+		return m.RefOffset
+	}
+	return 0
+}
+
+func (m *TxInput) GetFromAddr() []byte {
+	if m != nil {
+		return m.FromAddr
+	}
+	return nil
+}
+
+func (m *TxInput) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TxInput) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+// Transaction output
+type TxOutput struct {
+	// The amount of the transaction
+	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The address of the launcher
+	ToAddr []byte `protobuf:"bytes,2,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	// Fronzen height
+	FrozenHeight         int64    `protobuf:"varint,4,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxOutput) Reset()         { *m = TxOutput{} }
+func (m *TxOutput) String() string { return proto.CompactTextString(m) }
+func (*TxOutput) ProtoMessage()    {}
+func (*TxOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{31}
+}
+
+func (m *TxOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxOutput.Unmarshal(m, b)
+}
+func (m *TxOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxOutput.Marshal(b, m, deterministic)
+}
+func (m *TxOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutput.Merge(m, src)
+}
+func (m *TxOutput) XXX_Size() int {
+	return xxx_messageInfo_TxOutput.Size(m)
+}
+func (m *TxOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxOutput proto.InternalMessageInfo
+
+func (m *TxOutput) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TxOutput) GetToAddr() []byte {
+	if m != nil {
+		return m.ToAddr
+	}
+	return nil
+}
+
+func (m *TxOutput) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+// Unified Xuper Signature
+type XuperSignature struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XuperSignature) Reset()         { *m = XuperSignature{} }
+func (m *XuperSignature) String() string { return proto.CompactTextString(m) }
+func (*XuperSignature) ProtoMessage()    {}
+func (*XuperSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{32}
+}
+
+func (m *XuperSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XuperSignature.Unmarshal(m, b)
+}
+func (m *XuperSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XuperSignature.Marshal(b, m, deterministic)
+}
+func (m *XuperSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XuperSignature.Merge(m, src)
+}
+func (m *XuperSignature) XXX_Size() int {
+	return xxx_messageInfo_XuperSignature.Size(m)
+}
+func (m *XuperSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_XuperSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XuperSignature proto.InternalMessageInfo
+
+func (m *XuperSignature) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *XuperSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Transaction is the information of the transaction
+type Transaction struct {
+	// txid is the id of this transaction
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// the blockid the transaction belong to
+	Blockid []byte `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// Transaction input list
+	TxInputs []*TxInput `protobuf:"bytes,3,rep,name=tx_inputs,json=txInputs,proto3" json:"tx_inputs,omitempty"`
+	// Transaction output list
+	TxOutputs []*TxOutput `protobuf:"bytes,4,rep,name=tx_outputs,json=txOutputs,proto3" json:"tx_outputs,omitempty"`
+	// Transaction description or system contract
+	Desc []byte `protobuf:"bytes,6,opt,name=desc,proto3" json:"desc,omitempty"`
+	// Mining rewards
+	Coinbase bool `protobuf:"varint,7,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// Random number used to avoid replay attacks
+	Nonce string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Timestamp to launch the transaction
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// tx format version; tx格式版本号
+	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// auto generated tx
+	Autogen          bool             `protobuf:"varint,11,opt,name=autogen,proto3" json:"autogen,omitempty"`
+	TxInputsExt      []*TxInputExt    `protobuf:"bytes,23,rep,name=tx_inputs_ext,json=txInputsExt,proto3" json:"tx_inputs_ext,omitempty"`
+	TxOutputsExt     []*TxOutputExt   `protobuf:"bytes,24,rep,name=tx_outputs_ext,json=txOutputsExt,proto3" json:"tx_outputs_ext,omitempty"`
+	ContractRequests []*InvokeRequest `protobuf:"bytes,25,rep,name=contract_requests,json=contractRequests,proto3" json:"contract_requests,omitempty"`
+	// 权限系统新增字段
+	// 交易发起者, 可以是一个Address或者一个Account
+	Initiator string `protobuf:"bytes,26,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// 交易发起需要被收集签名的AddressURL集合信息，包括用于utxo转账和用于合约调用
+	AuthRequire []string `protobuf:"bytes,27,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	// 交易发起者对交易元数据签名，签名的内容包括auth_require字段
+	InitiatorSigns []*SignatureInfo `protobuf:"bytes,28,rep,name=initiator_signs,json=initiatorSigns,proto3" json:"initiator_signs,omitempty"`
+	// 收集到的签名
+	AuthRequireSigns []*SignatureInfo `protobuf:"bytes,29,rep,name=auth_require_signs,json=authRequireSigns,proto3" json:"auth_require_signs,omitempty"`
+	// 节点收到tx的时间戳，不参与签名
+	ReceivedTimestamp int64 `protobuf:"varint,30,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
+	// 统一签名(支持多重签名/环签名等，与initiator_signs/auth_require_signs不同时使用)
+	XuperSign *XuperSignature `protobuf:"bytes,31,opt,name=xuper_sign,json=xuperSign,proto3" json:"xuper_sign,omitempty"`
+	// 可修改区块链标记
+	ModifyBlock *ModifyBlock `protobuf:"bytes,32,opt,name=modify_block,json=modifyBlock,proto3" json:"modify_block,omitempty"`
+	// HD加解密相关信息
+	HDInfo               *HDInfo  `protobuf:"bytes,33,opt,name=HD_info,json=HDInfo,proto3" json:"HD_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{33}
+}
+
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return xxx_messageInfo_Transaction.Size(m)
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *Transaction) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *Transaction) GetTxInputs() []*TxInput {
+	if m != nil {
+		return m.TxInputs
+	}
+	return nil
+}
+
+func (m *Transaction) GetTxOutputs() []*TxOutput {
+	if m != nil {
+		return m.TxOutputs
+	}
+	return nil
+}
+
+func (m *Transaction) GetDesc() []byte {
+	if m != nil {
+		return m.Desc
+	}
+	return nil
+}
+
+func (m *Transaction) GetCoinbase() bool {
+	if m != nil {
+		return m.Coinbase
+	}
+	return false
+}
+
+func (m *Transaction) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *Transaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Transaction) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Transaction) GetAutogen() bool {
+	if m != nil {
+		return m.Autogen
+	}
+	return false
+}
+
+func (m *Transaction) GetTxInputsExt() []*TxInputExt {
+	if m != nil {
+		return m.TxInputsExt
+	}
+	return nil
+}
+
+func (m *Transaction) GetTxOutputsExt() []*TxOutputExt {
+	if m != nil {
+		return m.TxOutputsExt
+	}
+	return nil
+}
+
+func (m *Transaction) GetContractRequests() []*InvokeRequest {
+	if m != nil {
+		return m.ContractRequests
+	}
+	return nil
+}
+
+func (m *Transaction) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *Transaction) GetAuthRequire() []string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return nil
+}
+
+func (m *Transaction) GetInitiatorSigns() []*SignatureInfo {
+	if m != nil {
+		return m.InitiatorSigns
+	}
+	return nil
+}
+
+func (m *Transaction) GetAuthRequireSigns() []*SignatureInfo {
+	if m != nil {
+		return m.AuthRequireSigns
+	}
+	return nil
+}
+
+func (m *Transaction) GetReceivedTimestamp() int64 {
+	if m != nil {
+		return m.ReceivedTimestamp
+	}
+	return 0
+}
+
+func (m *Transaction) GetXuperSign() *XuperSignature {
+	if m != nil {
+		return m.XuperSign
+	}
+	return nil
+}
+
+func (m *Transaction) GetModifyBlock() *ModifyBlock {
+	if m != nil {
+		return m.ModifyBlock
+	}
+	return nil
+}
+
+func (m *Transaction) GetHDInfo() *HDInfo {
+	if m != nil {
+		return m.HDInfo
+	}
+	return nil
+}
+
+// Ledger metadata
+type LedgerMeta struct {
+	// root block id
+	RootBlockid []byte `protobuf:"bytes,1,opt,name=root_blockid,json=rootBlockid,proto3" json:"root_blockid,omitempty"`
+	// tip block id
+	TipBlockid []byte `protobuf:"bytes,2,opt,name=tip_blockid,json=tipBlockid,proto3" json:"tip_blockid,omitempty"`
+	// the height of the trunk
+	TrunkHeight          int64    `protobuf:"varint,3,opt,name=trunk_height,json=trunkHeight,proto3" json:"trunk_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LedgerMeta) Reset()         { *m = LedgerMeta{} }
+func (m *LedgerMeta) String() string { return proto.CompactTextString(m) }
+func (*LedgerMeta) ProtoMessage()    {}
+func (*LedgerMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{34}
+}
+
+func (m *LedgerMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerMeta.Unmarshal(m, b)
+}
+func (m *LedgerMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerMeta.Marshal(b, m, deterministic)
+}
+func (m *LedgerMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerMeta.Merge(m, src)
+}
+func (m *LedgerMeta) XXX_Size() int {
+	return xxx_messageInfo_LedgerMeta.Size(m)
+}
+func (m *LedgerMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerMeta proto.InternalMessageInfo
+
+func (m *LedgerMeta) GetRootBlockid() []byte {
+	if m != nil {
+		return m.RootBlockid
+	}
+	return nil
+}
+
+func (m *LedgerMeta) GetTipBlockid() []byte {
+	if m != nil {
+		return m.TipBlockid
+	}
+	return nil
+}
+
+func (m *LedgerMeta) GetTrunkHeight() int64 {
+	if m != nil {
+		return m.TrunkHeight
+	}
+	return 0
+}
+
+// Utxo metadata
+type UtxoMeta struct {
+	LatestBlockid            []byte           `protobuf:"bytes,1,opt,name=latest_blockid,json=latestBlockid,proto3" json:"latest_blockid,omitempty"`
+	LockKeyList              []string         `protobuf:"bytes,2,rep,name=lock_key_list,json=lockKeyList,proto3" json:"lock_key_list,omitempty"`
+	UtxoTotal                string           `protobuf:"bytes,3,opt,name=utxo_total,json=utxoTotal,proto3" json:"utxo_total,omitempty"`
+	AvgDelay                 int64            `protobuf:"varint,4,opt,name=avgDelay,proto3" json:"avgDelay,omitempty"`
+	UnconfirmTxAmount        int64            `protobuf:"varint,5,opt,name=unconfirmTxAmount,proto3" json:"unconfirmTxAmount,omitempty"`
+	MaxBlockSize             int64            `protobuf:"varint,6,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
+	ReservedContracts        []*InvokeRequest `protobuf:"bytes,7,rep,name=reserved_contracts,json=reservedContracts,proto3" json:"reserved_contracts,omitempty"`
+	ForbiddenContract        *InvokeRequest   `protobuf:"bytes,8,opt,name=forbidden_contract,json=forbiddenContract,proto3" json:"forbidden_contract,omitempty"`
+	NewAccountResourceAmount int64            `protobuf:"varint,9,opt,name=new_account_resource_amount,json=newAccountResourceAmount,proto3" json:"new_account_resource_amount,omitempty"`
+	IrreversibleBlockHeight  int64            `protobuf:"varint,10,opt,name=irreversibleBlockHeight,proto3" json:"irreversibleBlockHeight,omitempty"`
+	IrreversibleSlideWindow  int64            `protobuf:"varint,11,opt,name=irreversibleSlideWindow,proto3" json:"irreversibleSlideWindow,omitempty"`
+	GasPrice                 *GasPrice        `protobuf:"bytes,12,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	GroupChainContract       *InvokeRequest   `protobuf:"bytes,13,opt,name=group_chain_contract,json=groupChainContract,proto3" json:"group_chain_contract,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}         `json:"-"`
+	XXX_unrecognized         []byte           `json:"-"`
+	XXX_sizecache            int32            `json:"-"`
+}
+
+func (m *UtxoMeta) Reset()         { *m = UtxoMeta{} }
+func (m *UtxoMeta) String() string { return proto.CompactTextString(m) }
+func (*UtxoMeta) ProtoMessage()    {}
+func (*UtxoMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{35}
+}
+
+func (m *UtxoMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoMeta.Unmarshal(m, b)
+}
+func (m *UtxoMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoMeta.Marshal(b, m, deterministic)
+}
+func (m *UtxoMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoMeta.Merge(m, src)
+}
+func (m *UtxoMeta) XXX_Size() int {
+	return xxx_messageInfo_UtxoMeta.Size(m)
+}
+func (m *UtxoMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoMeta proto.InternalMessageInfo
+
+func (m *UtxoMeta) GetLatestBlockid() []byte {
+	if m != nil {
+		return m.LatestBlockid
+	}
+	return nil
+}
+
+func (m *UtxoMeta) GetLockKeyList() []string {
+	if m != nil {
+		return m.LockKeyList
+	}
+	return nil
+}
+
+func (m *UtxoMeta) GetUtxoTotal() string {
+	if m != nil {
+		return m.UtxoTotal
+	}
+	return ""
+}
+
+func (m *UtxoMeta) GetAvgDelay() int64 {
+	if m != nil {
+		return m.AvgDelay
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetUnconfirmTxAmount() int64 {
+	if m != nil {
+		return m.UnconfirmTxAmount
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetMaxBlockSize() int64 {
+	if m != nil {
+		return m.MaxBlockSize
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetReservedContracts() []*InvokeRequest {
+	if m != nil {
+		return m.ReservedContracts
+	}
+	return nil
+}
+
+func (m *UtxoMeta) GetForbiddenContract() *InvokeRequest {
+	if m != nil {
+		return m.ForbiddenContract
+	}
+	return nil
+}
+
+func (m *UtxoMeta) GetNewAccountResourceAmount() int64 {
+	if m != nil {
+		return m.NewAccountResourceAmount
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetIrreversibleBlockHeight() int64 {
+	if m != nil {
+		return m.IrreversibleBlockHeight
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetIrreversibleSlideWindow() int64 {
+	if m != nil {
+		return m.IrreversibleSlideWindow
+	}
+	return 0
+}
+
+func (m *UtxoMeta) GetGasPrice() *GasPrice {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *UtxoMeta) GetGroupChainContract() *InvokeRequest {
+	if m != nil {
+		return m.GroupChainContract
+	}
+	return nil
+}
+
+type GasPrice struct {
+	CpuRate              int64    `protobuf:"varint,1,opt,name=cpu_rate,json=cpuRate,proto3" json:"cpu_rate,omitempty"`
+	MemRate              int64    `protobuf:"varint,2,opt,name=mem_rate,json=memRate,proto3" json:"mem_rate,omitempty"`
+	DiskRate             int64    `protobuf:"varint,3,opt,name=disk_rate,json=diskRate,proto3" json:"disk_rate,omitempty"`
+	XfeeRate             int64    `protobuf:"varint,4,opt,name=xfee_rate,json=xfeeRate,proto3" json:"xfee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GasPrice) Reset()         { *m = GasPrice{} }
+func (m *GasPrice) String() string { return proto.CompactTextString(m) }
+func (*GasPrice) ProtoMessage()    {}
+func (*GasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{36}
+}
+
+func (m *GasPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GasPrice.Unmarshal(m, b)
+}
+func (m *GasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GasPrice.Marshal(b, m, deterministic)
+}
+func (m *GasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPrice.Merge(m, src)
+}
+func (m *GasPrice) XXX_Size() int {
+	return xxx_messageInfo_GasPrice.Size(m)
+}
+func (m *GasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPrice proto.InternalMessageInfo
+
+func (m *GasPrice) GetCpuRate() int64 {
+	if m != nil {
+		return m.CpuRate
+	}
+	return 0
+}
+
+func (m *GasPrice) GetMemRate() int64 {
+	if m != nil {
+		return m.MemRate
+	}
+	return 0
+}
+
+func (m *GasPrice) GetDiskRate() int64 {
+	if m != nil {
+		return m.DiskRate
+	}
+	return 0
+}
+
+func (m *GasPrice) GetXfeeRate() int64 {
+	if m != nil {
+		return m.XfeeRate
+	}
+	return 0
+}
+
+// The internal block struct
+type InternalBlock struct {
+	// block version
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Random number used to avoid replay attacks
+	Nonce int32 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// blockid generate the hash sign of the block used by sha256
+	Blockid []byte `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// pre_hash is the parent blockid of the block
+	PreHash []byte `protobuf:"bytes,4,opt,name=pre_hash,json=preHash,proto3" json:"pre_hash,omitempty"`
+	// The miner id
+	Proposer []byte `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// The sign which miner signed: blockid + nonce + timestamp
+	Sign []byte `protobuf:"bytes,6,opt,name=sign,proto3" json:"sign,omitempty"`
+	// The pk of the miner
+	Pubkey []byte `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The Merkle Tree root
+	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// The height of the blockchain
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp of the block
+	Timestamp int64 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Transactions of the block, only txid stored on kv, the detail information
+	// stored in another table
+	Transactions []*Transaction `protobuf:"bytes,11,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// The transaction count of the block
+	TxCount int32 `protobuf:"varint,12,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// 所有交易hash的merkle tree
+	MerkleTree  [][]byte          `protobuf:"bytes,13,rep,name=merkle_tree,json=merkleTree,proto3" json:"merkle_tree,omitempty"`
+	CurTerm     int64             `protobuf:"varint,16,opt,name=curTerm,proto3" json:"curTerm,omitempty"`
+	CurBlockNum int64             `protobuf:"varint,17,opt,name=curBlockNum,proto3" json:"curBlockNum,omitempty"`
+	FailedTxs   map[string]string `protobuf:"bytes,18,rep,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TargetBits  int32             `protobuf:"varint,19,opt,name=targetBits,proto3" json:"targetBits,omitempty"`
+	// Justify used in chained-bft
+	Justify *QuorumCert `protobuf:"bytes,20,opt,name=Justify,proto3" json:"Justify,omitempty"`
+	// 下面的属性会动态变化
+	// If the block is on the trunk
+	InTrunk bool `protobuf:"varint,14,opt,name=in_trunk,json=inTrunk,proto3" json:"in_trunk,omitempty"`
+	// Next next block which on trunk
+	NextHash             []byte   `protobuf:"bytes,15,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InternalBlock) Reset()         { *m = InternalBlock{} }
+func (m *InternalBlock) String() string { return proto.CompactTextString(m) }
+func (*InternalBlock) ProtoMessage()    {}
+func (*InternalBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{37}
+}
+
+func (m *InternalBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalBlock.Unmarshal(m, b)
+}
+func (m *InternalBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalBlock.Marshal(b, m, deterministic)
+}
+func (m *InternalBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalBlock.Merge(m, src)
+}
+func (m *InternalBlock) XXX_Size() int {
+	return xxx_messageInfo_InternalBlock.Size(m)
+}
+func (m *InternalBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalBlock proto.InternalMessageInfo
+
+func (m *InternalBlock) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetNonce() int32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetPreHash() []byte {
+	if m != nil {
+		return m.PreHash
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetTxCount() int32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetMerkleTree() [][]byte {
+	if m != nil {
+		return m.MerkleTree
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetCurTerm() int64 {
+	if m != nil {
+		return m.CurTerm
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetCurBlockNum() int64 {
+	if m != nil {
+		return m.CurBlockNum
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetFailedTxs() map[string]string {
+	if m != nil {
+		return m.FailedTxs
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetTargetBits() int32 {
+	if m != nil {
+		return m.TargetBits
+	}
+	return 0
+}
+
+func (m *InternalBlock) GetJustify() *QuorumCert {
+	if m != nil {
+		return m.Justify
+	}
+	return nil
+}
+
+func (m *InternalBlock) GetInTrunk() bool {
+	if m != nil {
+		return m.InTrunk
+	}
+	return false
+}
+
+func (m *InternalBlock) GetNextHash() []byte {
+	if m != nil {
+		return m.NextHash
+	}
+	return nil
+}
+
+// BlockChain status
+type BCStatus struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// block name
+	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// ledger metadata
+	Meta *LedgerMeta `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The information of the longest block
+	Block *InternalBlock `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// Utox information
+	UtxoMeta *UtxoMeta `protobuf:"bytes,5,opt,name=utxoMeta,proto3" json:"utxoMeta,omitempty"`
+	// Branch info
+	BranchBlockid        []string `protobuf:"bytes,6,rep,name=branchBlockid,proto3" json:"branchBlockid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BCStatus) Reset()         { *m = BCStatus{} }
+func (m *BCStatus) String() string { return proto.CompactTextString(m) }
+func (*BCStatus) ProtoMessage()    {}
+func (*BCStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{38}
+}
+
+func (m *BCStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BCStatus.Unmarshal(m, b)
+}
+func (m *BCStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BCStatus.Marshal(b, m, deterministic)
+}
+func (m *BCStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BCStatus.Merge(m, src)
+}
+func (m *BCStatus) XXX_Size() int {
+	return xxx_messageInfo_BCStatus.Size(m)
+}
+func (m *BCStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BCStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BCStatus proto.InternalMessageInfo
+
+func (m *BCStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BCStatus) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BCStatus) GetMeta() *LedgerMeta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *BCStatus) GetBlock() *InternalBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BCStatus) GetUtxoMeta() *UtxoMeta {
+	if m != nil {
+		return m.UtxoMeta
+	}
+	return nil
+}
+
+func (m *BCStatus) GetBranchBlockid() []string {
+	if m != nil {
+		return m.BranchBlockid
+	}
+	return nil
+}
+
+type BCTipStatus struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	IsTrunkTip           bool     `protobuf:"varint,2,opt,name=is_trunk_tip,json=isTrunkTip,proto3" json:"is_trunk_tip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BCTipStatus) Reset()         { *m = BCTipStatus{} }
+func (m *BCTipStatus) String() string { return proto.CompactTextString(m) }
+func (*BCTipStatus) ProtoMessage()    {}
+func (*BCTipStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{39}
+}
+
+func (m *BCTipStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BCTipStatus.Unmarshal(m, b)
+}
+func (m *BCTipStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BCTipStatus.Marshal(b, m, deterministic)
+}
+func (m *BCTipStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BCTipStatus.Merge(m, src)
+}
+func (m *BCTipStatus) XXX_Size() int {
+	return xxx_messageInfo_BCTipStatus.Size(m)
+}
+func (m *BCTipStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BCTipStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BCTipStatus proto.InternalMessageInfo
+
+func (m *BCTipStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BCTipStatus) GetIsTrunkTip() bool {
+	if m != nil {
+		return m.IsTrunkTip
+	}
+	return false
+}
+
+type BlockChains struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Blockchains          []string `protobuf:"bytes,2,rep,name=blockchains,proto3" json:"blockchains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockChains) Reset()         { *m = BlockChains{} }
+func (m *BlockChains) String() string { return proto.CompactTextString(m) }
+func (*BlockChains) ProtoMessage()    {}
+func (*BlockChains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{40}
+}
+
+func (m *BlockChains) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockChains.Unmarshal(m, b)
+}
+func (m *BlockChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockChains.Marshal(b, m, deterministic)
+}
+func (m *BlockChains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockChains.Merge(m, src)
+}
+func (m *BlockChains) XXX_Size() int {
+	return xxx_messageInfo_BlockChains.Size(m)
+}
+func (m *BlockChains) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockChains.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockChains proto.InternalMessageInfo
+
+func (m *BlockChains) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockChains) GetBlockchains() []string {
+	if m != nil {
+		return m.Blockchains
+	}
+	return nil
+}
+
+type Speeds struct {
+	SumSpeeds            map[string]float64   `protobuf:"bytes,1,rep,name=SumSpeeds,proto3" json:"SumSpeeds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	BcSpeeds             map[string]*BCSpeeds `protobuf:"bytes,2,rep,name=BcSpeeds,proto3" json:"BcSpeeds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Speeds) Reset()         { *m = Speeds{} }
+func (m *Speeds) String() string { return proto.CompactTextString(m) }
+func (*Speeds) ProtoMessage()    {}
+func (*Speeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{41}
+}
+
+func (m *Speeds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Speeds.Unmarshal(m, b)
+}
+func (m *Speeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Speeds.Marshal(b, m, deterministic)
+}
+func (m *Speeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Speeds.Merge(m, src)
+}
+func (m *Speeds) XXX_Size() int {
+	return xxx_messageInfo_Speeds.Size(m)
+}
+func (m *Speeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Speeds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Speeds proto.InternalMessageInfo
+
+func (m *Speeds) GetSumSpeeds() map[string]float64 {
+	if m != nil {
+		return m.SumSpeeds
+	}
+	return nil
+}
+
+func (m *Speeds) GetBcSpeeds() map[string]*BCSpeeds {
+	if m != nil {
+		return m.BcSpeeds
+	}
+	return nil
+}
+
+// BcSpeed的key: tps, block_interval_ms, txs_per_block；SumSpeeds的key: tps
+type BCSpeeds struct {
+	BcSpeed              map[string]float64 `protobuf:"bytes,1,rep,name=BcSpeed,proto3" json:"BcSpeed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BCSpeeds) Reset()         { *m = BCSpeeds{} }
+func (m *BCSpeeds) String() string { return proto.CompactTextString(m) }
+func (*BCSpeeds) ProtoMessage()    {}
+func (*BCSpeeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{42}
+}
+
+func (m *BCSpeeds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BCSpeeds.Unmarshal(m, b)
+}
+func (m *BCSpeeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BCSpeeds.Marshal(b, m, deterministic)
+}
+func (m *BCSpeeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BCSpeeds.Merge(m, src)
+}
+func (m *BCSpeeds) XXX_Size() int {
+	return xxx_messageInfo_BCSpeeds.Size(m)
+}
+func (m *BCSpeeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_BCSpeeds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BCSpeeds proto.InternalMessageInfo
+
+func (m *BCSpeeds) GetBcSpeed() map[string]float64 {
+	if m != nil {
+		return m.BcSpeed
+	}
+	return nil
+}
+
+type SystemsStatus struct {
+	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcsStatus            []*BCStatus `protobuf:"bytes,2,rep,name=bcs_status,json=bcsStatus,proto3" json:"bcs_status,omitempty"`
+	Speeds               *Speeds     `protobuf:"bytes,3,opt,name=speeds,proto3" json:"speeds,omitempty"`
+	PeerUrls             []string    `protobuf:"bytes,4,rep,name=peerUrls,proto3" json:"peerUrls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SystemsStatus) Reset()         { *m = SystemsStatus{} }
+func (m *SystemsStatus) String() string { return proto.CompactTextString(m) }
+func (*SystemsStatus) ProtoMessage()    {}
+func (*SystemsStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{43}
+}
+
+func (m *SystemsStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemsStatus.Unmarshal(m, b)
+}
+func (m *SystemsStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemsStatus.Marshal(b, m, deterministic)
+}
+func (m *SystemsStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemsStatus.Merge(m, src)
+}
+func (m *SystemsStatus) XXX_Size() int {
+	return xxx_messageInfo_SystemsStatus.Size(m)
+}
+func (m *SystemsStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemsStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemsStatus proto.InternalMessageInfo
+
+func (m *SystemsStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SystemsStatus) GetBcsStatus() []*BCStatus {
+	if m != nil {
+		return m.BcsStatus
+	}
+	return nil
+}
+
+func (m *SystemsStatus) GetSpeeds() *Speeds {
+	if m != nil {
+		return m.Speeds
+	}
+	return nil
+}
+
+func (m *SystemsStatus) GetPeerUrls() []string {
+	if m != nil {
+		return m.PeerUrls
+	}
+	return nil
+}
+
+type ChainStatsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Window               int64    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	TopN                 int64    `protobuf:"varint,4,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainStatsRequest) Reset()         { *m = ChainStatsRequest{} }
+func (m *ChainStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainStatsRequest) ProtoMessage()    {}
+func (*ChainStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{44}
+}
+
+func (m *ChainStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainStatsRequest.Unmarshal(m, b)
+}
+func (m *ChainStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *ChainStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatsRequest.Merge(m, src)
+}
+func (m *ChainStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ChainStatsRequest.Size(m)
+}
+func (m *ChainStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatsRequest proto.InternalMessageInfo
+
+func (m *ChainStatsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainStatsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ChainStatsRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ChainStatsRequest) GetTopN() int64 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+type BlockIntervalStats struct {
+	// 相邻区块出块间隔，单位毫秒
+	Min                  int64    `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  int64    `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Avg                  float64  `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`
+	P50                  int64    `protobuf:"varint,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90                  int64    `protobuf:"varint,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99                  int64    `protobuf:"varint,6,opt,name=p99,proto3" json:"p99,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockIntervalStats) Reset()         { *m = BlockIntervalStats{} }
+func (m *BlockIntervalStats) String() string { return proto.CompactTextString(m) }
+func (*BlockIntervalStats) ProtoMessage()    {}
+func (*BlockIntervalStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{45}
+}
+
+func (m *BlockIntervalStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockIntervalStats.Unmarshal(m, b)
+}
+func (m *BlockIntervalStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockIntervalStats.Marshal(b, m, deterministic)
+}
+func (m *BlockIntervalStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockIntervalStats.Merge(m, src)
+}
+func (m *BlockIntervalStats) XXX_Size() int {
+	return xxx_messageInfo_BlockIntervalStats.Size(m)
+}
+func (m *BlockIntervalStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockIntervalStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockIntervalStats proto.InternalMessageInfo
+
+func (m *BlockIntervalStats) GetMin() int64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *BlockIntervalStats) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *BlockIntervalStats) GetAvg() float64 {
+	if m != nil {
+		return m.Avg
+	}
+	return 0
+}
+
+func (m *BlockIntervalStats) GetP50() int64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *BlockIntervalStats) GetP90() int64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *BlockIntervalStats) GetP99() int64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+type ContractInvokeStat struct {
+	ContractName         string   `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	InvokeCount          int64    `protobuf:"varint,2,opt,name=invoke_count,json=invokeCount,proto3" json:"invoke_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractInvokeStat) Reset()         { *m = ContractInvokeStat{} }
+func (m *ContractInvokeStat) String() string { return proto.CompactTextString(m) }
+func (*ContractInvokeStat) ProtoMessage()    {}
+func (*ContractInvokeStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{46}
+}
+
+func (m *ContractInvokeStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractInvokeStat.Unmarshal(m, b)
+}
+func (m *ContractInvokeStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractInvokeStat.Marshal(b, m, deterministic)
+}
+func (m *ContractInvokeStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInvokeStat.Merge(m, src)
+}
+func (m *ContractInvokeStat) XXX_Size() int {
+	return xxx_messageInfo_ContractInvokeStat.Size(m)
+}
+func (m *ContractInvokeStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInvokeStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractInvokeStat proto.InternalMessageInfo
+
+func (m *ContractInvokeStat) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *ContractInvokeStat) GetInvokeCount() int64 {
+	if m != nil {
+		return m.InvokeCount
+	}
+	return 0
+}
+
+type ChainStatsResponse struct {
+	Header               *Header               `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string                `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	StartHeight          int64                 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight            int64                 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	BlockCount           int64                 `protobuf:"varint,5,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	TxCount              int64                 `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Tps                  float64               `protobuf:"fixed64,7,opt,name=tps,proto3" json:"tps,omitempty"`
+	BlockInterval        *BlockIntervalStats   `protobuf:"bytes,8,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	AvgBlockSize         float64               `protobuf:"fixed64,9,opt,name=avg_block_size,json=avgBlockSize,proto3" json:"avg_block_size,omitempty"`
+	AvgTxsPerBlock       float64               `protobuf:"fixed64,10,opt,name=avg_txs_per_block,json=avgTxsPerBlock,proto3" json:"avg_txs_per_block,omitempty"`
+	TopContracts         []*ContractInvokeStat `protobuf:"bytes,11,rep,name=top_contracts,json=topContracts,proto3" json:"top_contracts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChainStatsResponse) Reset()         { *m = ChainStatsResponse{} }
+func (m *ChainStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainStatsResponse) ProtoMessage()    {}
+func (*ChainStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{47}
+}
+
+func (m *ChainStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainStatsResponse.Unmarshal(m, b)
+}
+func (m *ChainStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *ChainStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatsResponse.Merge(m, src)
+}
+func (m *ChainStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ChainStatsResponse.Size(m)
+}
+func (m *ChainStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatsResponse proto.InternalMessageInfo
+
+func (m *ChainStatsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainStatsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ChainStatsResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetBlockCount() int64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetTps() float64 {
+	if m != nil {
+		return m.Tps
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetBlockInterval() *BlockIntervalStats {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return nil
+}
+
+func (m *ChainStatsResponse) GetAvgBlockSize() float64 {
+	if m != nil {
+		return m.AvgBlockSize
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetAvgTxsPerBlock() float64 {
+	if m != nil {
+		return m.AvgTxsPerBlock
+	}
+	return 0
+}
+
+func (m *ChainStatsResponse) GetTopContracts() []*ContractInvokeStat {
+	if m != nil {
+		return m.TopContracts
+	}
+	return nil
+}
+
+type SystemsStatusReply struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SystemsStatus        *SystemsStatus `protobuf:"bytes,2,opt,name=systems_status,json=systemsStatus,proto3" json:"systems_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SystemsStatusReply) Reset()         { *m = SystemsStatusReply{} }
+func (m *SystemsStatusReply) String() string { return proto.CompactTextString(m) }
+func (*SystemsStatusReply) ProtoMessage()    {}
+func (*SystemsStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{48}
+}
+
+func (m *SystemsStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemsStatusReply.Unmarshal(m, b)
+}
+func (m *SystemsStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemsStatusReply.Marshal(b, m, deterministic)
+}
+func (m *SystemsStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemsStatusReply.Merge(m, src)
+}
+func (m *SystemsStatusReply) XXX_Size() int {
+	return xxx_messageInfo_SystemsStatusReply.Size(m)
+}
+func (m *SystemsStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemsStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemsStatusReply proto.InternalMessageInfo
+
+func (m *SystemsStatusReply) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SystemsStatusReply) GetSystemsStatus() *SystemsStatus {
+	if m != nil {
+		return m.SystemsStatus
+	}
+	return nil
+}
+
+type ConsensusStatRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusStatRequest) Reset()         { *m = ConsensusStatRequest{} }
+func (m *ConsensusStatRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStatRequest) ProtoMessage()    {}
+func (*ConsensusStatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{49}
+}
+
+func (m *ConsensusStatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusStatRequest.Unmarshal(m, b)
+}
+func (m *ConsensusStatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusStatRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsensusStatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStatRequest.Merge(m, src)
+}
+func (m *ConsensusStatRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsensusStatRequest.Size(m)
+}
+func (m *ConsensusStatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStatRequest proto.InternalMessageInfo
+
+func (m *ConsensusStatRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ConsensusStatRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+// Consensus status
+type ConsensusStatus struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// version
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// consensus name
+	ConsensusName string `protobuf:"bytes,3,opt,name=consensus_name,json=consensusName,proto3" json:"consensus_name,omitempty"`
+	// consensus start height
+	StartHeight string `protobuf:"bytes,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// consensus validators info
+	ValidatorsInfo       string   `protobuf:"bytes,5,opt,name=validators_info,json=validatorsInfo,proto3" json:"validators_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusStatus) Reset()         { *m = ConsensusStatus{} }
+func (m *ConsensusStatus) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStatus) ProtoMessage()    {}
+func (*ConsensusStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{50}
+}
+
+func (m *ConsensusStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusStatus.Unmarshal(m, b)
+}
+func (m *ConsensusStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusStatus.Marshal(b, m, deterministic)
+}
+func (m *ConsensusStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStatus.Merge(m, src)
+}
+func (m *ConsensusStatus) XXX_Size() int {
+	return xxx_messageInfo_ConsensusStatus.Size(m)
+}
+func (m *ConsensusStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStatus proto.InternalMessageInfo
+
+func (m *ConsensusStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ConsensusStatus) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ConsensusStatus) GetConsensusName() string {
+	if m != nil {
+		return m.ConsensusName
+	}
+	return ""
+}
+
+func (m *ConsensusStatus) GetStartHeight() string {
+	if m != nil {
+		return m.StartHeight
+	}
+	return ""
+}
+
+func (m *ConsensusStatus) GetValidatorsInfo() string {
+	if m != nil {
+		return m.ValidatorsInfo
+	}
+	return ""
+}
+
+// RawUrl return the node's  connect url
+type RawUrl struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RawUrl               string   `protobuf:"bytes,2,opt,name=rawUrl,proto3" json:"rawUrl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RawUrl) Reset()         { *m = RawUrl{} }
+func (m *RawUrl) String() string { return proto.CompactTextString(m) }
+func (*RawUrl) ProtoMessage()    {}
+func (*RawUrl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{51}
+}
+
+func (m *RawUrl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawUrl.Unmarshal(m, b)
+}
+func (m *RawUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawUrl.Marshal(b, m, deterministic)
+}
+func (m *RawUrl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawUrl.Merge(m, src)
+}
+func (m *RawUrl) XXX_Size() int {
+	return xxx_messageInfo_RawUrl.Size(m)
+}
+func (m *RawUrl) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawUrl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawUrl proto.InternalMessageInfo
+
+func (m *RawUrl) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RawUrl) GetRawUrl() string {
+	if m != nil {
+		return m.RawUrl
+	}
+	return ""
+}
+
+type Utxo struct {
+	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	ToAddr               []byte   `protobuf:"bytes,2,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
+	ToPubkey             []byte   `protobuf:"bytes,3,opt,name=toPubkey,proto3" json:"toPubkey,omitempty"`
+	RefTxid              []byte   `protobuf:"bytes,4,opt,name=refTxid,proto3" json:"refTxid,omitempty"`
+	RefOffset            int32    `protobuf:"varint,5,opt,name=refOffset,proto3" json:"refOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Utxo) Reset()         { *m = Utxo{} }
+func (m *Utxo) String() string { return proto.CompactTextString(m) }
+func (*Utxo) ProtoMessage()    {}
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{52}
+}
+
+func (m *Utxo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Utxo.Unmarshal(m, b)
+}
+func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
+}
+func (m *Utxo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Utxo.Merge(m, src)
+}
+func (m *Utxo) XXX_Size() int {
+	return xxx_messageInfo_Utxo.Size(m)
+}
+func (m *Utxo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Utxo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Utxo proto.InternalMessageInfo
+
+func (m *Utxo) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Utxo) GetToAddr() []byte {
+	if m != nil {
+		return m.ToAddr
+	}
+	return nil
+}
+
+func (m *Utxo) GetToPubkey() []byte {
+	if m != nil {
+		return m.ToPubkey
+	}
+	return nil
+}
+
+func (m *Utxo) GetRefTxid() []byte {
+	if m != nil {
+		return m.RefTxid
+	}
+	return nil
+}
+
+func (m *Utxo) GetRefOffset() int32 {
+	if m != nil {
+		return m.RefOffset
+	}
+	return 0
+}
+
+// UtxoInput query info to query utxos
+type UtxoInput struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// which bcname to select
+	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// address to select
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// publickey of the address
+	Publickey string `protobuf:"bytes,4,opt,name=publickey,proto3" json:"publickey,omitempty"`
+	// totalNeed refer the total need utxos to select
+	TotalNeed string `protobuf:"bytes,5,opt,name=totalNeed,proto3" json:"totalNeed,omitempty"`
+	// userSign of input
+	UserSign []byte `protobuf:"bytes,7,opt,name=userSign,proto3" json:"userSign,omitempty"`
+	// need lock
+	NeedLock             bool     `protobuf:"varint,8,opt,name=needLock,proto3" json:"needLock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoInput) Reset()         { *m = UtxoInput{} }
+func (m *UtxoInput) String() string { return proto.CompactTextString(m) }
+func (*UtxoInput) ProtoMessage()    {}
+func (*UtxoInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{53}
+}
+
+func (m *UtxoInput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoInput.Unmarshal(m, b)
+}
+func (m *UtxoInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoInput.Marshal(b, m, deterministic)
+}
+func (m *UtxoInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoInput.Merge(m, src)
+}
+func (m *UtxoInput) XXX_Size() int {
+	return xxx_messageInfo_UtxoInput.Size(m)
+}
+func (m *UtxoInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoInput proto.InternalMessageInfo
+
+func (m *UtxoInput) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UtxoInput) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UtxoInput) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UtxoInput) GetPublickey() string {
+	if m != nil {
+		return m.Publickey
+	}
+	return ""
+}
+
+func (m *UtxoInput) GetTotalNeed() string {
+	if m != nil {
+		return m.TotalNeed
+	}
+	return ""
+}
+
+func (m *UtxoInput) GetUserSign() []byte {
+	if m != nil {
+		return m.UserSign
+	}
+	return nil
+}
+
+func (m *UtxoInput) GetNeedLock() bool {
+	if m != nil {
+		return m.NeedLock
+	}
+	return false
+}
+
+// UtxoLockRelease utxos to release lock.
+// userSign (or each of authRequireSigns) signs
+//
+//	DoubleSha256(bcname + address + strings.Join(refs, ",") + timestamp)
+//
+// where refs are "hex(refTxid):refOffset" of utxoList sorted in ascending order,
+// and timestamp is the decimal unix seconds, accepted within 5 minutes of node
+// time (releaseSignExpire).
+// Unlike the UtxoInput signature, which only covers bcname, address and amount
+// and so is reusable, it binds the exact utxo list and signing time, so it can
+// neither release other utxos nor be replayed once expired.
+type UtxoLockRelease struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// which bcname to release
+	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// address that utxos belong to
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// publickey of the address
+	Publickey string `protobuf:"bytes,4,opt,name=publickey,proto3" json:"publickey,omitempty"`
+	// userSign of release, see the message comment
+	UserSign []byte `protobuf:"bytes,5,opt,name=userSign,proto3" json:"userSign,omitempty"`
+	// utxos to release, refTxid and refOffset are required
+	UtxoList []*Utxo `protobuf:"bytes,6,rep,name=utxoList,proto3" json:"utxoList,omitempty"`
+	// unix timestamp in seconds when signing, expired signature is rejected
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signatures of account AKs when address is a contract account,
+	// publickey and userSign are ignored in this case
+	AuthRequireSigns     []*SignatureInfo `protobuf:"bytes,8,rep,name=authRequireSigns,proto3" json:"authRequireSigns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UtxoLockRelease) Reset()         { *m = UtxoLockRelease{} }
+func (m *UtxoLockRelease) String() string { return proto.CompactTextString(m) }
+func (*UtxoLockRelease) ProtoMessage()    {}
+func (*UtxoLockRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{54}
+}
+
+func (m *UtxoLockRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoLockRelease.Unmarshal(m, b)
+}
+func (m *UtxoLockRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoLockRelease.Marshal(b, m, deterministic)
+}
+func (m *UtxoLockRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoLockRelease.Merge(m, src)
+}
+func (m *UtxoLockRelease) XXX_Size() int {
+	return xxx_messageInfo_UtxoLockRelease.Size(m)
+}
+func (m *UtxoLockRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoLockRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoLockRelease proto.InternalMessageInfo
+
+func (m *UtxoLockRelease) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UtxoLockRelease) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UtxoLockRelease) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UtxoLockRelease) GetPublickey() string {
+	if m != nil {
+		return m.Publickey
+	}
+	return ""
+}
+
+func (m *UtxoLockRelease) GetUserSign() []byte {
+	if m != nil {
+		return m.UserSign
+	}
+	return nil
+}
+
+func (m *UtxoLockRelease) GetUtxoList() []*Utxo {
+	if m != nil {
+		return m.UtxoList
+	}
+	return nil
+}
+
+func (m *UtxoLockRelease) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *UtxoLockRelease) GetAuthRequireSigns() []*SignatureInfo {
+	if m != nil {
+		return m.AuthRequireSigns
+	}
+	return nil
+}
+
+// UtxoOutput query results
+type UtxoOutput struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// outSign return the output
+	// bytes outSign = 2;
+	// utxo list
+	UtxoList []*Utxo `protobuf:"bytes,2,rep,name=utxoList,proto3" json:"utxoList,omitempty"`
+	// total selected amount
+	TotalSelected        string   `protobuf:"bytes,3,opt,name=totalSelected,proto3" json:"totalSelected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoOutput) Reset()         { *m = UtxoOutput{} }
+func (m *UtxoOutput) String() string { return proto.CompactTextString(m) }
+func (*UtxoOutput) ProtoMessage()    {}
+func (*UtxoOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{55}
+}
+
+func (m *UtxoOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoOutput.Unmarshal(m, b)
+}
+func (m *UtxoOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoOutput.Marshal(b, m, deterministic)
+}
+func (m *UtxoOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoOutput.Merge(m, src)
+}
+func (m *UtxoOutput) XXX_Size() int {
+	return xxx_messageInfo_UtxoOutput.Size(m)
+}
+func (m *UtxoOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoOutput proto.InternalMessageInfo
+
+func (m *UtxoOutput) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UtxoOutput) GetUtxoList() []*Utxo {
+	if m != nil {
+		return m.UtxoList
+	}
+	return nil
+}
+
+func (m *UtxoOutput) GetTotalSelected() string {
+	if m != nil {
+		return m.TotalSelected
+	}
+	return ""
+}
+
+type NativeCodeDesc struct {
+	// native code name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// code version
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// digest of binary
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// the previous verion
+	PrevVersion string `protobuf:"bytes,4,opt,name=prevVersion,proto3" json:"prevVersion,omitempty"`
+	// xuper api version
+	XuperApiVersion      int32    `protobuf:"varint,5,opt,name=xuperApiVersion,proto3" json:"xuperApiVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NativeCodeDesc) Reset()         { *m = NativeCodeDesc{} }
+func (m *NativeCodeDesc) String() string { return proto.CompactTextString(m) }
+func (*NativeCodeDesc) ProtoMessage()    {}
+func (*NativeCodeDesc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{56}
+}
+
+func (m *NativeCodeDesc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NativeCodeDesc.Unmarshal(m, b)
+}
+func (m *NativeCodeDesc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NativeCodeDesc.Marshal(b, m, deterministic)
+}
+func (m *NativeCodeDesc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NativeCodeDesc.Merge(m, src)
+}
+func (m *NativeCodeDesc) XXX_Size() int {
+	return xxx_messageInfo_NativeCodeDesc.Size(m)
+}
+func (m *NativeCodeDesc) XXX_DiscardUnknown() {
+	xxx_messageInfo_NativeCodeDesc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NativeCodeDesc proto.InternalMessageInfo
+
+func (m *NativeCodeDesc) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NativeCodeDesc) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NativeCodeDesc) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *NativeCodeDesc) GetPrevVersion() string {
+	if m != nil {
+		return m.PrevVersion
+	}
+	return ""
+}
+
+func (m *NativeCodeDesc) GetXuperApiVersion() int32 {
+	if m != nil {
+		return m.XuperApiVersion
+	}
+	return 0
+}
+
+type WasmCodeDesc struct {
+	Runtime              string   `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Compiler             string   `protobuf:"bytes,2,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	Digest               []byte   `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	VmCompiler           string   `protobuf:"bytes,4,opt,name=vm_compiler,json=vmCompiler,proto3" json:"vm_compiler,omitempty"`
+	ContractType         string   `protobuf:"bytes,5,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmCodeDesc) Reset()         { *m = WasmCodeDesc{} }
+func (m *WasmCodeDesc) String() string { return proto.CompactTextString(m) }
+func (*WasmCodeDesc) ProtoMessage()    {}
+func (*WasmCodeDesc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{57}
+}
+
+func (m *WasmCodeDesc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmCodeDesc.Unmarshal(m, b)
+}
+func (m *WasmCodeDesc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmCodeDesc.Marshal(b, m, deterministic)
+}
+func (m *WasmCodeDesc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmCodeDesc.Merge(m, src)
+}
+func (m *WasmCodeDesc) XXX_Size() int {
+	return xxx_messageInfo_WasmCodeDesc.Size(m)
+}
+func (m *WasmCodeDesc) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmCodeDesc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmCodeDesc proto.InternalMessageInfo
+
+func (m *WasmCodeDesc) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *WasmCodeDesc) GetCompiler() string {
+	if m != nil {
+		return m.Compiler
+	}
+	return ""
+}
+
+func (m *WasmCodeDesc) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *WasmCodeDesc) GetVmCompiler() string {
+	if m != nil {
+		return m.VmCompiler
+	}
+	return ""
+}
+
+func (m *WasmCodeDesc) GetContractType() string {
+	if m != nil {
+		return m.ContractType
+	}
+	return ""
+}
+
+type NativeCodeStatus struct {
+	Desc                 *NativeCodeDesc `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status               int32           `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Healthy              bool            `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NativeCodeStatus) Reset()         { *m = NativeCodeStatus{} }
+func (m *NativeCodeStatus) String() string { return proto.CompactTextString(m) }
+func (*NativeCodeStatus) ProtoMessage()    {}
+func (*NativeCodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{58}
+}
+
+func (m *NativeCodeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NativeCodeStatus.Unmarshal(m, b)
+}
+func (m *NativeCodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NativeCodeStatus.Marshal(b, m, deterministic)
+}
+func (m *NativeCodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NativeCodeStatus.Merge(m, src)
+}
+func (m *NativeCodeStatus) XXX_Size() int {
+	return xxx_messageInfo_NativeCodeStatus.Size(m)
+}
+func (m *NativeCodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NativeCodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NativeCodeStatus proto.InternalMessageInfo
+
+func (m *NativeCodeStatus) GetDesc() *NativeCodeDesc {
+	if m != nil {
+		return m.Desc
+	}
+	return nil
+}
+
+func (m *NativeCodeStatus) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *NativeCodeStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+// 候选人列表接口
+type DposCandidatesRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCandidatesRequest) Reset()         { *m = DposCandidatesRequest{} }
+func (m *DposCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*DposCandidatesRequest) ProtoMessage()    {}
+func (*DposCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{59}
+}
+
+func (m *DposCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCandidatesRequest.Unmarshal(m, b)
+}
+func (m *DposCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCandidatesRequest.Marshal(b, m, deterministic)
+}
+func (m *DposCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCandidatesRequest.Merge(m, src)
+}
+func (m *DposCandidatesRequest) XXX_Size() int {
+	return xxx_messageInfo_DposCandidatesRequest.Size(m)
+}
+func (m *DposCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCandidatesRequest proto.InternalMessageInfo
+
+func (m *DposCandidatesRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposCandidatesRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+// 候选人列表返回
+type DposCandidatesResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CandidatesInfo       []string `protobuf:"bytes,2,rep,name=candidatesInfo,proto3" json:"candidatesInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCandidatesResponse) Reset()         { *m = DposCandidatesResponse{} }
+func (m *DposCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*DposCandidatesResponse) ProtoMessage()    {}
+func (*DposCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{60}
+}
+
+func (m *DposCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCandidatesResponse.Unmarshal(m, b)
+}
+func (m *DposCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCandidatesResponse.Marshal(b, m, deterministic)
+}
+func (m *DposCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCandidatesResponse.Merge(m, src)
+}
+func (m *DposCandidatesResponse) XXX_Size() int {
+	return xxx_messageInfo_DposCandidatesResponse.Size(m)
+}
+func (m *DposCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCandidatesResponse proto.InternalMessageInfo
+
+func (m *DposCandidatesResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposCandidatesResponse) GetCandidatesInfo() []string {
+	if m != nil {
+		return m.CandidatesInfo
+	}
+	return nil
+}
+
+// 提名者提名记录请求
+type DposNominateRecordsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposNominateRecordsRequest) Reset()         { *m = DposNominateRecordsRequest{} }
+func (m *DposNominateRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*DposNominateRecordsRequest) ProtoMessage()    {}
+func (*DposNominateRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{61}
+}
+
+func (m *DposNominateRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposNominateRecordsRequest.Unmarshal(m, b)
+}
+func (m *DposNominateRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposNominateRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *DposNominateRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposNominateRecordsRequest.Merge(m, src)
+}
+func (m *DposNominateRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_DposNominateRecordsRequest.Size(m)
+}
+func (m *DposNominateRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposNominateRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposNominateRecordsRequest proto.InternalMessageInfo
+
+func (m *DposNominateRecordsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposNominateRecordsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *DposNominateRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// 候选人信息
+type DposNominateInfo struct {
+	Candidate            string   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposNominateInfo) Reset()         { *m = DposNominateInfo{} }
+func (m *DposNominateInfo) String() string { return proto.CompactTextString(m) }
+func (*DposNominateInfo) ProtoMessage()    {}
+func (*DposNominateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{62}
+}
+
+func (m *DposNominateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposNominateInfo.Unmarshal(m, b)
+}
+func (m *DposNominateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposNominateInfo.Marshal(b, m, deterministic)
+}
+func (m *DposNominateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposNominateInfo.Merge(m, src)
+}
+func (m *DposNominateInfo) XXX_Size() int {
+	return xxx_messageInfo_DposNominateInfo.Size(m)
+}
+func (m *DposNominateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposNominateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposNominateInfo proto.InternalMessageInfo
+
+func (m *DposNominateInfo) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *DposNominateInfo) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+// 提名者提名记录返回
+type DposNominateRecordsResponse struct {
+	Header               *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NominateRecords      []*DposNominateInfo `protobuf:"bytes,2,rep,name=nominateRecords,proto3" json:"nominateRecords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DposNominateRecordsResponse) Reset()         { *m = DposNominateRecordsResponse{} }
+func (m *DposNominateRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*DposNominateRecordsResponse) ProtoMessage()    {}
+func (*DposNominateRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{63}
+}
+
+func (m *DposNominateRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposNominateRecordsResponse.Unmarshal(m, b)
+}
+func (m *DposNominateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposNominateRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *DposNominateRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposNominateRecordsResponse.Merge(m, src)
+}
+func (m *DposNominateRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_DposNominateRecordsResponse.Size(m)
+}
+func (m *DposNominateRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposNominateRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposNominateRecordsResponse proto.InternalMessageInfo
+
+func (m *DposNominateRecordsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposNominateRecordsResponse) GetNominateRecords() []*DposNominateInfo {
+	if m != nil {
+		return m.NominateRecords
+	}
+	return nil
+}
+
+// 候选人被提名记录请求
+type DposNomineeRecordsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposNomineeRecordsRequest) Reset()         { *m = DposNomineeRecordsRequest{} }
+func (m *DposNomineeRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*DposNomineeRecordsRequest) ProtoMessage()    {}
+func (*DposNomineeRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{64}
+}
+
+func (m *DposNomineeRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposNomineeRecordsRequest.Unmarshal(m, b)
+}
+func (m *DposNomineeRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposNomineeRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *DposNomineeRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposNomineeRecordsRequest.Merge(m, src)
+}
+func (m *DposNomineeRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_DposNomineeRecordsRequest.Size(m)
+}
+func (m *DposNomineeRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposNomineeRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposNomineeRecordsRequest proto.InternalMessageInfo
+
+func (m *DposNomineeRecordsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposNomineeRecordsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *DposNomineeRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// 候选人被提名记录返回
+type DposNomineeRecordsResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposNomineeRecordsResponse) Reset()         { *m = DposNomineeRecordsResponse{} }
+func (m *DposNomineeRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*DposNomineeRecordsResponse) ProtoMessage()    {}
+func (*DposNomineeRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{65}
+}
+
+func (m *DposNomineeRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposNomineeRecordsResponse.Unmarshal(m, b)
+}
+func (m *DposNomineeRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposNomineeRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *DposNomineeRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposNomineeRecordsResponse.Merge(m, src)
+}
+func (m *DposNomineeRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_DposNomineeRecordsResponse.Size(m)
+}
+func (m *DposNomineeRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposNomineeRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposNomineeRecordsResponse proto.InternalMessageInfo
+
+func (m *DposNomineeRecordsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposNomineeRecordsResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+// 选民投票记录请求
+type DposVoteRecordsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposVoteRecordsRequest) Reset()         { *m = DposVoteRecordsRequest{} }
+func (m *DposVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*DposVoteRecordsRequest) ProtoMessage()    {}
+func (*DposVoteRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{66}
+}
+
+func (m *DposVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVoteRecordsRequest.Unmarshal(m, b)
+}
+func (m *DposVoteRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVoteRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *DposVoteRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVoteRecordsRequest.Merge(m, src)
+}
+func (m *DposVoteRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_DposVoteRecordsRequest.Size(m)
+}
+func (m *DposVoteRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVoteRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVoteRecordsRequest proto.InternalMessageInfo
+
+func (m *DposVoteRecordsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposVoteRecordsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *DposVoteRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// 选民投票记录
+type VoteRecord struct {
+	Candidate            string   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
+func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
+func (*VoteRecord) ProtoMessage()    {}
+func (*VoteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{67}
+}
+
+func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteRecord.Unmarshal(m, b)
+}
+func (m *VoteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteRecord.Marshal(b, m, deterministic)
+}
+func (m *VoteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRecord.Merge(m, src)
+}
+func (m *VoteRecord) XXX_Size() int {
+	return xxx_messageInfo_VoteRecord.Size(m)
+}
+func (m *VoteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRecord proto.InternalMessageInfo
+
+func (m *VoteRecord) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *VoteRecord) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+// 选民投票记录返回
+type DposVoteRecordsResponse struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 选民投票txid记录
+	VoteTxidRecords      []*VoteRecord `protobuf:"bytes,2,rep,name=voteTxidRecords,proto3" json:"voteTxidRecords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DposVoteRecordsResponse) Reset()         { *m = DposVoteRecordsResponse{} }
+func (m *DposVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*DposVoteRecordsResponse) ProtoMessage()    {}
+func (*DposVoteRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{68}
+}
+
+func (m *DposVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVoteRecordsResponse.Unmarshal(m, b)
+}
+func (m *DposVoteRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVoteRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *DposVoteRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVoteRecordsResponse.Merge(m, src)
+}
+func (m *DposVoteRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_DposVoteRecordsResponse.Size(m)
+}
+func (m *DposVoteRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVoteRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVoteRecordsResponse proto.InternalMessageInfo
+
+func (m *DposVoteRecordsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposVoteRecordsResponse) GetVoteTxidRecords() []*VoteRecord {
+	if m != nil {
+		return m.VoteTxidRecords
+	}
+	return nil
+}
+
+// 候选人被投票记录请求
+type DposVotedRecordsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposVotedRecordsRequest) Reset()         { *m = DposVotedRecordsRequest{} }
+func (m *DposVotedRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*DposVotedRecordsRequest) ProtoMessage()    {}
+func (*DposVotedRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{69}
+}
+
+func (m *DposVotedRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVotedRecordsRequest.Unmarshal(m, b)
+}
+func (m *DposVotedRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVotedRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *DposVotedRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVotedRecordsRequest.Merge(m, src)
+}
+func (m *DposVotedRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_DposVotedRecordsRequest.Size(m)
+}
+func (m *DposVotedRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVotedRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVotedRecordsRequest proto.InternalMessageInfo
+
+func (m *DposVotedRecordsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposVotedRecordsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *DposVotedRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// 候选人被投票记录
+type VotedRecord struct {
+	Voter                string   `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VotedRecord) Reset()         { *m = VotedRecord{} }
+func (m *VotedRecord) String() string { return proto.CompactTextString(m) }
+func (*VotedRecord) ProtoMessage()    {}
+func (*VotedRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{70}
+}
+
+func (m *VotedRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotedRecord.Unmarshal(m, b)
+}
+func (m *VotedRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotedRecord.Marshal(b, m, deterministic)
+}
+func (m *VotedRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotedRecord.Merge(m, src)
+}
+func (m *VotedRecord) XXX_Size() int {
+	return xxx_messageInfo_VotedRecord.Size(m)
+}
+func (m *VotedRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotedRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotedRecord proto.InternalMessageInfo
+
+func (m *VotedRecord) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VotedRecord) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+// 候选人被投票记录返回
+type DposVotedRecordsResponse struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 候选人被投票的txid记录
+	VotedTxidRecords     []*VotedRecord `protobuf:"bytes,2,rep,name=votedTxidRecords,proto3" json:"votedTxidRecords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DposVotedRecordsResponse) Reset()         { *m = DposVotedRecordsResponse{} }
+func (m *DposVotedRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*DposVotedRecordsResponse) ProtoMessage()    {}
+func (*DposVotedRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{71}
+}
+
+func (m *DposVotedRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVotedRecordsResponse.Unmarshal(m, b)
+}
+func (m *DposVotedRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVotedRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *DposVotedRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVotedRecordsResponse.Merge(m, src)
+}
+func (m *DposVotedRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_DposVotedRecordsResponse.Size(m)
+}
+func (m *DposVotedRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVotedRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVotedRecordsResponse proto.InternalMessageInfo
+
+func (m *DposVotedRecordsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposVotedRecordsResponse) GetVotedTxidRecords() []*VotedRecord {
+	if m != nil {
+		return m.VotedTxidRecords
+	}
+	return nil
+}
+
+// 查询检票结果记录请求
+type DposCheckResultsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Term                 int64    `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCheckResultsRequest) Reset()         { *m = DposCheckResultsRequest{} }
+func (m *DposCheckResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*DposCheckResultsRequest) ProtoMessage()    {}
+func (*DposCheckResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{72}
+}
+
+func (m *DposCheckResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCheckResultsRequest.Unmarshal(m, b)
+}
+func (m *DposCheckResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCheckResultsRequest.Marshal(b, m, deterministic)
+}
+func (m *DposCheckResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCheckResultsRequest.Merge(m, src)
+}
+func (m *DposCheckResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_DposCheckResultsRequest.Size(m)
+}
+func (m *DposCheckResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCheckResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCheckResultsRequest proto.InternalMessageInfo
+
+func (m *DposCheckResultsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposCheckResultsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *DposCheckResultsRequest) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+// 查询检票结果记录返回
+type DposCheckResultsResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Term                 int64    `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	CheckResult          []string `protobuf:"bytes,3,rep,name=checkResult,proto3" json:"checkResult,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCheckResultsResponse) Reset()         { *m = DposCheckResultsResponse{} }
+func (m *DposCheckResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*DposCheckResultsResponse) ProtoMessage()    {}
+func (*DposCheckResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{73}
+}
+
+func (m *DposCheckResultsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCheckResultsResponse.Unmarshal(m, b)
+}
+func (m *DposCheckResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCheckResultsResponse.Marshal(b, m, deterministic)
+}
+func (m *DposCheckResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCheckResultsResponse.Merge(m, src)
+}
+func (m *DposCheckResultsResponse) XXX_Size() int {
+	return xxx_messageInfo_DposCheckResultsResponse.Size(m)
+}
+func (m *DposCheckResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCheckResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCheckResultsResponse proto.InternalMessageInfo
+
+func (m *DposCheckResultsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposCheckResultsResponse) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *DposCheckResultsResponse) GetCheckResult() []string {
+	if m != nil {
+		return m.CheckResult
+	}
+	return nil
+}
+
+// query dpos consensus current status request
+type DposStatusRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposStatusRequest) Reset()         { *m = DposStatusRequest{} }
+func (m *DposStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*DposStatusRequest) ProtoMessage()    {}
+func (*DposStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{74}
+}
+
+func (m *DposStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposStatusRequest.Unmarshal(m, b)
+}
+func (m *DposStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *DposStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposStatusRequest.Merge(m, src)
+}
+func (m *DposStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_DposStatusRequest.Size(m)
+}
+func (m *DposStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposStatusRequest proto.InternalMessageInfo
+
+func (m *DposStatusRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposStatusRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+// query dpos consensus current status reply
+type DposStatusResponse struct {
+	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Status               *DposStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DposStatusResponse) Reset()         { *m = DposStatusResponse{} }
+func (m *DposStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*DposStatusResponse) ProtoMessage()    {}
+func (*DposStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{75}
+}
+
+func (m *DposStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposStatusResponse.Unmarshal(m, b)
+}
+func (m *DposStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *DposStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposStatusResponse.Merge(m, src)
+}
+func (m *DposStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_DposStatusResponse.Size(m)
+}
+func (m *DposStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposStatusResponse proto.InternalMessageInfo
+
+func (m *DposStatusResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DposStatusResponse) GetStatus() *DposStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type DposStatus struct {
+	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	BlockNum             int64    `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Proposer             string   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	ProposerNum          int64    `protobuf:"varint,4,opt,name=proposer_num,json=proposerNum,proto3" json:"proposer_num,omitempty"`
+	CheckResult          []string `protobuf:"bytes,5,rep,name=checkResult,proto3" json:"checkResult,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposStatus) Reset()         { *m = DposStatus{} }
+func (m *DposStatus) String() string { return proto.CompactTextString(m) }
+func (*DposStatus) ProtoMessage()    {}
+func (*DposStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{76}
+}
+
+func (m *DposStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposStatus.Unmarshal(m, b)
+}
+func (m *DposStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposStatus.Marshal(b, m, deterministic)
+}
+func (m *DposStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposStatus.Merge(m, src)
+}
+func (m *DposStatus) XXX_Size() int {
+	return xxx_messageInfo_DposStatus.Size(m)
+}
+func (m *DposStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposStatus proto.InternalMessageInfo
+
+func (m *DposStatus) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *DposStatus) GetBlockNum() int64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *DposStatus) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *DposStatus) GetProposerNum() int64 {
+	if m != nil {
+		return m.ProposerNum
+	}
+	return 0
+}
+
+func (m *DposStatus) GetCheckResult() []string {
+	if m != nil {
+		return m.CheckResult
+	}
+	return nil
+}
+
+// 候选人列表请求
+type XpoaValidatorsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XpoaValidatorsRequest) Reset()         { *m = XpoaValidatorsRequest{} }
+func (m *XpoaValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*XpoaValidatorsRequest) ProtoMessage()    {}
+func (*XpoaValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{77}
+}
+
+func (m *XpoaValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XpoaValidatorsRequest.Unmarshal(m, b)
+}
+func (m *XpoaValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XpoaValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *XpoaValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XpoaValidatorsRequest.Merge(m, src)
+}
+func (m *XpoaValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_XpoaValidatorsRequest.Size(m)
+}
+func (m *XpoaValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_XpoaValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XpoaValidatorsRequest proto.InternalMessageInfo
+
+func (m *XpoaValidatorsRequest) GetHeader() *Header {
 	if m != nil {
-		return m.ReservedContracts
+		return m.Header
 	}
 	return nil
 }
 
-func (m *UtxoMeta) GetForbiddenContract() *InvokeRequest {
+func (m *XpoaValidatorsRequest) GetBcname() string {
 	if m != nil {
-		return m.ForbiddenContract
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *UtxoMeta) GetNewAccountResourceAmount() int64 {
+type XpoaValidator struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Neturl               string   `protobuf:"bytes,2,opt,name=neturl,proto3" json:"neturl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XpoaValidator) Reset()         { *m = XpoaValidator{} }
+func (m *XpoaValidator) String() string { return proto.CompactTextString(m) }
+func (*XpoaValidator) ProtoMessage()    {}
+func (*XpoaValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{78}
+}
+
+func (m *XpoaValidator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XpoaValidator.Unmarshal(m, b)
+}
+func (m *XpoaValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XpoaValidator.Marshal(b, m, deterministic)
+}
+func (m *XpoaValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XpoaValidator.Merge(m, src)
+}
+func (m *XpoaValidator) XXX_Size() int {
+	return xxx_messageInfo_XpoaValidator.Size(m)
+}
+func (m *XpoaValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_XpoaValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XpoaValidator proto.InternalMessageInfo
+
+func (m *XpoaValidator) GetAddress() string {
 	if m != nil {
-		return m.NewAccountResourceAmount
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *UtxoMeta) GetIrreversibleBlockHeight() int64 {
+func (m *XpoaValidator) GetNeturl() string {
 	if m != nil {
-		return m.IrreversibleBlockHeight
+		return m.Neturl
 	}
-	return 0
+	return ""
 }
 
-func (m *UtxoMeta) GetIrreversibleSlideWindow() int64 {
+// 候选人列表返回
+type XpoaValidatorsResponse struct {
+	Header     *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname     string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Validators []*XpoaValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	// 已提交变更但尚未生效的候选人，变更在包含变更交易的区块之后3个区块生效
+	PendingValidators    []*XpoaValidator `protobuf:"bytes,4,rep,name=pending_validators,json=pendingValidators,proto3" json:"pending_validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *XpoaValidatorsResponse) Reset()         { *m = XpoaValidatorsResponse{} }
+func (m *XpoaValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*XpoaValidatorsResponse) ProtoMessage()    {}
+func (*XpoaValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{79}
+}
+
+func (m *XpoaValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XpoaValidatorsResponse.Unmarshal(m, b)
+}
+func (m *XpoaValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XpoaValidatorsResponse.Marshal(b, m, deterministic)
+}
+func (m *XpoaValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XpoaValidatorsResponse.Merge(m, src)
+}
+func (m *XpoaValidatorsResponse) XXX_Size() int {
+	return xxx_messageInfo_XpoaValidatorsResponse.Size(m)
+}
+func (m *XpoaValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_XpoaValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XpoaValidatorsResponse proto.InternalMessageInfo
+
+func (m *XpoaValidatorsResponse) GetHeader() *Header {
 	if m != nil {
-		return m.IrreversibleSlideWindow
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *UtxoMeta) GetGasPrice() *GasPrice {
+func (m *XpoaValidatorsResponse) GetBcname() string {
 	if m != nil {
-		return m.GasPrice
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *XpoaValidatorsResponse) GetValidators() []*XpoaValidator {
+	if m != nil {
+		return m.Validators
 	}
 	return nil
 }
 
-func (m *UtxoMeta) GetGroupChainContract() *InvokeRequest {
+func (m *XpoaValidatorsResponse) GetPendingValidators() []*XpoaValidator {
 	if m != nil {
-		return m.GroupChainContract
+		return m.PendingValidators
 	}
 	return nil
 }
 
-type GasPrice struct {
-	CpuRate              int64    `protobuf:"varint,1,opt,name=cpu_rate,json=cpuRate,proto3" json:"cpu_rate,omitempty"`
-	MemRate              int64    `protobuf:"varint,2,opt,name=mem_rate,json=memRate,proto3" json:"mem_rate,omitempty"`
-	DiskRate             int64    `protobuf:"varint,3,opt,name=disk_rate,json=diskRate,proto3" json:"disk_rate,omitempty"`
-	XfeeRate             int64    `protobuf:"varint,4,opt,name=xfee_rate,json=xfeeRate,proto3" json:"xfee_rate,omitempty"`
+// xpoa共识状态请求
+type XpoaStatusRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GasPrice) Reset()         { *m = GasPrice{} }
-func (m *GasPrice) String() string { return proto.CompactTextString(m) }
-func (*GasPrice) ProtoMessage()    {}
-func (*GasPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{21}
+func (m *XpoaStatusRequest) Reset()         { *m = XpoaStatusRequest{} }
+func (m *XpoaStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*XpoaStatusRequest) ProtoMessage()    {}
+func (*XpoaStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{80}
 }
 
-func (m *GasPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GasPrice.Unmarshal(m, b)
-}
-func (m *GasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GasPrice.Marshal(b, m, deterministic)
+func (m *XpoaStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XpoaStatusRequest.Unmarshal(m, b)
 }
-func (m *GasPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GasPrice.Merge(m, src)
+func (m *XpoaStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XpoaStatusRequest.Marshal(b, m, deterministic)
 }
-func (m *GasPrice) XXX_Size() int {
-	return xxx_messageInfo_GasPrice.Size(m)
+func (m *XpoaStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XpoaStatusRequest.Merge(m, src)
 }
-func (m *GasPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_GasPrice.DiscardUnknown(m)
+func (m *XpoaStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_XpoaStatusRequest.Size(m)
 }
-
-var xxx_messageInfo_GasPrice proto.InternalMessageInfo
-
-func (m *GasPrice) GetCpuRate() int64 {
-	if m != nil {
-		return m.CpuRate
-	}
-	return 0
+func (m *XpoaStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_XpoaStatusRequest.DiscardUnknown(m)
 }
 
-func (m *GasPrice) GetMemRate() int64 {
-	if m != nil {
-		return m.MemRate
-	}
-	return 0
-}
+var xxx_messageInfo_XpoaStatusRequest proto.InternalMessageInfo
 
-func (m *GasPrice) GetDiskRate() int64 {
+func (m *XpoaStatusRequest) GetHeader() *Header {
 	if m != nil {
-		return m.DiskRate
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *GasPrice) GetXfeeRate() int64 {
+func (m *XpoaStatusRequest) GetBcname() string {
 	if m != nil {
-		return m.XfeeRate
+		return m.Bcname
 	}
-	return 0
+	return ""
 }
 
-// The internal block struct
-type InternalBlock struct {
-	// block version
-	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Random number used to avoid replay attacks
-	Nonce int32 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// blockid generate the hash sign of the block used by sha256
-	Blockid []byte `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
-	// pre_hash is the parent blockid of the block
-	PreHash []byte `protobuf:"bytes,4,opt,name=pre_hash,json=preHash,proto3" json:"pre_hash,omitempty"`
-	// The miner id
-	Proposer []byte `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// The sign which miner signed: blockid + nonce + timestamp
-	Sign []byte `protobuf:"bytes,6,opt,name=sign,proto3" json:"sign,omitempty"`
-	// The pk of the miner
-	Pubkey []byte `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	// The Merkle Tree root
-	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
-	// The height of the blockchain
-	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
-	// Timestamp of the block
-	Timestamp int64 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Transactions of the block, only txid stored on kv, the detail information
-	// stored in another table
-	Transactions []*Transaction `protobuf:"bytes,11,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	// The transaction count of the block
-	TxCount int32 `protobuf:"varint,12,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	// 所有交易hash的merkle tree
-	MerkleTree  [][]byte          `protobuf:"bytes,13,rep,name=merkle_tree,json=merkleTree,proto3" json:"merkle_tree,omitempty"`
-	CurTerm     int64             `protobuf:"varint,16,opt,name=curTerm,proto3" json:"curTerm,omitempty"`
-	CurBlockNum int64             `protobuf:"varint,17,opt,name=curBlockNum,proto3" json:"curBlockNum,omitempty"`
-	FailedTxs   map[string]string `protobuf:"bytes,18,rep,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TargetBits  int32             `protobuf:"varint,19,opt,name=targetBits,proto3" json:"targetBits,omitempty"`
-	// Justify used in chained-bft
-	Justify *QuorumCert `protobuf:"bytes,20,opt,name=Justify,proto3" json:"Justify,omitempty"`
-	// 下面的属性会动态变化
-	// If the block is on the trunk
-	InTrunk bool `protobuf:"varint,14,opt,name=in_trunk,json=inTrunk,proto3" json:"in_trunk,omitempty"`
-	// Next next block which on trunk
-	NextHash             []byte   `protobuf:"bytes,15,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
+// xpoa共识状态返回
+type XpoaStatusResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ConsensusName        string   `protobuf:"bytes,3,opt,name=consensus_name,json=consensusName,proto3" json:"consensus_name,omitempty"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	StartHeight          int64    `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Term                 int64    `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
+	Miner                string   `protobuf:"bytes,7,opt,name=miner,proto3" json:"miner,omitempty"`
+	Validators           []string `protobuf:"bytes,8,rep,name=validators,proto3" json:"validators,omitempty"`
+	PendingValidators    []string `protobuf:"bytes,9,rep,name=pending_validators,json=pendingValidators,proto3" json:"pending_validators,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InternalBlock) Reset()         { *m = InternalBlock{} }
-func (m *InternalBlock) String() string { return proto.CompactTextString(m) }
-func (*InternalBlock) ProtoMessage()    {}
-func (*InternalBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{22}
+func (m *XpoaStatusResponse) Reset()         { *m = XpoaStatusResponse{} }
+func (m *XpoaStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*XpoaStatusResponse) ProtoMessage()    {}
+func (*XpoaStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{81}
 }
 
-func (m *InternalBlock) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InternalBlock.Unmarshal(m, b)
+func (m *XpoaStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XpoaStatusResponse.Unmarshal(m, b)
 }
-func (m *InternalBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InternalBlock.Marshal(b, m, deterministic)
+func (m *XpoaStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XpoaStatusResponse.Marshal(b, m, deterministic)
 }
-func (m *InternalBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InternalBlock.Merge(m, src)
+func (m *XpoaStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XpoaStatusResponse.Merge(m, src)
 }
-func (m *InternalBlock) XXX_Size() int {
-	return xxx_messageInfo_InternalBlock.Size(m)
+func (m *XpoaStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_XpoaStatusResponse.Size(m)
 }
-func (m *InternalBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_InternalBlock.DiscardUnknown(m)
+func (m *XpoaStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_XpoaStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InternalBlock proto.InternalMessageInfo
+var xxx_messageInfo_XpoaStatusResponse proto.InternalMessageInfo
 
-func (m *InternalBlock) GetVersion() int32 {
+func (m *XpoaStatusResponse) GetHeader() *Header {
 	if m != nil {
-		return m.Version
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *InternalBlock) GetNonce() int32 {
+func (m *XpoaStatusResponse) GetBcname() string {
 	if m != nil {
-		return m.Nonce
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *XpoaStatusResponse) GetConsensusName() string {
+	if m != nil {
+		return m.ConsensusName
+	}
+	return ""
+}
+
+func (m *XpoaStatusResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
 	return 0
 }
 
-func (m *InternalBlock) GetBlockid() []byte {
+func (m *XpoaStatusResponse) GetStartHeight() int64 {
 	if m != nil {
-		return m.Blockid
+		return m.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *InternalBlock) GetPreHash() []byte {
+func (m *XpoaStatusResponse) GetTerm() int64 {
 	if m != nil {
-		return m.PreHash
+		return m.Term
 	}
-	return nil
+	return 0
 }
 
-func (m *InternalBlock) GetProposer() []byte {
+func (m *XpoaStatusResponse) GetMiner() string {
 	if m != nil {
-		return m.Proposer
+		return m.Miner
 	}
-	return nil
+	return ""
 }
 
-func (m *InternalBlock) GetSign() []byte {
+func (m *XpoaStatusResponse) GetValidators() []string {
 	if m != nil {
-		return m.Sign
+		return m.Validators
 	}
 	return nil
 }
 
-func (m *InternalBlock) GetPubkey() []byte {
+func (m *XpoaStatusResponse) GetPendingValidators() []string {
 	if m != nil {
-		return m.Pubkey
+		return m.PendingValidators
 	}
 	return nil
 }
 
-func (m *InternalBlock) GetMerkleRoot() []byte {
+// 治理代币余额请求
+type GovernTokenBalanceRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernTokenBalanceRequest) Reset()         { *m = GovernTokenBalanceRequest{} }
+func (m *GovernTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GovernTokenBalanceRequest) ProtoMessage()    {}
+func (*GovernTokenBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{82}
+}
+
+func (m *GovernTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernTokenBalanceRequest.Unmarshal(m, b)
+}
+func (m *GovernTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernTokenBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *GovernTokenBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernTokenBalanceRequest.Merge(m, src)
+}
+func (m *GovernTokenBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GovernTokenBalanceRequest.Size(m)
+}
+func (m *GovernTokenBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernTokenBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernTokenBalanceRequest proto.InternalMessageInfo
+
+func (m *GovernTokenBalanceRequest) GetHeader() *Header {
 	if m != nil {
-		return m.MerkleRoot
+		return m.Header
 	}
 	return nil
 }
 
-func (m *InternalBlock) GetHeight() int64 {
+func (m *GovernTokenBalanceRequest) GetBcname() string {
 	if m != nil {
-		return m.Height
+		return m.Bcname
 	}
-	return 0
+	return ""
 }
 
-func (m *InternalBlock) GetTimestamp() int64 {
+func (m *GovernTokenBalanceRequest) GetAccount() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Account
 	}
-	return 0
+	return ""
+}
+
+// 治理代币余额返回，金额均为十进制字符串
+type GovernTokenBalanceResponse struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              string            `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	TotalBalance         string            `protobuf:"bytes,4,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	LockedBalances       map[string]string `protobuf:"bytes,5,rep,name=locked_balances,json=lockedBalances,proto3" json:"locked_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TotalSupply          string            `protobuf:"bytes,6,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GovernTokenBalanceResponse) Reset()         { *m = GovernTokenBalanceResponse{} }
+func (m *GovernTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GovernTokenBalanceResponse) ProtoMessage()    {}
+func (*GovernTokenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{83}
+}
+
+func (m *GovernTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernTokenBalanceResponse.Unmarshal(m, b)
+}
+func (m *GovernTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernTokenBalanceResponse.Marshal(b, m, deterministic)
 }
+func (m *GovernTokenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernTokenBalanceResponse.Merge(m, src)
+}
+func (m *GovernTokenBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GovernTokenBalanceResponse.Size(m)
+}
+func (m *GovernTokenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernTokenBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernTokenBalanceResponse proto.InternalMessageInfo
 
-func (m *InternalBlock) GetTransactions() []*Transaction {
+func (m *GovernTokenBalanceResponse) GetHeader() *Header {
 	if m != nil {
-		return m.Transactions
+		return m.Header
 	}
 	return nil
 }
 
-func (m *InternalBlock) GetTxCount() int32 {
+func (m *GovernTokenBalanceResponse) GetBcname() string {
 	if m != nil {
-		return m.TxCount
+		return m.Bcname
 	}
-	return 0
+	return ""
 }
 
-func (m *InternalBlock) GetMerkleTree() [][]byte {
+func (m *GovernTokenBalanceResponse) GetAccount() string {
 	if m != nil {
-		return m.MerkleTree
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-func (m *InternalBlock) GetCurTerm() int64 {
+func (m *GovernTokenBalanceResponse) GetTotalBalance() string {
 	if m != nil {
-		return m.CurTerm
+		return m.TotalBalance
 	}
-	return 0
+	return ""
 }
 
-func (m *InternalBlock) GetCurBlockNum() int64 {
+func (m *GovernTokenBalanceResponse) GetLockedBalances() map[string]string {
 	if m != nil {
-		return m.CurBlockNum
+		return m.LockedBalances
 	}
-	return 0
+	return nil
 }
 
-func (m *InternalBlock) GetFailedTxs() map[string]string {
+func (m *GovernTokenBalanceResponse) GetTotalSupply() string {
 	if m != nil {
-		return m.FailedTxs
+		return m.TotalSupply
 	}
-	return nil
+	return ""
 }
 
-func (m *InternalBlock) GetTargetBits() int32 {
-	if m != nil {
-		return m.TargetBits
-	}
-	return 0
+// 提案查询请求
+type ProposalQueryRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ProposalId           string   `protobuf:"bytes,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InternalBlock) GetJustify() *QuorumCert {
+func (m *ProposalQueryRequest) Reset()         { *m = ProposalQueryRequest{} }
+func (m *ProposalQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposalQueryRequest) ProtoMessage()    {}
+func (*ProposalQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{84}
+}
+
+func (m *ProposalQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalQueryRequest.Unmarshal(m, b)
+}
+func (m *ProposalQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *ProposalQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalQueryRequest.Merge(m, src)
+}
+func (m *ProposalQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_ProposalQueryRequest.Size(m)
+}
+func (m *ProposalQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalQueryRequest proto.InternalMessageInfo
+
+func (m *ProposalQueryRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Justify
+		return m.Header
 	}
 	return nil
 }
 
-func (m *InternalBlock) GetInTrunk() bool {
+func (m *ProposalQueryRequest) GetBcname() string {
 	if m != nil {
-		return m.InTrunk
+		return m.Bcname
 	}
-	return false
+	return ""
 }
 
-func (m *InternalBlock) GetNextHash() []byte {
+func (m *ProposalQueryRequest) GetProposalId() string {
 	if m != nil {
-		return m.NextHash
+		return m.ProposalId
 	}
-	return nil
+	return ""
 }
 
-// BlockChain status
-type BCStatus struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// block name
-	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	// ledger metadata
-	Meta *LedgerMeta `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
-	// The information of the longest block
-	Block *InternalBlock `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	// Utox information
-	UtxoMeta *UtxoMeta `protobuf:"bytes,5,opt,name=utxoMeta,proto3" json:"utxoMeta,omitempty"`
-	// Branch info
-	BranchBlockid        []string `protobuf:"bytes,6,rep,name=branchBlockid,proto3" json:"branchBlockid,omitempty"`
+// 提案通过后在trigger.height触发的合约调用
+type ProposalTrigger struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Module               string   `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Method               string   `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Args                 string   `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BCStatus) Reset()         { *m = BCStatus{} }
-func (m *BCStatus) String() string { return proto.CompactTextString(m) }
-func (*BCStatus) ProtoMessage()    {}
-func (*BCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{23}
+func (m *ProposalTrigger) Reset()         { *m = ProposalTrigger{} }
+func (m *ProposalTrigger) String() string { return proto.CompactTextString(m) }
+func (*ProposalTrigger) ProtoMessage()    {}
+func (*ProposalTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{85}
 }
 
-func (m *BCStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BCStatus.Unmarshal(m, b)
+func (m *ProposalTrigger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalTrigger.Unmarshal(m, b)
 }
-func (m *BCStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BCStatus.Marshal(b, m, deterministic)
+func (m *ProposalTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalTrigger.Marshal(b, m, deterministic)
 }
-func (m *BCStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BCStatus.Merge(m, src)
+func (m *ProposalTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTrigger.Merge(m, src)
 }
-func (m *BCStatus) XXX_Size() int {
-	return xxx_messageInfo_BCStatus.Size(m)
+func (m *ProposalTrigger) XXX_Size() int {
+	return xxx_messageInfo_ProposalTrigger.Size(m)
 }
-func (m *BCStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_BCStatus.DiscardUnknown(m)
+func (m *ProposalTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTrigger.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BCStatus proto.InternalMessageInfo
+var xxx_messageInfo_ProposalTrigger proto.InternalMessageInfo
 
-func (m *BCStatus) GetHeader() *Header {
+func (m *ProposalTrigger) GetHeight() int64 {
 	if m != nil {
-		return m.Header
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *BCStatus) GetBcname() string {
+func (m *ProposalTrigger) GetModule() string {
 	if m != nil {
-		return m.Bcname
+		return m.Module
 	}
 	return ""
 }
 
-func (m *BCStatus) GetMeta() *LedgerMeta {
-	if m != nil {
-		return m.Meta
-	}
-	return nil
-}
-
-func (m *BCStatus) GetBlock() *InternalBlock {
+func (m *ProposalTrigger) GetContract() string {
 	if m != nil {
-		return m.Block
+		return m.Contract
 	}
-	return nil
+	return ""
 }
 
-func (m *BCStatus) GetUtxoMeta() *UtxoMeta {
+func (m *ProposalTrigger) GetMethod() string {
 	if m != nil {
-		return m.UtxoMeta
+		return m.Method
 	}
-	return nil
+	return ""
 }
 
-func (m *BCStatus) GetBranchBlockid() []string {
+func (m *ProposalTrigger) GetArgs() string {
 	if m != nil {
-		return m.BranchBlockid
+		return m.Args
 	}
-	return nil
+	return ""
 }
 
-type BCTipStatus struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	IsTrunkTip           bool     `protobuf:"varint,2,opt,name=is_trunk_tip,json=isTrunkTip,proto3" json:"is_trunk_tip,omitempty"`
+// 提案或投票锁定的治理代币，包含发起人的押金
+type ProposalLock struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BCTipStatus) Reset()         { *m = BCTipStatus{} }
-func (m *BCTipStatus) String() string { return proto.CompactTextString(m) }
-func (*BCTipStatus) ProtoMessage()    {}
-func (*BCTipStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{24}
+func (m *ProposalLock) Reset()         { *m = ProposalLock{} }
+func (m *ProposalLock) String() string { return proto.CompactTextString(m) }
+func (*ProposalLock) ProtoMessage()    {}
+func (*ProposalLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{86}
 }
 
-func (m *BCTipStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BCTipStatus.Unmarshal(m, b)
+func (m *ProposalLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalLock.Unmarshal(m, b)
 }
-func (m *BCTipStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BCTipStatus.Marshal(b, m, deterministic)
+func (m *ProposalLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalLock.Marshal(b, m, deterministic)
 }
-func (m *BCTipStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BCTipStatus.Merge(m, src)
+func (m *ProposalLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalLock.Merge(m, src)
 }
-func (m *BCTipStatus) XXX_Size() int {
-	return xxx_messageInfo_BCTipStatus.Size(m)
+func (m *ProposalLock) XXX_Size() int {
+	return xxx_messageInfo_ProposalLock.Size(m)
 }
-func (m *BCTipStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_BCTipStatus.DiscardUnknown(m)
+func (m *ProposalLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalLock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BCTipStatus proto.InternalMessageInfo
+var xxx_messageInfo_ProposalLock proto.InternalMessageInfo
 
-func (m *BCTipStatus) GetHeader() *Header {
+func (m *ProposalLock) GetAccount() string {
 	if m != nil {
-		return m.Header
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-func (m *BCTipStatus) GetIsTrunkTip() bool {
+func (m *ProposalLock) GetAmount() string {
 	if m != nil {
-		return m.IsTrunkTip
+		return m.Amount
 	}
-	return false
+	return ""
 }
 
-type BlockChains struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Blockchains          []string `protobuf:"bytes,2,rep,name=blockchains,proto3" json:"blockchains,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ProposalInfo struct {
+	ProposalId string `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// voting, cancelled, rejected, passed, completed_failure, completed_success
+	Status               string           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	VoteAmount           string           `protobuf:"bytes,4,opt,name=vote_amount,json=voteAmount,proto3" json:"vote_amount,omitempty"`
+	StopVoteHeight       int64            `protobuf:"varint,5,opt,name=stop_vote_height,json=stopVoteHeight,proto3" json:"stop_vote_height,omitempty"`
+	MinVotePercent       string           `protobuf:"bytes,6,opt,name=min_vote_percent,json=minVotePercent,proto3" json:"min_vote_percent,omitempty"`
+	VoteThreshold        string           `protobuf:"bytes,7,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	Trigger              *ProposalTrigger `protobuf:"bytes,8,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Locks                []*ProposalLock  `protobuf:"bytes,9,rep,name=locks,proto3" json:"locks,omitempty"`
+	Args                 string           `protobuf:"bytes,10,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *BlockChains) Reset()         { *m = BlockChains{} }
-func (m *BlockChains) String() string { return proto.CompactTextString(m) }
-func (*BlockChains) ProtoMessage()    {}
-func (*BlockChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{25}
+func (m *ProposalInfo) Reset()         { *m = ProposalInfo{} }
+func (m *ProposalInfo) String() string { return proto.CompactTextString(m) }
+func (*ProposalInfo) ProtoMessage()    {}
+func (*ProposalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{87}
 }
 
-func (m *BlockChains) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockChains.Unmarshal(m, b)
+func (m *ProposalInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalInfo.Unmarshal(m, b)
 }
-func (m *BlockChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockChains.Marshal(b, m, deterministic)
+func (m *ProposalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalInfo.Marshal(b, m, deterministic)
 }
-func (m *BlockChains) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockChains.Merge(m, src)
+func (m *ProposalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalInfo.Merge(m, src)
 }
-func (m *BlockChains) XXX_Size() int {
-	return xxx_messageInfo_BlockChains.Size(m)
+func (m *ProposalInfo) XXX_Size() int {
+	return xxx_messageInfo_ProposalInfo.Size(m)
 }
-func (m *BlockChains) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockChains.DiscardUnknown(m)
+func (m *ProposalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockChains proto.InternalMessageInfo
+var xxx_messageInfo_ProposalInfo proto.InternalMessageInfo
 
-func (m *BlockChains) GetHeader() *Header {
+func (m *ProposalInfo) GetProposalId() string {
 	if m != nil {
-		return m.Header
+		return m.ProposalId
 	}
-	return nil
+	return ""
 }
 
-func (m *BlockChains) GetBlockchains() []string {
+func (m *ProposalInfo) GetProposer() string {
 	if m != nil {
-		return m.Blockchains
+		return m.Proposer
 	}
-	return nil
-}
-
-type Speeds struct {
-	SumSpeeds            map[string]float64   `protobuf:"bytes,1,rep,name=SumSpeeds,proto3" json:"SumSpeeds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	BcSpeeds             map[string]*BCSpeeds `protobuf:"bytes,2,rep,name=BcSpeeds,proto3" json:"BcSpeeds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *Speeds) Reset()         { *m = Speeds{} }
-func (m *Speeds) String() string { return proto.CompactTextString(m) }
-func (*Speeds) ProtoMessage()    {}
-func (*Speeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{26}
+	return ""
 }
 
-func (m *Speeds) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Speeds.Unmarshal(m, b)
-}
-func (m *Speeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Speeds.Marshal(b, m, deterministic)
-}
-func (m *Speeds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Speeds.Merge(m, src)
-}
-func (m *Speeds) XXX_Size() int {
-	return xxx_messageInfo_Speeds.Size(m)
-}
-func (m *Speeds) XXX_DiscardUnknown() {
-	xxx_messageInfo_Speeds.DiscardUnknown(m)
+func (m *ProposalInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-var xxx_messageInfo_Speeds proto.InternalMessageInfo
-
-func (m *Speeds) GetSumSpeeds() map[string]float64 {
+func (m *ProposalInfo) GetVoteAmount() string {
 	if m != nil {
-		return m.SumSpeeds
+		return m.VoteAmount
 	}
-	return nil
+	return ""
 }
 
-func (m *Speeds) GetBcSpeeds() map[string]*BCSpeeds {
+func (m *ProposalInfo) GetStopVoteHeight() int64 {
 	if m != nil {
-		return m.BcSpeeds
+		return m.StopVoteHeight
 	}
-	return nil
+	return 0
 }
 
-type BCSpeeds struct {
-	BcSpeed              map[string]float64 `protobuf:"bytes,1,rep,name=BcSpeed,proto3" json:"BcSpeed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *ProposalInfo) GetMinVotePercent() string {
+	if m != nil {
+		return m.MinVotePercent
+	}
+	return ""
 }
 
-func (m *BCSpeeds) Reset()         { *m = BCSpeeds{} }
-func (m *BCSpeeds) String() string { return proto.CompactTextString(m) }
-func (*BCSpeeds) ProtoMessage()    {}
-func (*BCSpeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{27}
+func (m *ProposalInfo) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
+	}
+	return ""
 }
 
-func (m *BCSpeeds) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BCSpeeds.Unmarshal(m, b)
-}
-func (m *BCSpeeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BCSpeeds.Marshal(b, m, deterministic)
-}
-func (m *BCSpeeds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BCSpeeds.Merge(m, src)
-}
-func (m *BCSpeeds) XXX_Size() int {
-	return xxx_messageInfo_BCSpeeds.Size(m)
-}
-func (m *BCSpeeds) XXX_DiscardUnknown() {
-	xxx_messageInfo_BCSpeeds.DiscardUnknown(m)
+func (m *ProposalInfo) GetTrigger() *ProposalTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
 }
 
-var xxx_messageInfo_BCSpeeds proto.InternalMessageInfo
-
-func (m *BCSpeeds) GetBcSpeed() map[string]float64 {
+func (m *ProposalInfo) GetLocks() []*ProposalLock {
 	if m != nil {
-		return m.BcSpeed
+		return m.Locks
 	}
 	return nil
 }
 
-type SystemsStatus struct {
-	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	BcsStatus            []*BCStatus `protobuf:"bytes,2,rep,name=bcs_status,json=bcsStatus,proto3" json:"bcs_status,omitempty"`
-	Speeds               *Speeds     `protobuf:"bytes,3,opt,name=speeds,proto3" json:"speeds,omitempty"`
-	PeerUrls             []string    `protobuf:"bytes,4,rep,name=peerUrls,proto3" json:"peerUrls,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *ProposalInfo) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
 }
 
-func (m *SystemsStatus) Reset()         { *m = SystemsStatus{} }
-func (m *SystemsStatus) String() string { return proto.CompactTextString(m) }
-func (*SystemsStatus) ProtoMessage()    {}
-func (*SystemsStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{28}
+type ProposalQueryResponse struct {
+	Header               *Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string        `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Proposal             *ProposalInfo `protobuf:"bytes,3,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SystemsStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SystemsStatus.Unmarshal(m, b)
+func (m *ProposalQueryResponse) Reset()         { *m = ProposalQueryResponse{} }
+func (m *ProposalQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposalQueryResponse) ProtoMessage()    {}
+func (*ProposalQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{88}
 }
-func (m *SystemsStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SystemsStatus.Marshal(b, m, deterministic)
+
+func (m *ProposalQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalQueryResponse.Unmarshal(m, b)
 }
-func (m *SystemsStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SystemsStatus.Merge(m, src)
+func (m *ProposalQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalQueryResponse.Marshal(b, m, deterministic)
 }
-func (m *SystemsStatus) XXX_Size() int {
-	return xxx_messageInfo_SystemsStatus.Size(m)
+func (m *ProposalQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalQueryResponse.Merge(m, src)
 }
-func (m *SystemsStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_SystemsStatus.DiscardUnknown(m)
+func (m *ProposalQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposalQueryResponse.Size(m)
+}
+func (m *ProposalQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalQueryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SystemsStatus proto.InternalMessageInfo
+var xxx_messageInfo_ProposalQueryResponse proto.InternalMessageInfo
 
-func (m *SystemsStatus) GetHeader() *Header {
+func (m *ProposalQueryResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *SystemsStatus) GetBcsStatus() []*BCStatus {
-	if m != nil {
-		return m.BcsStatus
-	}
-	return nil
-}
-
-func (m *SystemsStatus) GetSpeeds() *Speeds {
+func (m *ProposalQueryResponse) GetBcname() string {
 	if m != nil {
-		return m.Speeds
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *SystemsStatus) GetPeerUrls() []string {
+func (m *ProposalQueryResponse) GetProposal() *ProposalInfo {
 	if m != nil {
-		return m.PeerUrls
+		return m.Proposal
 	}
 	return nil
 }
 
-type SystemsStatusReply struct {
-	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	SystemsStatus        *SystemsStatus `protobuf:"bytes,2,opt,name=systems_status,json=systemsStatus,proto3" json:"systems_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type InvokeRPCRequest struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Requests             []*InvokeRequest `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests,omitempty"`
+	Initiator            string           `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          []string         `protobuf:"bytes,5,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SystemsStatusReply) Reset()         { *m = SystemsStatusReply{} }
-func (m *SystemsStatusReply) String() string { return proto.CompactTextString(m) }
-func (*SystemsStatusReply) ProtoMessage()    {}
-func (*SystemsStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{29}
+func (m *InvokeRPCRequest) Reset()         { *m = InvokeRPCRequest{} }
+func (m *InvokeRPCRequest) String() string { return proto.CompactTextString(m) }
+func (*InvokeRPCRequest) ProtoMessage()    {}
+func (*InvokeRPCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{89}
 }
 
-func (m *SystemsStatusReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SystemsStatusReply.Unmarshal(m, b)
+func (m *InvokeRPCRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeRPCRequest.Unmarshal(m, b)
 }
-func (m *SystemsStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SystemsStatusReply.Marshal(b, m, deterministic)
+func (m *InvokeRPCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeRPCRequest.Marshal(b, m, deterministic)
 }
-func (m *SystemsStatusReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SystemsStatusReply.Merge(m, src)
+func (m *InvokeRPCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeRPCRequest.Merge(m, src)
 }
-func (m *SystemsStatusReply) XXX_Size() int {
-	return xxx_messageInfo_SystemsStatusReply.Size(m)
+func (m *InvokeRPCRequest) XXX_Size() int {
+	return xxx_messageInfo_InvokeRPCRequest.Size(m)
 }
-func (m *SystemsStatusReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_SystemsStatusReply.DiscardUnknown(m)
+func (m *InvokeRPCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeRPCRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SystemsStatusReply proto.InternalMessageInfo
+var xxx_messageInfo_InvokeRPCRequest proto.InternalMessageInfo
 
-func (m *SystemsStatusReply) GetHeader() *Header {
+func (m *InvokeRPCRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *SystemsStatusReply) GetSystemsStatus() *SystemsStatus {
+func (m *InvokeRPCRequest) GetBcname() string {
 	if m != nil {
-		return m.SystemsStatus
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *InvokeRPCRequest) GetRequests() []*InvokeRequest {
+	if m != nil {
+		return m.Requests
 	}
 	return nil
 }
 
-type ConsensusStatRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *InvokeRPCRequest) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
 }
 
-func (m *ConsensusStatRequest) Reset()         { *m = ConsensusStatRequest{} }
-func (m *ConsensusStatRequest) String() string { return proto.CompactTextString(m) }
-func (*ConsensusStatRequest) ProtoMessage()    {}
-func (*ConsensusStatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{30}
+func (m *InvokeRPCRequest) GetAuthRequire() []string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return nil
 }
 
-func (m *ConsensusStatRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConsensusStatRequest.Unmarshal(m, b)
+type InvokeRPCResponse struct {
+	Header               *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string          `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Response             *InvokeResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *ConsensusStatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConsensusStatRequest.Marshal(b, m, deterministic)
+
+func (m *InvokeRPCResponse) Reset()         { *m = InvokeRPCResponse{} }
+func (m *InvokeRPCResponse) String() string { return proto.CompactTextString(m) }
+func (*InvokeRPCResponse) ProtoMessage()    {}
+func (*InvokeRPCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{90}
 }
-func (m *ConsensusStatRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsensusStatRequest.Merge(m, src)
+
+func (m *InvokeRPCResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeRPCResponse.Unmarshal(m, b)
 }
-func (m *ConsensusStatRequest) XXX_Size() int {
-	return xxx_messageInfo_ConsensusStatRequest.Size(m)
+func (m *InvokeRPCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeRPCResponse.Marshal(b, m, deterministic)
 }
-func (m *ConsensusStatRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsensusStatRequest.DiscardUnknown(m)
+func (m *InvokeRPCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeRPCResponse.Merge(m, src)
+}
+func (m *InvokeRPCResponse) XXX_Size() int {
+	return xxx_messageInfo_InvokeRPCResponse.Size(m)
+}
+func (m *InvokeRPCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeRPCResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsensusStatRequest proto.InternalMessageInfo
+var xxx_messageInfo_InvokeRPCResponse proto.InternalMessageInfo
 
-func (m *ConsensusStatRequest) GetHeader() *Header {
+func (m *InvokeRPCResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *ConsensusStatRequest) GetBcname() string {
+func (m *InvokeRPCResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-// Consensus status
-type ConsensusStatus struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// version
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// consensus name
-	ConsensusName string `protobuf:"bytes,3,opt,name=consensus_name,json=consensusName,proto3" json:"consensus_name,omitempty"`
-	// consensus start height
-	StartHeight string `protobuf:"bytes,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// consensus validators info
-	ValidatorsInfo       string   `protobuf:"bytes,5,opt,name=validators_info,json=validatorsInfo,proto3" json:"validators_info,omitempty"`
+func (m *InvokeRPCResponse) GetResponse() *InvokeResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// 预执行的请求结构
+type InvokeRequest struct {
+	ModuleName     string            `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	ContractName   string            `protobuf:"bytes,2,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	MethodName     string            `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	Args           map[string][]byte `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ResourceLimits []*ResourceLimit  `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	// amount is the amount transfer to the contract
+	// attention: In one transaction, transfer to only one contract is allowed
+	Amount               string   `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsensusStatus) Reset()         { *m = ConsensusStatus{} }
-func (m *ConsensusStatus) String() string { return proto.CompactTextString(m) }
-func (*ConsensusStatus) ProtoMessage()    {}
-func (*ConsensusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{31}
+func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
+func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
+func (*InvokeRequest) ProtoMessage()    {}
+func (*InvokeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{91}
 }
 
-func (m *ConsensusStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConsensusStatus.Unmarshal(m, b)
+func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeRequest.Unmarshal(m, b)
 }
-func (m *ConsensusStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConsensusStatus.Marshal(b, m, deterministic)
+func (m *InvokeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeRequest.Marshal(b, m, deterministic)
 }
-func (m *ConsensusStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsensusStatus.Merge(m, src)
+func (m *InvokeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeRequest.Merge(m, src)
 }
-func (m *ConsensusStatus) XXX_Size() int {
-	return xxx_messageInfo_ConsensusStatus.Size(m)
+func (m *InvokeRequest) XXX_Size() int {
+	return xxx_messageInfo_InvokeRequest.Size(m)
 }
-func (m *ConsensusStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsensusStatus.DiscardUnknown(m)
+func (m *InvokeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsensusStatus proto.InternalMessageInfo
+var xxx_messageInfo_InvokeRequest proto.InternalMessageInfo
 
-func (m *ConsensusStatus) GetHeader() *Header {
+func (m *InvokeRequest) GetModuleName() string {
 	if m != nil {
-		return m.Header
+		return m.ModuleName
 	}
-	return nil
+	return ""
 }
 
-func (m *ConsensusStatus) GetVersion() string {
+func (m *InvokeRequest) GetContractName() string {
 	if m != nil {
-		return m.Version
+		return m.ContractName
 	}
 	return ""
 }
 
-func (m *ConsensusStatus) GetConsensusName() string {
+func (m *InvokeRequest) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+func (m *InvokeRequest) GetArgs() map[string][]byte {
 	if m != nil {
-		return m.ConsensusName
+		return m.Args
 	}
-	return ""
+	return nil
 }
 
-func (m *ConsensusStatus) GetStartHeight() string {
+func (m *InvokeRequest) GetResourceLimits() []*ResourceLimit {
 	if m != nil {
-		return m.StartHeight
+		return m.ResourceLimits
 	}
-	return ""
+	return nil
 }
 
-func (m *ConsensusStatus) GetValidatorsInfo() string {
+func (m *InvokeRequest) GetAmount() string {
 	if m != nil {
-		return m.ValidatorsInfo
+		return m.Amount
 	}
 	return ""
 }
 
-// RawUrl return the node's  connect url
-type RawUrl struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RawUrl               string   `protobuf:"bytes,2,opt,name=rawUrl,proto3" json:"rawUrl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// 预执行的返回结构
+type InvokeResponse struct {
+	Inputs               []*TxInputExt       `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs              []*TxOutputExt      `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Response             [][]byte            `protobuf:"bytes,3,rep,name=response,proto3" json:"response,omitempty"`
+	GasUsed              int64               `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Requests             []*InvokeRequest    `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	Responses            []*ContractResponse `protobuf:"bytes,6,rep,name=responses,proto3" json:"responses,omitempty"`
+	UtxoInputs           []*TxInput          `protobuf:"bytes,7,rep,name=utxoInputs,proto3" json:"utxoInputs,omitempty"`
+	UtxoOutputs          []*TxOutput         `protobuf:"bytes,8,rep,name=utxoOutputs,proto3" json:"utxoOutputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *RawUrl) Reset()         { *m = RawUrl{} }
-func (m *RawUrl) String() string { return proto.CompactTextString(m) }
-func (*RawUrl) ProtoMessage()    {}
-func (*RawUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{32}
+func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
+func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
+func (*InvokeResponse) ProtoMessage()    {}
+func (*InvokeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{92}
 }
 
-func (m *RawUrl) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RawUrl.Unmarshal(m, b)
+func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeResponse.Unmarshal(m, b)
 }
-func (m *RawUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RawUrl.Marshal(b, m, deterministic)
+func (m *InvokeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeResponse.Marshal(b, m, deterministic)
 }
-func (m *RawUrl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawUrl.Merge(m, src)
+func (m *InvokeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeResponse.Merge(m, src)
 }
-func (m *RawUrl) XXX_Size() int {
-	return xxx_messageInfo_RawUrl.Size(m)
+func (m *InvokeResponse) XXX_Size() int {
+	return xxx_messageInfo_InvokeResponse.Size(m)
 }
-func (m *RawUrl) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawUrl.DiscardUnknown(m)
+func (m *InvokeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawUrl proto.InternalMessageInfo
+var xxx_messageInfo_InvokeResponse proto.InternalMessageInfo
 
-func (m *RawUrl) GetHeader() *Header {
+func (m *InvokeResponse) GetInputs() []*TxInputExt {
 	if m != nil {
-		return m.Header
+		return m.Inputs
 	}
 	return nil
 }
 
-func (m *RawUrl) GetRawUrl() string {
+func (m *InvokeResponse) GetOutputs() []*TxOutputExt {
 	if m != nil {
-		return m.RawUrl
+		return m.Outputs
 	}
-	return ""
-}
-
-type Utxo struct {
-	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	ToAddr               []byte   `protobuf:"bytes,2,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
-	ToPubkey             []byte   `protobuf:"bytes,3,opt,name=toPubkey,proto3" json:"toPubkey,omitempty"`
-	RefTxid              []byte   `protobuf:"bytes,4,opt,name=refTxid,proto3" json:"refTxid,omitempty"`
-	RefOffset            int32    `protobuf:"varint,5,opt,name=refOffset,proto3" json:"refOffset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Utxo) Reset()         { *m = Utxo{} }
-func (m *Utxo) String() string { return proto.CompactTextString(m) }
-func (*Utxo) ProtoMessage()    {}
-func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{33}
+	return nil
 }
 
-func (m *Utxo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Utxo.Unmarshal(m, b)
-}
-func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
-}
-func (m *Utxo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Utxo.Merge(m, src)
-}
-func (m *Utxo) XXX_Size() int {
-	return xxx_messageInfo_Utxo.Size(m)
-}
-func (m *Utxo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Utxo.DiscardUnknown(m)
+func (m *InvokeResponse) GetResponse() [][]byte {
+	if m != nil {
+		return m.Response
+	}
+	return nil
 }
 
-var xxx_messageInfo_Utxo proto.InternalMessageInfo
-
-func (m *Utxo) GetAmount() []byte {
+func (m *InvokeResponse) GetGasUsed() int64 {
 	if m != nil {
-		return m.Amount
+		return m.GasUsed
 	}
-	return nil
+	return 0
 }
 
-func (m *Utxo) GetToAddr() []byte {
+func (m *InvokeResponse) GetRequests() []*InvokeRequest {
 	if m != nil {
-		return m.ToAddr
+		return m.Requests
 	}
 	return nil
 }
 
-func (m *Utxo) GetToPubkey() []byte {
+func (m *InvokeResponse) GetResponses() []*ContractResponse {
 	if m != nil {
-		return m.ToPubkey
+		return m.Responses
 	}
 	return nil
 }
 
-func (m *Utxo) GetRefTxid() []byte {
+func (m *InvokeResponse) GetUtxoInputs() []*TxInput {
 	if m != nil {
-		return m.RefTxid
+		return m.UtxoInputs
 	}
 	return nil
 }
 
-func (m *Utxo) GetRefOffset() int32 {
+func (m *InvokeResponse) GetUtxoOutputs() []*TxOutput {
 	if m != nil {
-		return m.RefOffset
+		return m.UtxoOutputs
 	}
-	return 0
+	return nil
 }
 
-// UtxoInput query info to query utxos
-type UtxoInput struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// which bcname to select
-	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	// address to select
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// publickey of the address
-	Publickey string `protobuf:"bytes,4,opt,name=publickey,proto3" json:"publickey,omitempty"`
-	// totalNeed refer the total need utxos to select
-	TotalNeed string `protobuf:"bytes,5,opt,name=totalNeed,proto3" json:"totalNeed,omitempty"`
-	// userSign of input
-	UserSign []byte `protobuf:"bytes,7,opt,name=userSign,proto3" json:"userSign,omitempty"`
-	// need lock
-	NeedLock             bool     `protobuf:"varint,8,opt,name=needLock,proto3" json:"needLock,omitempty"`
+// 扩展输入
+type TxInputExt struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RefTxid              []byte   `protobuf:"bytes,3,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
+	RefOffset            int32    `protobuf:"varint,4,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UtxoInput) Reset()         { *m = UtxoInput{} }
-func (m *UtxoInput) String() string { return proto.CompactTextString(m) }
-func (*UtxoInput) ProtoMessage()    {}
-func (*UtxoInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{34}
+func (m *TxInputExt) Reset()         { *m = TxInputExt{} }
+func (m *TxInputExt) String() string { return proto.CompactTextString(m) }
+func (*TxInputExt) ProtoMessage()    {}
+func (*TxInputExt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{93}
 }
 
-func (m *UtxoInput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoInput.Unmarshal(m, b)
-}
-func (m *UtxoInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoInput.Marshal(b, m, deterministic)
-}
-func (m *UtxoInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoInput.Merge(m, src)
+func (m *TxInputExt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxInputExt.Unmarshal(m, b)
 }
-func (m *UtxoInput) XXX_Size() int {
-	return xxx_messageInfo_UtxoInput.Size(m)
+func (m *TxInputExt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxInputExt.Marshal(b, m, deterministic)
 }
-func (m *UtxoInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoInput.DiscardUnknown(m)
+func (m *TxInputExt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInputExt.Merge(m, src)
 }
-
-var xxx_messageInfo_UtxoInput proto.InternalMessageInfo
-
-func (m *UtxoInput) GetHeader() *Header {
-	if m != nil {
-		return m.Header
-	}
-	return nil
+func (m *TxInputExt) XXX_Size() int {
+	return xxx_messageInfo_TxInputExt.Size(m)
 }
-
-func (m *UtxoInput) GetBcname() string {
-	if m != nil {
-		return m.Bcname
-	}
-	return ""
+func (m *TxInputExt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxInputExt.DiscardUnknown(m)
 }
 
-func (m *UtxoInput) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_TxInputExt proto.InternalMessageInfo
 
-func (m *UtxoInput) GetPublickey() string {
+func (m *TxInputExt) GetBucket() string {
 	if m != nil {
-		return m.Publickey
+		return m.Bucket
 	}
 	return ""
 }
 
-func (m *UtxoInput) GetTotalNeed() string {
+func (m *TxInputExt) GetKey() []byte {
 	if m != nil {
-		return m.TotalNeed
+		return m.Key
 	}
-	return ""
+	return nil
 }
 
-func (m *UtxoInput) GetUserSign() []byte {
+func (m *TxInputExt) GetRefTxid() []byte {
 	if m != nil {
-		return m.UserSign
+		return m.RefTxid
 	}
 	return nil
 }
 
-func (m *UtxoInput) GetNeedLock() bool {
+func (m *TxInputExt) GetRefOffset() int32 {
 	if m != nil {
-		return m.NeedLock
+		return m.RefOffset
 	}
-	return false
+	return 0
 }
 
-// UtxoOutput query results
-type UtxoOutput struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// outSign return the output
-	// bytes outSign = 2;
-	// utxo list
-	UtxoList []*Utxo `protobuf:"bytes,2,rep,name=utxoList,proto3" json:"utxoList,omitempty"`
-	// total selected amount
-	TotalSelected        string   `protobuf:"bytes,3,opt,name=totalSelected,proto3" json:"totalSelected,omitempty"`
+// 扩展输出
+type TxOutputExt struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UtxoOutput) Reset()         { *m = UtxoOutput{} }
-func (m *UtxoOutput) String() string { return proto.CompactTextString(m) }
-func (*UtxoOutput) ProtoMessage()    {}
-func (*UtxoOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{35}
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UtxoOutput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoOutput.Unmarshal(m, b)
+func (m *TxOutputExt) Reset()         { *m = TxOutputExt{} }
+func (m *TxOutputExt) String() string { return proto.CompactTextString(m) }
+func (*TxOutputExt) ProtoMessage()    {}
+func (*TxOutputExt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{94}
 }
-func (m *UtxoOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoOutput.Marshal(b, m, deterministic)
+
+func (m *TxOutputExt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxOutputExt.Unmarshal(m, b)
 }
-func (m *UtxoOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoOutput.Merge(m, src)
+func (m *TxOutputExt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxOutputExt.Marshal(b, m, deterministic)
 }
-func (m *UtxoOutput) XXX_Size() int {
-	return xxx_messageInfo_UtxoOutput.Size(m)
+func (m *TxOutputExt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutputExt.Merge(m, src)
 }
-func (m *UtxoOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoOutput.DiscardUnknown(m)
+func (m *TxOutputExt) XXX_Size() int {
+	return xxx_messageInfo_TxOutputExt.Size(m)
+}
+func (m *TxOutputExt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutputExt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UtxoOutput proto.InternalMessageInfo
+var xxx_messageInfo_TxOutputExt proto.InternalMessageInfo
 
-func (m *UtxoOutput) GetHeader() *Header {
+func (m *TxOutputExt) GetBucket() string {
 	if m != nil {
-		return m.Header
+		return m.Bucket
 	}
-	return nil
+	return ""
 }
 
-func (m *UtxoOutput) GetUtxoList() []*Utxo {
+func (m *TxOutputExt) GetKey() []byte {
 	if m != nil {
-		return m.UtxoList
+		return m.Key
 	}
 	return nil
 }
 
-func (m *UtxoOutput) GetTotalSelected() string {
+func (m *TxOutputExt) GetValue() []byte {
 	if m != nil {
-		return m.TotalSelected
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-type NativeCodeDesc struct {
-	// native code name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// code version
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// digest of binary
-	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
-	// the previous verion
-	PrevVersion string `protobuf:"bytes,4,opt,name=prevVersion,proto3" json:"prevVersion,omitempty"`
-	// xuper api version
-	XuperApiVersion      int32    `protobuf:"varint,5,opt,name=xuperApiVersion,proto3" json:"xuperApiVersion,omitempty"`
+// 签名详情
+type SignatureInfo struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Sign                 []byte   `protobuf:"bytes,2,opt,name=Sign,proto3" json:"Sign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NativeCodeDesc) Reset()         { *m = NativeCodeDesc{} }
-func (m *NativeCodeDesc) String() string { return proto.CompactTextString(m) }
-func (*NativeCodeDesc) ProtoMessage()    {}
-func (*NativeCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{36}
+func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
+func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
+func (*SignatureInfo) ProtoMessage()    {}
+func (*SignatureInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{95}
 }
 
-func (m *NativeCodeDesc) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NativeCodeDesc.Unmarshal(m, b)
+func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureInfo.Unmarshal(m, b)
 }
-func (m *NativeCodeDesc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NativeCodeDesc.Marshal(b, m, deterministic)
+func (m *SignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureInfo.Marshal(b, m, deterministic)
 }
-func (m *NativeCodeDesc) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NativeCodeDesc.Merge(m, src)
+func (m *SignatureInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureInfo.Merge(m, src)
 }
-func (m *NativeCodeDesc) XXX_Size() int {
-	return xxx_messageInfo_NativeCodeDesc.Size(m)
+func (m *SignatureInfo) XXX_Size() int {
+	return xxx_messageInfo_SignatureInfo.Size(m)
 }
-func (m *NativeCodeDesc) XXX_DiscardUnknown() {
-	xxx_messageInfo_NativeCodeDesc.DiscardUnknown(m)
+func (m *SignatureInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NativeCodeDesc proto.InternalMessageInfo
+var xxx_messageInfo_SignatureInfo proto.InternalMessageInfo
 
-func (m *NativeCodeDesc) GetName() string {
+func (m *SignatureInfo) GetPublicKey() string {
 	if m != nil {
-		return m.Name
+		return m.PublicKey
 	}
 	return ""
 }
 
-func (m *NativeCodeDesc) GetVersion() string {
+func (m *SignatureInfo) GetSign() []byte {
 	if m != nil {
-		return m.Version
+		return m.Sign
 	}
-	return ""
+	return nil
 }
 
-func (m *NativeCodeDesc) GetDigest() []byte {
-	if m != nil {
-		return m.Digest
-	}
-	return nil
+type PermissionModel struct {
+	Rule                 PermissionRule `protobuf:"varint,1,opt,name=rule,proto3,enum=pb.PermissionRule" json:"rule,omitempty"`
+	AcceptValue          float64        `protobuf:"fixed64,2,opt,name=acceptValue,proto3" json:"acceptValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *NativeCodeDesc) GetPrevVersion() string {
+func (m *PermissionModel) Reset()         { *m = PermissionModel{} }
+func (m *PermissionModel) String() string { return proto.CompactTextString(m) }
+func (*PermissionModel) ProtoMessage()    {}
+func (*PermissionModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{96}
+}
+
+func (m *PermissionModel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionModel.Unmarshal(m, b)
+}
+func (m *PermissionModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionModel.Marshal(b, m, deterministic)
+}
+func (m *PermissionModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionModel.Merge(m, src)
+}
+func (m *PermissionModel) XXX_Size() int {
+	return xxx_messageInfo_PermissionModel.Size(m)
+}
+func (m *PermissionModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionModel proto.InternalMessageInfo
+
+func (m *PermissionModel) GetRule() PermissionRule {
 	if m != nil {
-		return m.PrevVersion
+		return m.Rule
 	}
-	return ""
+	return PermissionRule_NULL
 }
 
-func (m *NativeCodeDesc) GetXuperApiVersion() int32 {
+func (m *PermissionModel) GetAcceptValue() float64 {
 	if m != nil {
-		return m.XuperApiVersion
+		return m.AcceptValue
 	}
 	return 0
 }
 
-type WasmCodeDesc struct {
-	Runtime              string   `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Compiler             string   `protobuf:"bytes,2,opt,name=compiler,proto3" json:"compiler,omitempty"`
-	Digest               []byte   `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
-	VmCompiler           string   `protobuf:"bytes,4,opt,name=vm_compiler,json=vmCompiler,proto3" json:"vm_compiler,omitempty"`
-	ContractType         string   `protobuf:"bytes,5,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`
+// AK集的表示方法
+type AkSet struct {
+	Aks                  []string `protobuf:"bytes,1,rep,name=aks,proto3" json:"aks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WasmCodeDesc) Reset()         { *m = WasmCodeDesc{} }
-func (m *WasmCodeDesc) String() string { return proto.CompactTextString(m) }
-func (*WasmCodeDesc) ProtoMessage()    {}
-func (*WasmCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{37}
+func (m *AkSet) Reset()         { *m = AkSet{} }
+func (m *AkSet) String() string { return proto.CompactTextString(m) }
+func (*AkSet) ProtoMessage()    {}
+func (*AkSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{97}
 }
 
-func (m *WasmCodeDesc) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WasmCodeDesc.Unmarshal(m, b)
+func (m *AkSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AkSet.Unmarshal(m, b)
 }
-func (m *WasmCodeDesc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WasmCodeDesc.Marshal(b, m, deterministic)
+func (m *AkSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AkSet.Marshal(b, m, deterministic)
 }
-func (m *WasmCodeDesc) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WasmCodeDesc.Merge(m, src)
+func (m *AkSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AkSet.Merge(m, src)
 }
-func (m *WasmCodeDesc) XXX_Size() int {
-	return xxx_messageInfo_WasmCodeDesc.Size(m)
+func (m *AkSet) XXX_Size() int {
+	return xxx_messageInfo_AkSet.Size(m)
 }
-func (m *WasmCodeDesc) XXX_DiscardUnknown() {
-	xxx_messageInfo_WasmCodeDesc.DiscardUnknown(m)
+func (m *AkSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AkSet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WasmCodeDesc proto.InternalMessageInfo
+var xxx_messageInfo_AkSet proto.InternalMessageInfo
 
-func (m *WasmCodeDesc) GetRuntime() string {
+func (m *AkSet) GetAks() []string {
 	if m != nil {
-		return m.Runtime
+		return m.Aks
 	}
-	return ""
+	return nil
 }
 
-func (m *WasmCodeDesc) GetCompiler() string {
-	if m != nil {
-		return m.Compiler
-	}
-	return ""
+type AkSets struct {
+	Sets                 map[string]*AkSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Expression           string            `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *WasmCodeDesc) GetDigest() []byte {
-	if m != nil {
-		return m.Digest
-	}
-	return nil
+func (m *AkSets) Reset()         { *m = AkSets{} }
+func (m *AkSets) String() string { return proto.CompactTextString(m) }
+func (*AkSets) ProtoMessage()    {}
+func (*AkSets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{98}
 }
 
-func (m *WasmCodeDesc) GetVmCompiler() string {
+func (m *AkSets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AkSets.Unmarshal(m, b)
+}
+func (m *AkSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AkSets.Marshal(b, m, deterministic)
+}
+func (m *AkSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AkSets.Merge(m, src)
+}
+func (m *AkSets) XXX_Size() int {
+	return xxx_messageInfo_AkSets.Size(m)
+}
+func (m *AkSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_AkSets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AkSets proto.InternalMessageInfo
+
+func (m *AkSets) GetSets() map[string]*AkSet {
 	if m != nil {
-		return m.VmCompiler
+		return m.Sets
 	}
-	return ""
+	return nil
 }
 
-func (m *WasmCodeDesc) GetContractType() string {
+func (m *AkSets) GetExpression() string {
 	if m != nil {
-		return m.ContractType
+		return m.Expression
 	}
 	return ""
 }
 
-type NativeCodeStatus struct {
-	Desc                 *NativeCodeDesc `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
-	Status               int32           `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Healthy              bool            `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// Acl实际使用的结构
+type Acl struct {
+	Pm                   *PermissionModel   `protobuf:"bytes,1,opt,name=pm,proto3" json:"pm,omitempty"`
+	AksWeight            map[string]float64 `protobuf:"bytes,2,rep,name=aksWeight,proto3" json:"aksWeight,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	AkSets               *AkSets            `protobuf:"bytes,3,opt,name=akSets,proto3" json:"akSets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *NativeCodeStatus) Reset()         { *m = NativeCodeStatus{} }
-func (m *NativeCodeStatus) String() string { return proto.CompactTextString(m) }
-func (*NativeCodeStatus) ProtoMessage()    {}
-func (*NativeCodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{38}
+func (m *Acl) Reset()         { *m = Acl{} }
+func (m *Acl) String() string { return proto.CompactTextString(m) }
+func (*Acl) ProtoMessage()    {}
+func (*Acl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{99}
 }
 
-func (m *NativeCodeStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NativeCodeStatus.Unmarshal(m, b)
+func (m *Acl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Acl.Unmarshal(m, b)
 }
-func (m *NativeCodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NativeCodeStatus.Marshal(b, m, deterministic)
+func (m *Acl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Acl.Marshal(b, m, deterministic)
 }
-func (m *NativeCodeStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NativeCodeStatus.Merge(m, src)
+func (m *Acl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Acl.Merge(m, src)
 }
-func (m *NativeCodeStatus) XXX_Size() int {
-	return xxx_messageInfo_NativeCodeStatus.Size(m)
+func (m *Acl) XXX_Size() int {
+	return xxx_messageInfo_Acl.Size(m)
 }
-func (m *NativeCodeStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_NativeCodeStatus.DiscardUnknown(m)
+func (m *Acl) XXX_DiscardUnknown() {
+	xxx_messageInfo_Acl.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NativeCodeStatus proto.InternalMessageInfo
+var xxx_messageInfo_Acl proto.InternalMessageInfo
 
-func (m *NativeCodeStatus) GetDesc() *NativeCodeDesc {
+func (m *Acl) GetPm() *PermissionModel {
 	if m != nil {
-		return m.Desc
+		return m.Pm
 	}
 	return nil
 }
 
-func (m *NativeCodeStatus) GetStatus() int32 {
+func (m *Acl) GetAksWeight() map[string]float64 {
 	if m != nil {
-		return m.Status
+		return m.AksWeight
 	}
-	return 0
+	return nil
 }
 
-func (m *NativeCodeStatus) GetHealthy() bool {
+func (m *Acl) GetAkSets() *AkSets {
 	if m != nil {
-		return m.Healthy
+		return m.AkSets
 	}
-	return false
+	return nil
 }
 
-// 候选人列表接口
-type DposCandidatesRequest struct {
+// 查询Acl
+type AclStatus struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	AccountName          string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ContractName         string   `protobuf:"bytes,4,opt,name=contractName,proto3" json:"contractName,omitempty"`
+	MethodName           string   `protobuf:"bytes,5,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	Confirmed            bool     `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Acl                  *Acl     `protobuf:"bytes,7,opt,name=acl,proto3" json:"acl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposCandidatesRequest) Reset()         { *m = DposCandidatesRequest{} }
-func (m *DposCandidatesRequest) String() string { return proto.CompactTextString(m) }
-func (*DposCandidatesRequest) ProtoMessage()    {}
-func (*DposCandidatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{39}
+func (m *AclStatus) Reset()         { *m = AclStatus{} }
+func (m *AclStatus) String() string { return proto.CompactTextString(m) }
+func (*AclStatus) ProtoMessage()    {}
+func (*AclStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{100}
 }
 
-func (m *DposCandidatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposCandidatesRequest.Unmarshal(m, b)
+func (m *AclStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AclStatus.Unmarshal(m, b)
 }
-func (m *DposCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposCandidatesRequest.Marshal(b, m, deterministic)
+func (m *AclStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AclStatus.Marshal(b, m, deterministic)
 }
-func (m *DposCandidatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposCandidatesRequest.Merge(m, src)
+func (m *AclStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AclStatus.Merge(m, src)
 }
-func (m *DposCandidatesRequest) XXX_Size() int {
-	return xxx_messageInfo_DposCandidatesRequest.Size(m)
+func (m *AclStatus) XXX_Size() int {
+	return xxx_messageInfo_AclStatus.Size(m)
 }
-func (m *DposCandidatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposCandidatesRequest.DiscardUnknown(m)
+func (m *AclStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AclStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposCandidatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_AclStatus proto.InternalMessageInfo
 
-func (m *DposCandidatesRequest) GetHeader() *Header {
+func (m *AclStatus) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposCandidatesRequest) GetBcname() string {
+func (m *AclStatus) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-// 候选人列表返回
-type DposCandidatesResponse struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	CandidatesInfo       []string `protobuf:"bytes,2,rep,name=candidatesInfo,proto3" json:"candidatesInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AclStatus) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
 }
 
-func (m *DposCandidatesResponse) Reset()         { *m = DposCandidatesResponse{} }
-func (m *DposCandidatesResponse) String() string { return proto.CompactTextString(m) }
-func (*DposCandidatesResponse) ProtoMessage()    {}
-func (*DposCandidatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{40}
+func (m *AclStatus) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
 }
 
-func (m *DposCandidatesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposCandidatesResponse.Unmarshal(m, b)
-}
-func (m *DposCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposCandidatesResponse.Marshal(b, m, deterministic)
-}
-func (m *DposCandidatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposCandidatesResponse.Merge(m, src)
-}
-func (m *DposCandidatesResponse) XXX_Size() int {
-	return xxx_messageInfo_DposCandidatesResponse.Size(m)
-}
-func (m *DposCandidatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposCandidatesResponse.DiscardUnknown(m)
+func (m *AclStatus) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
 }
 
-var xxx_messageInfo_DposCandidatesResponse proto.InternalMessageInfo
-
-func (m *DposCandidatesResponse) GetHeader() *Header {
+func (m *AclStatus) GetConfirmed() bool {
 	if m != nil {
-		return m.Header
+		return m.Confirmed
 	}
-	return nil
+	return false
 }
 
-func (m *DposCandidatesResponse) GetCandidatesInfo() []string {
+func (m *AclStatus) GetAcl() *Acl {
 	if m != nil {
-		return m.CandidatesInfo
+		return m.Acl
 	}
 	return nil
 }
 
-// 提名者提名记录请求
-type DposNominateRecordsRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+// Identity authentication request
+type IdentityAuth struct {
+	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	PeerID               string   `protobuf:"bytes,4,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Timestamp            string   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposNominateRecordsRequest) Reset()         { *m = DposNominateRecordsRequest{} }
-func (m *DposNominateRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*DposNominateRecordsRequest) ProtoMessage()    {}
-func (*DposNominateRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{41}
+func (m *IdentityAuth) Reset()         { *m = IdentityAuth{} }
+func (m *IdentityAuth) String() string { return proto.CompactTextString(m) }
+func (*IdentityAuth) ProtoMessage()    {}
+func (*IdentityAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{101}
 }
 
-func (m *DposNominateRecordsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposNominateRecordsRequest.Unmarshal(m, b)
+func (m *IdentityAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentityAuth.Unmarshal(m, b)
 }
-func (m *DposNominateRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposNominateRecordsRequest.Marshal(b, m, deterministic)
+func (m *IdentityAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentityAuth.Marshal(b, m, deterministic)
 }
-func (m *DposNominateRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposNominateRecordsRequest.Merge(m, src)
+func (m *IdentityAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityAuth.Merge(m, src)
 }
-func (m *DposNominateRecordsRequest) XXX_Size() int {
-	return xxx_messageInfo_DposNominateRecordsRequest.Size(m)
+func (m *IdentityAuth) XXX_Size() int {
+	return xxx_messageInfo_IdentityAuth.Size(m)
 }
-func (m *DposNominateRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposNominateRecordsRequest.DiscardUnknown(m)
+func (m *IdentityAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityAuth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposNominateRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_IdentityAuth proto.InternalMessageInfo
 
-func (m *DposNominateRecordsRequest) GetHeader() *Header {
+func (m *IdentityAuth) GetSign() []byte {
 	if m != nil {
-		return m.Header
+		return m.Sign
 	}
 	return nil
 }
 
-func (m *DposNominateRecordsRequest) GetBcname() string {
+func (m *IdentityAuth) GetPubkey() []byte {
 	if m != nil {
-		return m.Bcname
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *IdentityAuth) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *DposNominateRecordsRequest) GetAddress() string {
+func (m *IdentityAuth) GetPeerID() string {
 	if m != nil {
-		return m.Address
+		return m.PeerID
 	}
 	return ""
 }
 
-// 候选人信息
-type DposNominateInfo struct {
-	Candidate            string   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
-	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *IdentityAuth) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
 }
 
-func (m *DposNominateInfo) Reset()         { *m = DposNominateInfo{} }
-func (m *DposNominateInfo) String() string { return proto.CompactTextString(m) }
-func (*DposNominateInfo) ProtoMessage()    {}
-func (*DposNominateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{42}
+// Identity authentication requests
+type IdentityAuths struct {
+	Auth                 []*IdentityAuth `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DposNominateInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposNominateInfo.Unmarshal(m, b)
+func (m *IdentityAuths) Reset()         { *m = IdentityAuths{} }
+func (m *IdentityAuths) String() string { return proto.CompactTextString(m) }
+func (*IdentityAuths) ProtoMessage()    {}
+func (*IdentityAuths) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{102}
 }
-func (m *DposNominateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposNominateInfo.Marshal(b, m, deterministic)
+
+func (m *IdentityAuths) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentityAuths.Unmarshal(m, b)
 }
-func (m *DposNominateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposNominateInfo.Merge(m, src)
+func (m *IdentityAuths) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentityAuths.Marshal(b, m, deterministic)
 }
-func (m *DposNominateInfo) XXX_Size() int {
-	return xxx_messageInfo_DposNominateInfo.Size(m)
+func (m *IdentityAuths) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityAuths.Merge(m, src)
 }
-func (m *DposNominateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposNominateInfo.DiscardUnknown(m)
+func (m *IdentityAuths) XXX_Size() int {
+	return xxx_messageInfo_IdentityAuths.Size(m)
 }
-
-var xxx_messageInfo_DposNominateInfo proto.InternalMessageInfo
-
-func (m *DposNominateInfo) GetCandidate() string {
-	if m != nil {
-		return m.Candidate
-	}
-	return ""
+func (m *IdentityAuths) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityAuths.DiscardUnknown(m)
 }
 
-func (m *DposNominateInfo) GetTxid() string {
+var xxx_messageInfo_IdentityAuths proto.InternalMessageInfo
+
+func (m *IdentityAuths) GetAuth() []*IdentityAuth {
 	if m != nil {
-		return m.Txid
+		return m.Auth
 	}
-	return ""
+	return nil
 }
 
-// 提名者提名记录返回
-type DposNominateRecordsResponse struct {
-	Header               *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	NominateRecords      []*DposNominateInfo `protobuf:"bytes,2,rep,name=nominateRecords,proto3" json:"nominateRecords,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type ResourceLimit struct {
+	Type                 ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.ResourceType" json:"type,omitempty"`
+	Limit                int64        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DposNominateRecordsResponse) Reset()         { *m = DposNominateRecordsResponse{} }
-func (m *DposNominateRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*DposNominateRecordsResponse) ProtoMessage()    {}
-func (*DposNominateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{43}
+func (m *ResourceLimit) Reset()         { *m = ResourceLimit{} }
+func (m *ResourceLimit) String() string { return proto.CompactTextString(m) }
+func (*ResourceLimit) ProtoMessage()    {}
+func (*ResourceLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{103}
 }
 
-func (m *DposNominateRecordsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposNominateRecordsResponse.Unmarshal(m, b)
+func (m *ResourceLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceLimit.Unmarshal(m, b)
 }
-func (m *DposNominateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposNominateRecordsResponse.Marshal(b, m, deterministic)
+func (m *ResourceLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceLimit.Marshal(b, m, deterministic)
 }
-func (m *DposNominateRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposNominateRecordsResponse.Merge(m, src)
+func (m *ResourceLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceLimit.Merge(m, src)
 }
-func (m *DposNominateRecordsResponse) XXX_Size() int {
-	return xxx_messageInfo_DposNominateRecordsResponse.Size(m)
+func (m *ResourceLimit) XXX_Size() int {
+	return xxx_messageInfo_ResourceLimit.Size(m)
 }
-func (m *DposNominateRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposNominateRecordsResponse.DiscardUnknown(m)
+func (m *ResourceLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposNominateRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResourceLimit proto.InternalMessageInfo
 
-func (m *DposNominateRecordsResponse) GetHeader() *Header {
+func (m *ResourceLimit) GetType() ResourceType {
 	if m != nil {
-		return m.Header
+		return m.Type
 	}
-	return nil
+	return ResourceType_CPU
 }
 
-func (m *DposNominateRecordsResponse) GetNominateRecords() []*DposNominateInfo {
+func (m *ResourceLimit) GetLimit() int64 {
 	if m != nil {
-		return m.NominateRecords
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-// 候选人被提名记录请求
-type DposNomineeRecordsRequest struct {
+type AK2AccountRequest struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
 	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
@@ -3346,2564 +7417,2818 @@ type DposNomineeRecordsRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposNomineeRecordsRequest) Reset()         { *m = DposNomineeRecordsRequest{} }
-func (m *DposNomineeRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*DposNomineeRecordsRequest) ProtoMessage()    {}
-func (*DposNomineeRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{44}
+func (m *AK2AccountRequest) Reset()         { *m = AK2AccountRequest{} }
+func (m *AK2AccountRequest) String() string { return proto.CompactTextString(m) }
+func (*AK2AccountRequest) ProtoMessage()    {}
+func (*AK2AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{104}
 }
 
-func (m *DposNomineeRecordsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposNomineeRecordsRequest.Unmarshal(m, b)
+func (m *AK2AccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AK2AccountRequest.Unmarshal(m, b)
 }
-func (m *DposNomineeRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposNomineeRecordsRequest.Marshal(b, m, deterministic)
+func (m *AK2AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AK2AccountRequest.Marshal(b, m, deterministic)
 }
-func (m *DposNomineeRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposNomineeRecordsRequest.Merge(m, src)
+func (m *AK2AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AK2AccountRequest.Merge(m, src)
 }
-func (m *DposNomineeRecordsRequest) XXX_Size() int {
-	return xxx_messageInfo_DposNomineeRecordsRequest.Size(m)
+func (m *AK2AccountRequest) XXX_Size() int {
+	return xxx_messageInfo_AK2AccountRequest.Size(m)
 }
-func (m *DposNomineeRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposNomineeRecordsRequest.DiscardUnknown(m)
+func (m *AK2AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AK2AccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposNomineeRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AK2AccountRequest proto.InternalMessageInfo
 
-func (m *DposNomineeRecordsRequest) GetHeader() *Header {
+func (m *AK2AccountRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposNomineeRecordsRequest) GetBcname() string {
+func (m *AK2AccountRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *DposNomineeRecordsRequest) GetAddress() string {
+func (m *AK2AccountRequest) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-// 候选人被提名记录返回
-type DposNomineeRecordsResponse struct {
+type AK2AccountResponse struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              []string `protobuf:"bytes,3,rep,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposNomineeRecordsResponse) Reset()         { *m = DposNomineeRecordsResponse{} }
-func (m *DposNomineeRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*DposNomineeRecordsResponse) ProtoMessage()    {}
-func (*DposNomineeRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{45}
+func (m *AK2AccountResponse) Reset()         { *m = AK2AccountResponse{} }
+func (m *AK2AccountResponse) String() string { return proto.CompactTextString(m) }
+func (*AK2AccountResponse) ProtoMessage()    {}
+func (*AK2AccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{105}
 }
 
-func (m *DposNomineeRecordsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposNomineeRecordsResponse.Unmarshal(m, b)
+func (m *AK2AccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AK2AccountResponse.Unmarshal(m, b)
 }
-func (m *DposNomineeRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposNomineeRecordsResponse.Marshal(b, m, deterministic)
+func (m *AK2AccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AK2AccountResponse.Marshal(b, m, deterministic)
 }
-func (m *DposNomineeRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposNomineeRecordsResponse.Merge(m, src)
+func (m *AK2AccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AK2AccountResponse.Merge(m, src)
 }
-func (m *DposNomineeRecordsResponse) XXX_Size() int {
-	return xxx_messageInfo_DposNomineeRecordsResponse.Size(m)
+func (m *AK2AccountResponse) XXX_Size() int {
+	return xxx_messageInfo_AK2AccountResponse.Size(m)
 }
-func (m *DposNomineeRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposNomineeRecordsResponse.DiscardUnknown(m)
+func (m *AK2AccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AK2AccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposNomineeRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AK2AccountResponse proto.InternalMessageInfo
 
-func (m *DposNomineeRecordsResponse) GetHeader() *Header {
+func (m *AK2AccountResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposNomineeRecordsResponse) GetTxid() string {
+func (m *AK2AccountResponse) GetBcname() string {
 	if m != nil {
-		return m.Txid
+		return m.Bcname
 	}
 	return ""
 }
 
-// 选民投票记录请求
-type DposVoteRecordsRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AK2AccountResponse) GetAccount() []string {
+	if m != nil {
+		return m.Account
+	}
+	return nil
 }
 
-func (m *DposVoteRecordsRequest) Reset()         { *m = DposVoteRecordsRequest{} }
-func (m *DposVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*DposVoteRecordsRequest) ProtoMessage()    {}
-func (*DposVoteRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{46}
+type AccountChange struct {
+	Type                 AccountChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.AccountChangeType" json:"type,omitempty"`
+	Txid                 []byte            `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
+	Height               int64             `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp            int64             `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Initiator            string            `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          []string          `protobuf:"bytes,7,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	ContractName         string            `protobuf:"bytes,8,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	MethodName           string            `protobuf:"bytes,9,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	Acl                  *Acl              `protobuf:"bytes,10,opt,name=acl,proto3" json:"acl,omitempty"`
+	AddedAks             []string          `protobuf:"bytes,11,rep,name=added_aks,json=addedAks,proto3" json:"added_aks,omitempty"`
+	RemovedAks           []string          `protobuf:"bytes,12,rep,name=removed_aks,json=removedAks,proto3" json:"removed_aks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DposVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposVoteRecordsRequest.Unmarshal(m, b)
+func (m *AccountChange) Reset()         { *m = AccountChange{} }
+func (m *AccountChange) String() string { return proto.CompactTextString(m) }
+func (*AccountChange) ProtoMessage()    {}
+func (*AccountChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{106}
 }
-func (m *DposVoteRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposVoteRecordsRequest.Marshal(b, m, deterministic)
+
+func (m *AccountChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountChange.Unmarshal(m, b)
 }
-func (m *DposVoteRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposVoteRecordsRequest.Merge(m, src)
+func (m *AccountChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountChange.Marshal(b, m, deterministic)
 }
-func (m *DposVoteRecordsRequest) XXX_Size() int {
-	return xxx_messageInfo_DposVoteRecordsRequest.Size(m)
+func (m *AccountChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountChange.Merge(m, src)
 }
-func (m *DposVoteRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposVoteRecordsRequest.DiscardUnknown(m)
+func (m *AccountChange) XXX_Size() int {
+	return xxx_messageInfo_AccountChange.Size(m)
+}
+func (m *AccountChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposVoteRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountChange proto.InternalMessageInfo
 
-func (m *DposVoteRecordsRequest) GetHeader() *Header {
+func (m *AccountChange) GetType() AccountChangeType {
 	if m != nil {
-		return m.Header
+		return m.Type
 	}
-	return nil
+	return AccountChangeType_ACCOUNT_CHANGE_UNDEFINE
 }
 
-func (m *DposVoteRecordsRequest) GetBcname() string {
+func (m *AccountChange) GetTxid() []byte {
 	if m != nil {
-		return m.Bcname
+		return m.Txid
 	}
-	return ""
+	return nil
 }
 
-func (m *DposVoteRecordsRequest) GetAddress() string {
+func (m *AccountChange) GetStatus() TransactionStatus {
 	if m != nil {
-		return m.Address
+		return m.Status
 	}
-	return ""
+	return TransactionStatus_UNDEFINE
 }
 
-// 选民投票记录
-type VoteRecord struct {
-	Candidate            string   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
-	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AccountChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
-func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
-func (*VoteRecord) ProtoMessage()    {}
-func (*VoteRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{47}
+func (m *AccountChange) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VoteRecord.Unmarshal(m, b)
+func (m *AccountChange) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
 }
-func (m *VoteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VoteRecord.Marshal(b, m, deterministic)
+
+func (m *AccountChange) GetAuthRequire() []string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return nil
 }
-func (m *VoteRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoteRecord.Merge(m, src)
+
+func (m *AccountChange) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
 }
-func (m *VoteRecord) XXX_Size() int {
-	return xxx_messageInfo_VoteRecord.Size(m)
+
+func (m *AccountChange) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
 }
-func (m *VoteRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_VoteRecord.DiscardUnknown(m)
+
+func (m *AccountChange) GetAcl() *Acl {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
 }
 
-var xxx_messageInfo_VoteRecord proto.InternalMessageInfo
-
-func (m *VoteRecord) GetCandidate() string {
+func (m *AccountChange) GetAddedAks() []string {
 	if m != nil {
-		return m.Candidate
+		return m.AddedAks
 	}
-	return ""
+	return nil
 }
 
-func (m *VoteRecord) GetTxid() string {
+func (m *AccountChange) GetRemovedAks() []string {
 	if m != nil {
-		return m.Txid
+		return m.RemovedAks
 	}
-	return ""
+	return nil
 }
 
-// 选民投票记录返回
-type DposVoteRecordsResponse struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// 选民投票txid记录
-	VoteTxidRecords      []*VoteRecord `protobuf:"bytes,2,rep,name=voteTxidRecords,proto3" json:"voteTxidRecords,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type AccountHistoryRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposVoteRecordsResponse) Reset()         { *m = DposVoteRecordsResponse{} }
-func (m *DposVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*DposVoteRecordsResponse) ProtoMessage()    {}
-func (*DposVoteRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{48}
+func (m *AccountHistoryRequest) Reset()         { *m = AccountHistoryRequest{} }
+func (m *AccountHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryRequest) ProtoMessage()    {}
+func (*AccountHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{107}
 }
 
-func (m *DposVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposVoteRecordsResponse.Unmarshal(m, b)
+func (m *AccountHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryRequest.Unmarshal(m, b)
 }
-func (m *DposVoteRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposVoteRecordsResponse.Marshal(b, m, deterministic)
+func (m *AccountHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *DposVoteRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposVoteRecordsResponse.Merge(m, src)
+func (m *AccountHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryRequest.Merge(m, src)
 }
-func (m *DposVoteRecordsResponse) XXX_Size() int {
-	return xxx_messageInfo_DposVoteRecordsResponse.Size(m)
+func (m *AccountHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryRequest.Size(m)
 }
-func (m *DposVoteRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposVoteRecordsResponse.DiscardUnknown(m)
+func (m *AccountHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposVoteRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AccountHistoryRequest proto.InternalMessageInfo
 
-func (m *DposVoteRecordsResponse) GetHeader() *Header {
+func (m *AccountHistoryRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposVoteRecordsResponse) GetVoteTxidRecords() []*VoteRecord {
+func (m *AccountHistoryRequest) GetBcname() string {
 	if m != nil {
-		return m.VoteTxidRecords
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-// 候选人被投票记录请求
-type DposVotedRecordsRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AccountHistoryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *DposVotedRecordsRequest) Reset()         { *m = DposVotedRecordsRequest{} }
-func (m *DposVotedRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*DposVotedRecordsRequest) ProtoMessage()    {}
-func (*DposVotedRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{49}
+func (m *AccountHistoryRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *DposVotedRecordsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposVotedRecordsRequest.Unmarshal(m, b)
+func (m *AccountHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
-func (m *DposVotedRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposVotedRecordsRequest.Marshal(b, m, deterministic)
+
+type AccountHistoryResponse struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              string           `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Changes              []*AccountChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	HasMore              bool             `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Truncated            bool             `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *DposVotedRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposVotedRecordsRequest.Merge(m, src)
+
+func (m *AccountHistoryResponse) Reset()         { *m = AccountHistoryResponse{} }
+func (m *AccountHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryResponse) ProtoMessage()    {}
+func (*AccountHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{108}
 }
-func (m *DposVotedRecordsRequest) XXX_Size() int {
-	return xxx_messageInfo_DposVotedRecordsRequest.Size(m)
+
+func (m *AccountHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryResponse.Unmarshal(m, b)
 }
-func (m *DposVotedRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposVotedRecordsRequest.DiscardUnknown(m)
+func (m *AccountHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryResponse.Merge(m, src)
+}
+func (m *AccountHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryResponse.Size(m)
+}
+func (m *AccountHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposVotedRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountHistoryResponse proto.InternalMessageInfo
 
-func (m *DposVotedRecordsRequest) GetHeader() *Header {
+func (m *AccountHistoryResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposVotedRecordsRequest) GetBcname() string {
+func (m *AccountHistoryResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *DposVotedRecordsRequest) GetAddress() string {
+func (m *AccountHistoryResponse) GetAccount() string {
 	if m != nil {
-		return m.Address
+		return m.Account
 	}
 	return ""
 }
 
-// 候选人被投票记录
-type VotedRecord struct {
-	Voter                string   `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
-	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+func (m *AccountHistoryResponse) GetChanges() []*AccountChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *AccountHistoryResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *AccountHistoryResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Query account contracts request
+type GetAccountContractsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VotedRecord) Reset()         { *m = VotedRecord{} }
-func (m *VotedRecord) String() string { return proto.CompactTextString(m) }
-func (*VotedRecord) ProtoMessage()    {}
-func (*VotedRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{50}
+func (m *GetAccountContractsRequest) Reset()         { *m = GetAccountContractsRequest{} }
+func (m *GetAccountContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountContractsRequest) ProtoMessage()    {}
+func (*GetAccountContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{109}
 }
 
-func (m *VotedRecord) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VotedRecord.Unmarshal(m, b)
+func (m *GetAccountContractsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountContractsRequest.Unmarshal(m, b)
 }
-func (m *VotedRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VotedRecord.Marshal(b, m, deterministic)
+func (m *GetAccountContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountContractsRequest.Marshal(b, m, deterministic)
 }
-func (m *VotedRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotedRecord.Merge(m, src)
+func (m *GetAccountContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountContractsRequest.Merge(m, src)
 }
-func (m *VotedRecord) XXX_Size() int {
-	return xxx_messageInfo_VotedRecord.Size(m)
+func (m *GetAccountContractsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountContractsRequest.Size(m)
 }
-func (m *VotedRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotedRecord.DiscardUnknown(m)
+func (m *GetAccountContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountContractsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotedRecord proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountContractsRequest proto.InternalMessageInfo
 
-func (m *VotedRecord) GetVoter() string {
+func (m *GetAccountContractsRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Voter
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetAccountContractsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *VotedRecord) GetTxid() string {
+func (m *GetAccountContractsRequest) GetAccount() string {
 	if m != nil {
-		return m.Txid
+		return m.Account
 	}
 	return ""
 }
 
-// 候选人被投票记录返回
-type DposVotedRecordsResponse struct {
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// 候选人被投票的txid记录
-	VotedTxidRecords     []*VotedRecord `protobuf:"bytes,2,rep,name=votedTxidRecords,proto3" json:"votedTxidRecords,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// Query account contracts response
+type GetAccountContractsResponse struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ContractsStatus      []*ContractStatus `protobuf:"bytes,2,rep,name=contracts_status,json=contractsStatus,proto3" json:"contracts_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DposVotedRecordsResponse) Reset()         { *m = DposVotedRecordsResponse{} }
-func (m *DposVotedRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*DposVotedRecordsResponse) ProtoMessage()    {}
-func (*DposVotedRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{51}
+func (m *GetAccountContractsResponse) Reset()         { *m = GetAccountContractsResponse{} }
+func (m *GetAccountContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountContractsResponse) ProtoMessage()    {}
+func (*GetAccountContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{110}
 }
 
-func (m *DposVotedRecordsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposVotedRecordsResponse.Unmarshal(m, b)
+func (m *GetAccountContractsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountContractsResponse.Unmarshal(m, b)
 }
-func (m *DposVotedRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposVotedRecordsResponse.Marshal(b, m, deterministic)
+func (m *GetAccountContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountContractsResponse.Marshal(b, m, deterministic)
 }
-func (m *DposVotedRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposVotedRecordsResponse.Merge(m, src)
+func (m *GetAccountContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountContractsResponse.Merge(m, src)
 }
-func (m *DposVotedRecordsResponse) XXX_Size() int {
-	return xxx_messageInfo_DposVotedRecordsResponse.Size(m)
+func (m *GetAccountContractsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountContractsResponse.Size(m)
 }
-func (m *DposVotedRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposVotedRecordsResponse.DiscardUnknown(m)
+func (m *GetAccountContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountContractsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposVotedRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountContractsResponse proto.InternalMessageInfo
 
-func (m *DposVotedRecordsResponse) GetHeader() *Header {
+func (m *GetAccountContractsResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposVotedRecordsResponse) GetVotedTxidRecords() []*VotedRecord {
+func (m *GetAccountContractsResponse) GetContractsStatus() []*ContractStatus {
 	if m != nil {
-		return m.VotedTxidRecords
+		return m.ContractsStatus
 	}
 	return nil
 }
 
-// 查询检票结果记录请求
-type DposCheckResultsRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Term                 int64    `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+// Status of a contract
+type ContractStatus struct {
+	ContractName         string   `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Desc                 []byte   `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	IsBanned             bool     `protobuf:"varint,4,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
+	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Runtime              string   `protobuf:"bytes,6,opt,name=runtime,proto3" json:"runtime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DposCheckResultsRequest) Reset()         { *m = DposCheckResultsRequest{} }
-func (m *DposCheckResultsRequest) String() string { return proto.CompactTextString(m) }
-func (*DposCheckResultsRequest) ProtoMessage()    {}
-func (*DposCheckResultsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{52}
+func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
+func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
+func (*ContractStatus) ProtoMessage()    {}
+func (*ContractStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{111}
 }
 
-func (m *DposCheckResultsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposCheckResultsRequest.Unmarshal(m, b)
+func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractStatus.Unmarshal(m, b)
 }
-func (m *DposCheckResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposCheckResultsRequest.Marshal(b, m, deterministic)
+func (m *ContractStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractStatus.Marshal(b, m, deterministic)
 }
-func (m *DposCheckResultsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposCheckResultsRequest.Merge(m, src)
+func (m *ContractStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStatus.Merge(m, src)
 }
-func (m *DposCheckResultsRequest) XXX_Size() int {
-	return xxx_messageInfo_DposCheckResultsRequest.Size(m)
+func (m *ContractStatus) XXX_Size() int {
+	return xxx_messageInfo_ContractStatus.Size(m)
 }
-func (m *DposCheckResultsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposCheckResultsRequest.DiscardUnknown(m)
+func (m *ContractStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposCheckResultsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractStatus proto.InternalMessageInfo
 
-func (m *DposCheckResultsRequest) GetHeader() *Header {
+func (m *ContractStatus) GetContractName() string {
 	if m != nil {
-		return m.Header
+		return m.ContractName
 	}
-	return nil
+	return ""
 }
 
-func (m *DposCheckResultsRequest) GetBcname() string {
+func (m *ContractStatus) GetTxid() string {
 	if m != nil {
-		return m.Bcname
+		return m.Txid
 	}
 	return ""
 }
 
-func (m *DposCheckResultsRequest) GetTerm() int64 {
+func (m *ContractStatus) GetDesc() []byte {
 	if m != nil {
-		return m.Term
+		return m.Desc
 	}
-	return 0
-}
-
-// 查询检票结果记录返回
-type DposCheckResultsResponse struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Term                 int64    `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
-	CheckResult          []string `protobuf:"bytes,3,rep,name=checkResult,proto3" json:"checkResult,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DposCheckResultsResponse) Reset()         { *m = DposCheckResultsResponse{} }
-func (m *DposCheckResultsResponse) String() string { return proto.CompactTextString(m) }
-func (*DposCheckResultsResponse) ProtoMessage()    {}
-func (*DposCheckResultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{53}
-}
-
-func (m *DposCheckResultsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposCheckResultsResponse.Unmarshal(m, b)
-}
-func (m *DposCheckResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposCheckResultsResponse.Marshal(b, m, deterministic)
-}
-func (m *DposCheckResultsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposCheckResultsResponse.Merge(m, src)
-}
-func (m *DposCheckResultsResponse) XXX_Size() int {
-	return xxx_messageInfo_DposCheckResultsResponse.Size(m)
-}
-func (m *DposCheckResultsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposCheckResultsResponse.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_DposCheckResultsResponse proto.InternalMessageInfo
-
-func (m *DposCheckResultsResponse) GetHeader() *Header {
+func (m *ContractStatus) GetIsBanned() bool {
 	if m != nil {
-		return m.Header
+		return m.IsBanned
 	}
-	return nil
+	return false
 }
 
-func (m *DposCheckResultsResponse) GetTerm() int64 {
+func (m *ContractStatus) GetTimestamp() int64 {
 	if m != nil {
-		return m.Term
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *DposCheckResultsResponse) GetCheckResult() []string {
+func (m *ContractStatus) GetRuntime() string {
 	if m != nil {
-		return m.CheckResult
+		return m.Runtime
 	}
-	return nil
+	return ""
 }
 
-// query dpos consensus current status request
-type DposStatusRequest struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// PreExecWithSelectUTXORequest preExec + selectUtxo for request
+type PreExecWithSelectUTXORequest struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	TotalAmount          int64             `protobuf:"varint,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	SignInfo             *SignatureInfo    `protobuf:"bytes,6,opt,name=signInfo,proto3" json:"signInfo,omitempty"`
+	NeedLock             bool              `protobuf:"varint,7,opt,name=needLock,proto3" json:"needLock,omitempty"`
+	Request              *InvokeRPCRequest `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DposStatusRequest) Reset()         { *m = DposStatusRequest{} }
-func (m *DposStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*DposStatusRequest) ProtoMessage()    {}
-func (*DposStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{54}
+func (m *PreExecWithSelectUTXORequest) Reset()         { *m = PreExecWithSelectUTXORequest{} }
+func (m *PreExecWithSelectUTXORequest) String() string { return proto.CompactTextString(m) }
+func (*PreExecWithSelectUTXORequest) ProtoMessage()    {}
+func (*PreExecWithSelectUTXORequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{112}
 }
 
-func (m *DposStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposStatusRequest.Unmarshal(m, b)
+func (m *PreExecWithSelectUTXORequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreExecWithSelectUTXORequest.Unmarshal(m, b)
 }
-func (m *DposStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposStatusRequest.Marshal(b, m, deterministic)
+func (m *PreExecWithSelectUTXORequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreExecWithSelectUTXORequest.Marshal(b, m, deterministic)
 }
-func (m *DposStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposStatusRequest.Merge(m, src)
+func (m *PreExecWithSelectUTXORequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreExecWithSelectUTXORequest.Merge(m, src)
 }
-func (m *DposStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_DposStatusRequest.Size(m)
+func (m *PreExecWithSelectUTXORequest) XXX_Size() int {
+	return xxx_messageInfo_PreExecWithSelectUTXORequest.Size(m)
 }
-func (m *DposStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposStatusRequest.DiscardUnknown(m)
+func (m *PreExecWithSelectUTXORequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreExecWithSelectUTXORequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_PreExecWithSelectUTXORequest proto.InternalMessageInfo
 
-func (m *DposStatusRequest) GetHeader() *Header {
+func (m *PreExecWithSelectUTXORequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *DposStatusRequest) GetBcname() string {
+func (m *PreExecWithSelectUTXORequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-// query dpos consensus current status reply
-type DposStatusResponse struct {
-	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Status               *DposStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *PreExecWithSelectUTXORequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *DposStatusResponse) Reset()         { *m = DposStatusResponse{} }
-func (m *DposStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*DposStatusResponse) ProtoMessage()    {}
-func (*DposStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{55}
+func (m *PreExecWithSelectUTXORequest) GetTotalAmount() int64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
 }
 
-func (m *DposStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposStatusResponse.Unmarshal(m, b)
-}
-func (m *DposStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposStatusResponse.Marshal(b, m, deterministic)
-}
-func (m *DposStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposStatusResponse.Merge(m, src)
-}
-func (m *DposStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_DposStatusResponse.Size(m)
-}
-func (m *DposStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposStatusResponse.DiscardUnknown(m)
+func (m *PreExecWithSelectUTXORequest) GetSignInfo() *SignatureInfo {
+	if m != nil {
+		return m.SignInfo
+	}
+	return nil
 }
 
-var xxx_messageInfo_DposStatusResponse proto.InternalMessageInfo
-
-func (m *DposStatusResponse) GetHeader() *Header {
+func (m *PreExecWithSelectUTXORequest) GetNeedLock() bool {
 	if m != nil {
-		return m.Header
+		return m.NeedLock
 	}
-	return nil
+	return false
 }
 
-func (m *DposStatusResponse) GetStatus() *DposStatus {
+func (m *PreExecWithSelectUTXORequest) GetRequest() *InvokeRPCRequest {
 	if m != nil {
-		return m.Status
+		return m.Request
 	}
 	return nil
 }
 
-type DposStatus struct {
-	Term                 int64    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
-	BlockNum             int64    `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	Proposer             string   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	ProposerNum          int64    `protobuf:"varint,4,opt,name=proposer_num,json=proposerNum,proto3" json:"proposer_num,omitempty"`
-	CheckResult          []string `protobuf:"bytes,5,rep,name=checkResult,proto3" json:"checkResult,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// PreExecWithSelectUTXOResponse preExec + selectUtxo for response
+type PreExecWithSelectUTXOResponse struct {
+	Header   *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname   string          `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Response *InvokeResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// for preExec & selectUTXO
+	UtxoOutput           *UtxoOutput `protobuf:"bytes,4,opt,name=utxoOutput,proto3" json:"utxoOutput,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *DposStatus) Reset()         { *m = DposStatus{} }
-func (m *DposStatus) String() string { return proto.CompactTextString(m) }
-func (*DposStatus) ProtoMessage()    {}
-func (*DposStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{56}
+func (m *PreExecWithSelectUTXOResponse) Reset()         { *m = PreExecWithSelectUTXOResponse{} }
+func (m *PreExecWithSelectUTXOResponse) String() string { return proto.CompactTextString(m) }
+func (*PreExecWithSelectUTXOResponse) ProtoMessage()    {}
+func (*PreExecWithSelectUTXOResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{113}
 }
 
-func (m *DposStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DposStatus.Unmarshal(m, b)
+func (m *PreExecWithSelectUTXOResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Unmarshal(m, b)
 }
-func (m *DposStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DposStatus.Marshal(b, m, deterministic)
+func (m *PreExecWithSelectUTXOResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Marshal(b, m, deterministic)
 }
-func (m *DposStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DposStatus.Merge(m, src)
+func (m *PreExecWithSelectUTXOResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreExecWithSelectUTXOResponse.Merge(m, src)
 }
-func (m *DposStatus) XXX_Size() int {
-	return xxx_messageInfo_DposStatus.Size(m)
+func (m *PreExecWithSelectUTXOResponse) XXX_Size() int {
+	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Size(m)
 }
-func (m *DposStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_DposStatus.DiscardUnknown(m)
+func (m *PreExecWithSelectUTXOResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreExecWithSelectUTXOResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DposStatus proto.InternalMessageInfo
-
-func (m *DposStatus) GetTerm() int64 {
-	if m != nil {
-		return m.Term
-	}
-	return 0
-}
+var xxx_messageInfo_PreExecWithSelectUTXOResponse proto.InternalMessageInfo
 
-func (m *DposStatus) GetBlockNum() int64 {
+func (m *PreExecWithSelectUTXOResponse) GetHeader() *Header {
 	if m != nil {
-		return m.BlockNum
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *DposStatus) GetProposer() string {
+func (m *PreExecWithSelectUTXOResponse) GetBcname() string {
 	if m != nil {
-		return m.Proposer
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *DposStatus) GetProposerNum() int64 {
+func (m *PreExecWithSelectUTXOResponse) GetResponse() *InvokeResponse {
 	if m != nil {
-		return m.ProposerNum
+		return m.Response
 	}
-	return 0
+	return nil
 }
 
-func (m *DposStatus) GetCheckResult() []string {
+func (m *PreExecWithSelectUTXOResponse) GetUtxoOutput() *UtxoOutput {
 	if m != nil {
-		return m.CheckResult
+		return m.UtxoOutput
 	}
 	return nil
 }
 
-type InvokeRPCRequest struct {
-	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Requests             []*InvokeRequest `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests,omitempty"`
-	Initiator            string           `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	AuthRequire          []string         `protobuf:"bytes,5,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type SimulateTxRequest struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string       `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *InvokeRPCRequest) Reset()         { *m = InvokeRPCRequest{} }
-func (m *InvokeRPCRequest) String() string { return proto.CompactTextString(m) }
-func (*InvokeRPCRequest) ProtoMessage()    {}
-func (*InvokeRPCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{57}
+func (m *SimulateTxRequest) Reset()         { *m = SimulateTxRequest{} }
+func (m *SimulateTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateTxRequest) ProtoMessage()    {}
+func (*SimulateTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{114}
 }
 
-func (m *InvokeRPCRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvokeRPCRequest.Unmarshal(m, b)
+func (m *SimulateTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateTxRequest.Unmarshal(m, b)
 }
-func (m *InvokeRPCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvokeRPCRequest.Marshal(b, m, deterministic)
+func (m *SimulateTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateTxRequest.Marshal(b, m, deterministic)
 }
-func (m *InvokeRPCRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvokeRPCRequest.Merge(m, src)
+func (m *SimulateTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTxRequest.Merge(m, src)
 }
-func (m *InvokeRPCRequest) XXX_Size() int {
-	return xxx_messageInfo_InvokeRPCRequest.Size(m)
+func (m *SimulateTxRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateTxRequest.Size(m)
 }
-func (m *InvokeRPCRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvokeRPCRequest.DiscardUnknown(m)
+func (m *SimulateTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvokeRPCRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateTxRequest proto.InternalMessageInfo
 
-func (m *InvokeRPCRequest) GetHeader() *Header {
+func (m *SimulateTxRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *InvokeRPCRequest) GetBcname() string {
+func (m *SimulateTxRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *InvokeRPCRequest) GetRequests() []*InvokeRequest {
+func (m *SimulateTxRequest) GetTx() *Transaction {
 	if m != nil {
-		return m.Requests
+		return m.Tx
 	}
 	return nil
 }
 
-func (m *InvokeRPCRequest) GetInitiator() string {
+// BalanceChange 地址余额变化，金额均为十进制字符串
+type BalanceChange struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	NewBalance           string   `protobuf:"bytes,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Delta                string   `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{115}
+}
+
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceChange.Unmarshal(m, b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return xxx_messageInfo_BalanceChange.Size(m)
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetAddress() string {
 	if m != nil {
-		return m.Initiator
+		return m.Address
 	}
 	return ""
 }
 
-func (m *InvokeRPCRequest) GetAuthRequire() []string {
+func (m *BalanceChange) GetBalance() string {
 	if m != nil {
-		return m.AuthRequire
+		return m.Balance
 	}
-	return nil
+	return ""
 }
 
-type InvokeRPCResponse struct {
-	Header               *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string          `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Response             *InvokeResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *BalanceChange) GetNewBalance() string {
+	if m != nil {
+		return m.NewBalance
+	}
+	return ""
 }
 
-func (m *InvokeRPCResponse) Reset()         { *m = InvokeRPCResponse{} }
-func (m *InvokeRPCResponse) String() string { return proto.CompactTextString(m) }
-func (*InvokeRPCResponse) ProtoMessage()    {}
-func (*InvokeRPCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{58}
+func (m *BalanceChange) GetDelta() string {
+	if m != nil {
+		return m.Delta
+	}
+	return ""
 }
 
-func (m *InvokeRPCResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvokeRPCResponse.Unmarshal(m, b)
+// KeyChange 合约存储的写入，删除时new_value为空且deleted为true
+type KeyChange struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue             []byte   `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue             []byte   `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Deleted              bool     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *InvokeRPCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvokeRPCResponse.Marshal(b, m, deterministic)
+
+func (m *KeyChange) Reset()         { *m = KeyChange{} }
+func (m *KeyChange) String() string { return proto.CompactTextString(m) }
+func (*KeyChange) ProtoMessage()    {}
+func (*KeyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{116}
 }
-func (m *InvokeRPCResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvokeRPCResponse.Merge(m, src)
+
+func (m *KeyChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyChange.Unmarshal(m, b)
 }
-func (m *InvokeRPCResponse) XXX_Size() int {
-	return xxx_messageInfo_InvokeRPCResponse.Size(m)
+func (m *KeyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyChange.Marshal(b, m, deterministic)
 }
-func (m *InvokeRPCResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvokeRPCResponse.DiscardUnknown(m)
+func (m *KeyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyChange.Merge(m, src)
+}
+func (m *KeyChange) XXX_Size() int {
+	return xxx_messageInfo_KeyChange.Size(m)
+}
+func (m *KeyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvokeRPCResponse proto.InternalMessageInfo
+var xxx_messageInfo_KeyChange proto.InternalMessageInfo
 
-func (m *InvokeRPCResponse) GetHeader() *Header {
+func (m *KeyChange) GetBucket() string {
 	if m != nil {
-		return m.Header
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *KeyChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
 	return nil
 }
 
-func (m *InvokeRPCResponse) GetBcname() string {
+func (m *KeyChange) GetOldValue() []byte {
 	if m != nil {
-		return m.Bcname
+		return m.OldValue
 	}
-	return ""
+	return nil
 }
 
-func (m *InvokeRPCResponse) GetResponse() *InvokeResponse {
+func (m *KeyChange) GetNewValue() []byte {
 	if m != nil {
-		return m.Response
+		return m.NewValue
 	}
 	return nil
 }
 
-// 预执行的请求结构
-type InvokeRequest struct {
-	ModuleName     string            `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	ContractName   string            `protobuf:"bytes,2,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
-	MethodName     string            `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
-	Args           map[string][]byte `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ResourceLimits []*ResourceLimit  `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	// amount is the amount transfer to the contract
-	// attention: In one transaction, transfer to only one contract is allowed
-	Amount               string   `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *KeyChange) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
 }
 
-func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
-func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
-func (*InvokeRequest) ProtoMessage()    {}
-func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{59}
+type SimulateTxResponse struct {
+	Header               *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string              `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	BalanceChanges       []*BalanceChange    `protobuf:"bytes,3,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+	KeyChanges           []*KeyChange        `protobuf:"bytes,4,rep,name=key_changes,json=keyChanges,proto3" json:"key_changes,omitempty"`
+	Events               []*ContractEvent    `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	Responses            []*ContractResponse `protobuf:"bytes,6,rep,name=responses,proto3" json:"responses,omitempty"`
+	GasUsed              int64               `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Fee                  string              `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvokeRequest.Unmarshal(m, b)
+func (m *SimulateTxResponse) Reset()         { *m = SimulateTxResponse{} }
+func (m *SimulateTxResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateTxResponse) ProtoMessage()    {}
+func (*SimulateTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{117}
 }
-func (m *InvokeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvokeRequest.Marshal(b, m, deterministic)
+
+func (m *SimulateTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateTxResponse.Unmarshal(m, b)
 }
-func (m *InvokeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvokeRequest.Merge(m, src)
+func (m *SimulateTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateTxResponse.Marshal(b, m, deterministic)
 }
-func (m *InvokeRequest) XXX_Size() int {
-	return xxx_messageInfo_InvokeRequest.Size(m)
+func (m *SimulateTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTxResponse.Merge(m, src)
 }
-func (m *InvokeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvokeRequest.DiscardUnknown(m)
+func (m *SimulateTxResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateTxResponse.Size(m)
+}
+func (m *SimulateTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvokeRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateTxResponse proto.InternalMessageInfo
 
-func (m *InvokeRequest) GetModuleName() string {
+func (m *SimulateTxResponse) GetHeader() *Header {
 	if m != nil {
-		return m.ModuleName
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *InvokeRequest) GetContractName() string {
+func (m *SimulateTxResponse) GetBcname() string {
 	if m != nil {
-		return m.ContractName
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *InvokeRequest) GetMethodName() string {
+func (m *SimulateTxResponse) GetBalanceChanges() []*BalanceChange {
 	if m != nil {
-		return m.MethodName
+		return m.BalanceChanges
 	}
-	return ""
+	return nil
 }
 
-func (m *InvokeRequest) GetArgs() map[string][]byte {
+func (m *SimulateTxResponse) GetKeyChanges() []*KeyChange {
 	if m != nil {
-		return m.Args
+		return m.KeyChanges
 	}
 	return nil
 }
 
-func (m *InvokeRequest) GetResourceLimits() []*ResourceLimit {
+func (m *SimulateTxResponse) GetEvents() []*ContractEvent {
 	if m != nil {
-		return m.ResourceLimits
+		return m.Events
 	}
 	return nil
 }
 
-func (m *InvokeRequest) GetAmount() string {
+func (m *SimulateTxResponse) GetResponses() []*ContractResponse {
 	if m != nil {
-		return m.Amount
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *SimulateTxResponse) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SimulateTxResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
 	return ""
 }
 
-// 预执行的返回结构
-type InvokeResponse struct {
-	Inputs               []*TxInputExt       `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Outputs              []*TxOutputExt      `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	Response             [][]byte            `protobuf:"bytes,3,rep,name=response,proto3" json:"response,omitempty"`
-	GasUsed              int64               `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
-	Requests             []*InvokeRequest    `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
-	Responses            []*ContractResponse `protobuf:"bytes,6,rep,name=responses,proto3" json:"responses,omitempty"`
-	UtxoInputs           []*TxInput          `protobuf:"bytes,7,rep,name=utxoInputs,proto3" json:"utxoInputs,omitempty"`
-	UtxoOutputs          []*TxOutput         `protobuf:"bytes,8,rep,name=utxoOutputs,proto3" json:"utxoOutputs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type EstimateFeeResponse struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	GasPrice             *GasPrice        `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	ResourceUsed         []*ResourceLimit `protobuf:"bytes,4,rep,name=resource_used,json=resourceUsed,proto3" json:"resource_used,omitempty"`
+	CpuFee               int64            `protobuf:"varint,5,opt,name=cpu_fee,json=cpuFee,proto3" json:"cpu_fee,omitempty"`
+	MemFee               int64            `protobuf:"varint,6,opt,name=mem_fee,json=memFee,proto3" json:"mem_fee,omitempty"`
+	DiskFee              int64            `protobuf:"varint,7,opt,name=disk_fee,json=diskFee,proto3" json:"disk_fee,omitempty"`
+	XfeeFee              int64            `protobuf:"varint,8,opt,name=xfee_fee,json=xfeeFee,proto3" json:"xfee_fee,omitempty"`
+	TotalFee             int64            `protobuf:"varint,9,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	SafetyMargin         int64            `protobuf:"varint,10,opt,name=safety_margin,json=safetyMargin,proto3" json:"safety_margin,omitempty"`
+	SuggestedFee         int64            `protobuf:"varint,11,opt,name=suggested_fee,json=suggestedFee,proto3" json:"suggested_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
-func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
-func (*InvokeResponse) ProtoMessage()    {}
-func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{60}
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{118}
 }
 
-func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvokeResponse.Unmarshal(m, b)
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
 }
-func (m *InvokeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvokeResponse.Marshal(b, m, deterministic)
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
 }
-func (m *InvokeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvokeResponse.Merge(m, src)
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
 }
-func (m *InvokeResponse) XXX_Size() int {
-	return xxx_messageInfo_InvokeResponse.Size(m)
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateFeeResponse.Size(m)
 }
-func (m *InvokeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvokeResponse.DiscardUnknown(m)
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvokeResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
 
-func (m *InvokeResponse) GetInputs() []*TxInputExt {
+func (m *EstimateFeeResponse) GetHeader() *Header {
 	if m != nil {
-		return m.Inputs
+		return m.Header
 	}
 	return nil
 }
 
-func (m *InvokeResponse) GetOutputs() []*TxOutputExt {
+func (m *EstimateFeeResponse) GetBcname() string {
 	if m != nil {
-		return m.Outputs
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *EstimateFeeResponse) GetGasPrice() *GasPrice {
+	if m != nil {
+		return m.GasPrice
 	}
 	return nil
 }
 
-func (m *InvokeResponse) GetResponse() [][]byte {
+func (m *EstimateFeeResponse) GetResourceUsed() []*ResourceLimit {
 	if m != nil {
-		return m.Response
+		return m.ResourceUsed
 	}
 	return nil
 }
 
-func (m *InvokeResponse) GetGasUsed() int64 {
+func (m *EstimateFeeResponse) GetCpuFee() int64 {
 	if m != nil {
-		return m.GasUsed
+		return m.CpuFee
 	}
 	return 0
 }
 
-func (m *InvokeResponse) GetRequests() []*InvokeRequest {
+func (m *EstimateFeeResponse) GetMemFee() int64 {
 	if m != nil {
-		return m.Requests
+		return m.MemFee
 	}
-	return nil
+	return 0
 }
 
-func (m *InvokeResponse) GetResponses() []*ContractResponse {
+func (m *EstimateFeeResponse) GetDiskFee() int64 {
 	if m != nil {
-		return m.Responses
+		return m.DiskFee
 	}
-	return nil
+	return 0
 }
 
-func (m *InvokeResponse) GetUtxoInputs() []*TxInput {
+func (m *EstimateFeeResponse) GetXfeeFee() int64 {
 	if m != nil {
-		return m.UtxoInputs
+		return m.XfeeFee
 	}
-	return nil
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetTotalFee() int64 {
+	if m != nil {
+		return m.TotalFee
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetSafetyMargin() int64 {
+	if m != nil {
+		return m.SafetyMargin
+	}
+	return 0
 }
 
-func (m *InvokeResponse) GetUtxoOutputs() []*TxOutput {
+func (m *EstimateFeeResponse) GetSuggestedFee() int64 {
 	if m != nil {
-		return m.UtxoOutputs
+		return m.SuggestedFee
 	}
-	return nil
+	return 0
 }
 
-// 扩展输入
-type TxInputExt struct {
-	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RefTxid              []byte   `protobuf:"bytes,3,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
-	RefOffset            int32    `protobuf:"varint,4,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
+// ContractResponse is the response returnd by contract
+type ContractResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Body                 []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxInputExt) Reset()         { *m = TxInputExt{} }
-func (m *TxInputExt) String() string { return proto.CompactTextString(m) }
-func (*TxInputExt) ProtoMessage()    {}
-func (*TxInputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{61}
+func (m *ContractResponse) Reset()         { *m = ContractResponse{} }
+func (m *ContractResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractResponse) ProtoMessage()    {}
+func (*ContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{119}
 }
 
-func (m *TxInputExt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxInputExt.Unmarshal(m, b)
+func (m *ContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractResponse.Unmarshal(m, b)
 }
-func (m *TxInputExt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxInputExt.Marshal(b, m, deterministic)
+func (m *ContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractResponse.Marshal(b, m, deterministic)
 }
-func (m *TxInputExt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxInputExt.Merge(m, src)
+func (m *ContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractResponse.Merge(m, src)
 }
-func (m *TxInputExt) XXX_Size() int {
-	return xxx_messageInfo_TxInputExt.Size(m)
+func (m *ContractResponse) XXX_Size() int {
+	return xxx_messageInfo_ContractResponse.Size(m)
 }
-func (m *TxInputExt) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxInputExt.DiscardUnknown(m)
+func (m *ContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxInputExt proto.InternalMessageInfo
+var xxx_messageInfo_ContractResponse proto.InternalMessageInfo
 
-func (m *TxInputExt) GetBucket() string {
+func (m *ContractResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Bucket
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *TxInputExt) GetKey() []byte {
+func (m *ContractResponse) GetMessage() string {
 	if m != nil {
-		return m.Key
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-func (m *TxInputExt) GetRefTxid() []byte {
+func (m *ContractResponse) GetBody() []byte {
 	if m != nil {
-		return m.RefTxid
+		return m.Body
 	}
 	return nil
 }
 
-func (m *TxInputExt) GetRefOffset() int32 {
-	if m != nil {
-		return m.RefOffset
-	}
-	return 0
-}
-
-// 扩展输出
-type TxOutputExt struct {
-	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+type ModifyBlock struct {
+	// txid交易被effective_txid的交易提出可修改区块链的请求
+	EffectiveTxid string `protobuf:"bytes,1,opt,name=effective_txid,json=effectiveTxid,proto3" json:"effective_txid,omitempty"`
+	// 本交易是否已被修改标记
+	Marked bool `protobuf:"varint,2,opt,name=marked,proto3" json:"marked,omitempty"`
+	// txid交易被修改生效的高度
+	EffectiveHeight int64 `protobuf:"varint,3,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// 监管的public key
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// 监管地址对修改的交易id的签名
+	Sign                 string   `protobuf:"bytes,5,opt,name=sign,proto3" json:"sign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxOutputExt) Reset()         { *m = TxOutputExt{} }
-func (m *TxOutputExt) String() string { return proto.CompactTextString(m) }
-func (*TxOutputExt) ProtoMessage()    {}
-func (*TxOutputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{62}
+func (m *ModifyBlock) Reset()         { *m = ModifyBlock{} }
+func (m *ModifyBlock) String() string { return proto.CompactTextString(m) }
+func (*ModifyBlock) ProtoMessage()    {}
+func (*ModifyBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{120}
 }
 
-func (m *TxOutputExt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxOutputExt.Unmarshal(m, b)
+func (m *ModifyBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyBlock.Unmarshal(m, b)
 }
-func (m *TxOutputExt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxOutputExt.Marshal(b, m, deterministic)
+func (m *ModifyBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyBlock.Marshal(b, m, deterministic)
 }
-func (m *TxOutputExt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxOutputExt.Merge(m, src)
+func (m *ModifyBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyBlock.Merge(m, src)
 }
-func (m *TxOutputExt) XXX_Size() int {
-	return xxx_messageInfo_TxOutputExt.Size(m)
+func (m *ModifyBlock) XXX_Size() int {
+	return xxx_messageInfo_ModifyBlock.Size(m)
 }
-func (m *TxOutputExt) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxOutputExt.DiscardUnknown(m)
+func (m *ModifyBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxOutputExt proto.InternalMessageInfo
+var xxx_messageInfo_ModifyBlock proto.InternalMessageInfo
 
-func (m *TxOutputExt) GetBucket() string {
+func (m *ModifyBlock) GetEffectiveTxid() string {
 	if m != nil {
-		return m.Bucket
+		return m.EffectiveTxid
 	}
 	return ""
 }
 
-func (m *TxOutputExt) GetKey() []byte {
+func (m *ModifyBlock) GetMarked() bool {
 	if m != nil {
-		return m.Key
+		return m.Marked
 	}
-	return nil
+	return false
 }
 
-func (m *TxOutputExt) GetValue() []byte {
+func (m *ModifyBlock) GetEffectiveHeight() int64 {
 	if m != nil {
-		return m.Value
+		return m.EffectiveHeight
 	}
-	return nil
+	return 0
 }
 
-// 签名详情
-type SignatureInfo struct {
-	PublicKey            string   `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
-	Sign                 []byte   `protobuf:"bytes,2,opt,name=Sign,proto3" json:"Sign,omitempty"`
+func (m *ModifyBlock) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ModifyBlock) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+type HDInfo struct {
+	// HDPublickey
+	HdPublicKey []byte `protobuf:"bytes,1,opt,name=hd_public_key,json=hdPublicKey,proto3" json:"hd_public_key,omitempty"`
+	// original_hash
+	OriginalHash         []byte   `protobuf:"bytes,2,opt,name=original_hash,json=originalHash,proto3" json:"original_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
-func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
-func (*SignatureInfo) ProtoMessage()    {}
-func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{63}
+func (m *HDInfo) Reset()         { *m = HDInfo{} }
+func (m *HDInfo) String() string { return proto.CompactTextString(m) }
+func (*HDInfo) ProtoMessage()    {}
+func (*HDInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{121}
 }
 
-func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignatureInfo.Unmarshal(m, b)
+func (m *HDInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDInfo.Unmarshal(m, b)
 }
-func (m *SignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignatureInfo.Marshal(b, m, deterministic)
+func (m *HDInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDInfo.Marshal(b, m, deterministic)
 }
-func (m *SignatureInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignatureInfo.Merge(m, src)
+func (m *HDInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDInfo.Merge(m, src)
 }
-func (m *SignatureInfo) XXX_Size() int {
-	return xxx_messageInfo_SignatureInfo.Size(m)
+func (m *HDInfo) XXX_Size() int {
+	return xxx_messageInfo_HDInfo.Size(m)
 }
-func (m *SignatureInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignatureInfo.DiscardUnknown(m)
+func (m *HDInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignatureInfo proto.InternalMessageInfo
+var xxx_messageInfo_HDInfo proto.InternalMessageInfo
 
-func (m *SignatureInfo) GetPublicKey() string {
+func (m *HDInfo) GetHdPublicKey() []byte {
 	if m != nil {
-		return m.PublicKey
+		return m.HdPublicKey
 	}
-	return ""
+	return nil
 }
 
-func (m *SignatureInfo) GetSign() []byte {
+func (m *HDInfo) GetOriginalHash() []byte {
 	if m != nil {
-		return m.Sign
+		return m.OriginalHash
 	}
 	return nil
 }
 
-type PermissionModel struct {
-	Rule                 PermissionRule `protobuf:"varint,1,opt,name=rule,proto3,enum=pb.PermissionRule" json:"rule,omitempty"`
-	AcceptValue          float64        `protobuf:"fixed64,2,opt,name=acceptValue,proto3" json:"acceptValue,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type UtxoRecordDetail struct {
+	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string      `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	AccountName          string      `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	OpenUtxoRecord       *UtxoRecord `protobuf:"bytes,4,opt,name=openUtxoRecord,proto3" json:"openUtxoRecord,omitempty"`
+	LockedUtxoRecord     *UtxoRecord `protobuf:"bytes,5,opt,name=lockedUtxoRecord,proto3" json:"lockedUtxoRecord,omitempty"`
+	FrozenUtxoRecord     *UtxoRecord `protobuf:"bytes,6,opt,name=frozenUtxoRecord,proto3" json:"frozenUtxoRecord,omitempty"`
+	DisplayCount         int64       `protobuf:"varint,7,opt,name=displayCount,proto3" json:"displayCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *PermissionModel) Reset()         { *m = PermissionModel{} }
-func (m *PermissionModel) String() string { return proto.CompactTextString(m) }
-func (*PermissionModel) ProtoMessage()    {}
-func (*PermissionModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{64}
+func (m *UtxoRecordDetail) Reset()         { *m = UtxoRecordDetail{} }
+func (m *UtxoRecordDetail) String() string { return proto.CompactTextString(m) }
+func (*UtxoRecordDetail) ProtoMessage()    {}
+func (*UtxoRecordDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{122}
 }
 
-func (m *PermissionModel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PermissionModel.Unmarshal(m, b)
+func (m *UtxoRecordDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoRecordDetail.Unmarshal(m, b)
 }
-func (m *PermissionModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PermissionModel.Marshal(b, m, deterministic)
+func (m *UtxoRecordDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoRecordDetail.Marshal(b, m, deterministic)
 }
-func (m *PermissionModel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermissionModel.Merge(m, src)
+func (m *UtxoRecordDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoRecordDetail.Merge(m, src)
 }
-func (m *PermissionModel) XXX_Size() int {
-	return xxx_messageInfo_PermissionModel.Size(m)
+func (m *UtxoRecordDetail) XXX_Size() int {
+	return xxx_messageInfo_UtxoRecordDetail.Size(m)
 }
-func (m *PermissionModel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PermissionModel.DiscardUnknown(m)
+func (m *UtxoRecordDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoRecordDetail.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PermissionModel proto.InternalMessageInfo
+var xxx_messageInfo_UtxoRecordDetail proto.InternalMessageInfo
 
-func (m *PermissionModel) GetRule() PermissionRule {
+func (m *UtxoRecordDetail) GetHeader() *Header {
 	if m != nil {
-		return m.Rule
+		return m.Header
 	}
-	return PermissionRule_NULL
+	return nil
+}
+
+func (m *UtxoRecordDetail) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
 }
 
-func (m *PermissionModel) GetAcceptValue() float64 {
+func (m *UtxoRecordDetail) GetAccountName() string {
 	if m != nil {
-		return m.AcceptValue
+		return m.AccountName
 	}
-	return 0
+	return ""
 }
 
-// AK集的表示方法
-type AkSet struct {
-	Aks                  []string `protobuf:"bytes,1,rep,name=aks,proto3" json:"aks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UtxoRecordDetail) GetOpenUtxoRecord() *UtxoRecord {
+	if m != nil {
+		return m.OpenUtxoRecord
+	}
+	return nil
 }
 
-func (m *AkSet) Reset()         { *m = AkSet{} }
-func (m *AkSet) String() string { return proto.CompactTextString(m) }
-func (*AkSet) ProtoMessage()    {}
-func (*AkSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{65}
+func (m *UtxoRecordDetail) GetLockedUtxoRecord() *UtxoRecord {
+	if m != nil {
+		return m.LockedUtxoRecord
+	}
+	return nil
 }
 
-func (m *AkSet) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AkSet.Unmarshal(m, b)
-}
-func (m *AkSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AkSet.Marshal(b, m, deterministic)
-}
-func (m *AkSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AkSet.Merge(m, src)
-}
-func (m *AkSet) XXX_Size() int {
-	return xxx_messageInfo_AkSet.Size(m)
-}
-func (m *AkSet) XXX_DiscardUnknown() {
-	xxx_messageInfo_AkSet.DiscardUnknown(m)
+func (m *UtxoRecordDetail) GetFrozenUtxoRecord() *UtxoRecord {
+	if m != nil {
+		return m.FrozenUtxoRecord
+	}
+	return nil
 }
 
-var xxx_messageInfo_AkSet proto.InternalMessageInfo
-
-func (m *AkSet) GetAks() []string {
+func (m *UtxoRecordDetail) GetDisplayCount() int64 {
 	if m != nil {
-		return m.Aks
+		return m.DisplayCount
 	}
-	return nil
+	return 0
 }
 
-type AkSets struct {
-	Sets                 map[string]*AkSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Expression           string            `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type UtxoRecord struct {
+	UtxoCount            string     `protobuf:"bytes,1,opt,name=utxoCount,proto3" json:"utxoCount,omitempty"`
+	UtxoAmount           string     `protobuf:"bytes,2,opt,name=utxoAmount,proto3" json:"utxoAmount,omitempty"`
+	Item                 []*UtxoKey `protobuf:"bytes,3,rep,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *AkSets) Reset()         { *m = AkSets{} }
-func (m *AkSets) String() string { return proto.CompactTextString(m) }
-func (*AkSets) ProtoMessage()    {}
-func (*AkSets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{66}
+func (m *UtxoRecord) Reset()         { *m = UtxoRecord{} }
+func (m *UtxoRecord) String() string { return proto.CompactTextString(m) }
+func (*UtxoRecord) ProtoMessage()    {}
+func (*UtxoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{123}
 }
 
-func (m *AkSets) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AkSets.Unmarshal(m, b)
+func (m *UtxoRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoRecord.Unmarshal(m, b)
 }
-func (m *AkSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AkSets.Marshal(b, m, deterministic)
+func (m *UtxoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoRecord.Marshal(b, m, deterministic)
 }
-func (m *AkSets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AkSets.Merge(m, src)
+func (m *UtxoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoRecord.Merge(m, src)
 }
-func (m *AkSets) XXX_Size() int {
-	return xxx_messageInfo_AkSets.Size(m)
+func (m *UtxoRecord) XXX_Size() int {
+	return xxx_messageInfo_UtxoRecord.Size(m)
 }
-func (m *AkSets) XXX_DiscardUnknown() {
-	xxx_messageInfo_AkSets.DiscardUnknown(m)
+func (m *UtxoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AkSets proto.InternalMessageInfo
+var xxx_messageInfo_UtxoRecord proto.InternalMessageInfo
 
-func (m *AkSets) GetSets() map[string]*AkSet {
+func (m *UtxoRecord) GetUtxoCount() string {
 	if m != nil {
-		return m.Sets
+		return m.UtxoCount
 	}
-	return nil
+	return ""
 }
 
-func (m *AkSets) GetExpression() string {
+func (m *UtxoRecord) GetUtxoAmount() string {
 	if m != nil {
-		return m.Expression
+		return m.UtxoAmount
 	}
 	return ""
 }
 
-// Acl实际使用的结构
-type Acl struct {
-	Pm                   *PermissionModel   `protobuf:"bytes,1,opt,name=pm,proto3" json:"pm,omitempty"`
-	AksWeight            map[string]float64 `protobuf:"bytes,2,rep,name=aksWeight,proto3" json:"aksWeight,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	AkSets               *AkSets            `protobuf:"bytes,3,opt,name=akSets,proto3" json:"akSets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *UtxoRecord) GetItem() []*UtxoKey {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *Acl) Reset()         { *m = Acl{} }
-func (m *Acl) String() string { return proto.CompactTextString(m) }
-func (*Acl) ProtoMessage()    {}
-func (*Acl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{67}
+type UtxoKey struct {
+	RefTxid              string   `protobuf:"bytes,1,opt,name=refTxid,proto3" json:"refTxid,omitempty"`
+	Offset               string   `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Acl) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Acl.Unmarshal(m, b)
+func (m *UtxoKey) Reset()         { *m = UtxoKey{} }
+func (m *UtxoKey) String() string { return proto.CompactTextString(m) }
+func (*UtxoKey) ProtoMessage()    {}
+func (*UtxoKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{124}
 }
-func (m *Acl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Acl.Marshal(b, m, deterministic)
+
+func (m *UtxoKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoKey.Unmarshal(m, b)
 }
-func (m *Acl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Acl.Merge(m, src)
+func (m *UtxoKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoKey.Marshal(b, m, deterministic)
 }
-func (m *Acl) XXX_Size() int {
-	return xxx_messageInfo_Acl.Size(m)
+func (m *UtxoKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoKey.Merge(m, src)
 }
-func (m *Acl) XXX_DiscardUnknown() {
-	xxx_messageInfo_Acl.DiscardUnknown(m)
+func (m *UtxoKey) XXX_Size() int {
+	return xxx_messageInfo_UtxoKey.Size(m)
+}
+func (m *UtxoKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Acl proto.InternalMessageInfo
+var xxx_messageInfo_UtxoKey proto.InternalMessageInfo
 
-func (m *Acl) GetPm() *PermissionModel {
+func (m *UtxoKey) GetRefTxid() string {
 	if m != nil {
-		return m.Pm
+		return m.RefTxid
 	}
-	return nil
+	return ""
 }
 
-func (m *Acl) GetAksWeight() map[string]float64 {
+func (m *UtxoKey) GetOffset() string {
 	if m != nil {
-		return m.AksWeight
+		return m.Offset
 	}
-	return nil
+	return ""
 }
 
-func (m *Acl) GetAkSets() *AkSets {
+func (m *UtxoKey) GetAmount() string {
 	if m != nil {
-		return m.AkSets
+		return m.Amount
 	}
-	return nil
+	return ""
 }
 
-// 查询Acl
-type AclStatus struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	AccountName          string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ContractName         string   `protobuf:"bytes,4,opt,name=contractName,proto3" json:"contractName,omitempty"`
-	MethodName           string   `protobuf:"bytes,5,opt,name=methodName,proto3" json:"methodName,omitempty"`
-	Confirmed            bool     `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-	Acl                  *Acl     `protobuf:"bytes,7,opt,name=acl,proto3" json:"acl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PendingTx struct {
+	Txid                 []byte         `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Initiator            string         `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Contracts            []string       `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	ReceivedTimestamp    int64          `protobuf:"varint,4,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
+	Age                  int64          `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	State                PendingTxState `protobuf:"varint,6,opt,name=state,proto3,enum=pb.PendingTxState" json:"state,omitempty"`
+	Parents              [][]byte       `protobuf:"bytes,7,rep,name=parents,proto3" json:"parents,omitempty"`
+	Tx                   *Transaction   `protobuf:"bytes,8,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *AclStatus) Reset()         { *m = AclStatus{} }
-func (m *AclStatus) String() string { return proto.CompactTextString(m) }
-func (*AclStatus) ProtoMessage()    {}
-func (*AclStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{68}
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{125}
 }
 
-func (m *AclStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AclStatus.Unmarshal(m, b)
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTx.Unmarshal(m, b)
 }
-func (m *AclStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AclStatus.Marshal(b, m, deterministic)
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
 }
-func (m *AclStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AclStatus.Merge(m, src)
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
 }
-func (m *AclStatus) XXX_Size() int {
-	return xxx_messageInfo_AclStatus.Size(m)
+func (m *PendingTx) XXX_Size() int {
+	return xxx_messageInfo_PendingTx.Size(m)
 }
-func (m *AclStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_AclStatus.DiscardUnknown(m)
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AclStatus proto.InternalMessageInfo
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
 
-func (m *AclStatus) GetHeader() *Header {
+func (m *PendingTx) GetTxid() []byte {
 	if m != nil {
-		return m.Header
+		return m.Txid
 	}
 	return nil
 }
 
-func (m *AclStatus) GetBcname() string {
+func (m *PendingTx) GetInitiator() string {
 	if m != nil {
-		return m.Bcname
+		return m.Initiator
 	}
 	return ""
 }
 
-func (m *AclStatus) GetAccountName() string {
+func (m *PendingTx) GetContracts() []string {
 	if m != nil {
-		return m.AccountName
+		return m.Contracts
 	}
-	return ""
+	return nil
 }
 
-func (m *AclStatus) GetContractName() string {
+func (m *PendingTx) GetReceivedTimestamp() int64 {
 	if m != nil {
-		return m.ContractName
+		return m.ReceivedTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *AclStatus) GetMethodName() string {
+func (m *PendingTx) GetAge() int64 {
 	if m != nil {
-		return m.MethodName
+		return m.Age
 	}
-	return ""
+	return 0
 }
 
-func (m *AclStatus) GetConfirmed() bool {
+func (m *PendingTx) GetState() PendingTxState {
 	if m != nil {
-		return m.Confirmed
+		return m.State
 	}
-	return false
+	return PendingTxState_PENDING_UNDEFINE
 }
 
-func (m *AclStatus) GetAcl() *Acl {
+func (m *PendingTx) GetParents() [][]byte {
 	if m != nil {
-		return m.Acl
+		return m.Parents
 	}
 	return nil
 }
 
-// Identity authentication request
-type IdentityAuth struct {
-	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
-	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	PeerID               string   `protobuf:"bytes,4,opt,name=peerID,proto3" json:"peerID,omitempty"`
-	Timestamp            string   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (m *PendingTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type PendingTxsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Initiator            string   `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Contract             string   `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	Offset               int64    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	NeedContent          bool     `protobuf:"varint,7,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IdentityAuth) Reset()         { *m = IdentityAuth{} }
-func (m *IdentityAuth) String() string { return proto.CompactTextString(m) }
-func (*IdentityAuth) ProtoMessage()    {}
-func (*IdentityAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{69}
+func (m *PendingTxsRequest) Reset()         { *m = PendingTxsRequest{} }
+func (m *PendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsRequest) ProtoMessage()    {}
+func (*PendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{126}
 }
 
-func (m *IdentityAuth) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IdentityAuth.Unmarshal(m, b)
+func (m *PendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxsRequest.Unmarshal(m, b)
 }
-func (m *IdentityAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IdentityAuth.Marshal(b, m, deterministic)
+func (m *PendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxsRequest.Marshal(b, m, deterministic)
 }
-func (m *IdentityAuth) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IdentityAuth.Merge(m, src)
+func (m *PendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsRequest.Merge(m, src)
 }
-func (m *IdentityAuth) XXX_Size() int {
-	return xxx_messageInfo_IdentityAuth.Size(m)
+func (m *PendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingTxsRequest.Size(m)
 }
-func (m *IdentityAuth) XXX_DiscardUnknown() {
-	xxx_messageInfo_IdentityAuth.DiscardUnknown(m)
+func (m *PendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IdentityAuth proto.InternalMessageInfo
+var xxx_messageInfo_PendingTxsRequest proto.InternalMessageInfo
 
-func (m *IdentityAuth) GetSign() []byte {
+func (m *PendingTxsRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Sign
+		return m.Header
 	}
 	return nil
 }
 
-func (m *IdentityAuth) GetPubkey() []byte {
+func (m *PendingTxsRequest) GetBcname() string {
 	if m != nil {
-		return m.Pubkey
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-func (m *IdentityAuth) GetAddr() string {
+func (m *PendingTxsRequest) GetInitiator() string {
 	if m != nil {
-		return m.Addr
+		return m.Initiator
 	}
 	return ""
 }
 
-func (m *IdentityAuth) GetPeerID() string {
+func (m *PendingTxsRequest) GetContract() string {
 	if m != nil {
-		return m.PeerID
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *IdentityAuth) GetTimestamp() string {
+func (m *PendingTxsRequest) GetOffset() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.Offset
 	}
-	return ""
-}
-
-// Identity authentication requests
-type IdentityAuths struct {
-	Auth                 []*IdentityAuth `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
-}
-
-func (m *IdentityAuths) Reset()         { *m = IdentityAuths{} }
-func (m *IdentityAuths) String() string { return proto.CompactTextString(m) }
-func (*IdentityAuths) ProtoMessage()    {}
-func (*IdentityAuths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{70}
+	return 0
 }
 
-func (m *IdentityAuths) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IdentityAuths.Unmarshal(m, b)
-}
-func (m *IdentityAuths) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IdentityAuths.Marshal(b, m, deterministic)
-}
-func (m *IdentityAuths) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IdentityAuths.Merge(m, src)
-}
-func (m *IdentityAuths) XXX_Size() int {
-	return xxx_messageInfo_IdentityAuths.Size(m)
-}
-func (m *IdentityAuths) XXX_DiscardUnknown() {
-	xxx_messageInfo_IdentityAuths.DiscardUnknown(m)
+func (m *PendingTxsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-var xxx_messageInfo_IdentityAuths proto.InternalMessageInfo
-
-func (m *IdentityAuths) GetAuth() []*IdentityAuth {
+func (m *PendingTxsRequest) GetNeedContent() bool {
 	if m != nil {
-		return m.Auth
+		return m.NeedContent
 	}
-	return nil
+	return false
 }
 
-type ResourceLimit struct {
-	Type                 ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.ResourceType" json:"type,omitempty"`
-	Limit                int64        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+type PendingTxsResponse struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string       `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	PoolSize             int64        `protobuf:"varint,3,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Matched              int64        `protobuf:"varint,4,opt,name=matched,proto3" json:"matched,omitempty"`
+	Txs                  []*PendingTx `protobuf:"bytes,5,rep,name=txs,proto3" json:"txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ResourceLimit) Reset()         { *m = ResourceLimit{} }
-func (m *ResourceLimit) String() string { return proto.CompactTextString(m) }
-func (*ResourceLimit) ProtoMessage()    {}
-func (*ResourceLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{71}
+func (m *PendingTxsResponse) Reset()         { *m = PendingTxsResponse{} }
+func (m *PendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsResponse) ProtoMessage()    {}
+func (*PendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{127}
 }
 
-func (m *ResourceLimit) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResourceLimit.Unmarshal(m, b)
+func (m *PendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxsResponse.Unmarshal(m, b)
 }
-func (m *ResourceLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResourceLimit.Marshal(b, m, deterministic)
+func (m *PendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxsResponse.Marshal(b, m, deterministic)
 }
-func (m *ResourceLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceLimit.Merge(m, src)
+func (m *PendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsResponse.Merge(m, src)
 }
-func (m *ResourceLimit) XXX_Size() int {
-	return xxx_messageInfo_ResourceLimit.Size(m)
+func (m *PendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingTxsResponse.Size(m)
 }
-func (m *ResourceLimit) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceLimit.DiscardUnknown(m)
+func (m *PendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceLimit proto.InternalMessageInfo
+var xxx_messageInfo_PendingTxsResponse proto.InternalMessageInfo
 
-func (m *ResourceLimit) GetType() ResourceType {
+func (m *PendingTxsResponse) GetHeader() *Header {
 	if m != nil {
-		return m.Type
+		return m.Header
 	}
-	return ResourceType_CPU
+	return nil
 }
 
-func (m *ResourceLimit) GetLimit() int64 {
+func (m *PendingTxsResponse) GetBcname() string {
 	if m != nil {
-		return m.Limit
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *PendingTxsResponse) GetPoolSize() int64 {
+	if m != nil {
+		return m.PoolSize
 	}
 	return 0
 }
 
-type AK2AccountRequest struct {
+func (m *PendingTxsResponse) GetMatched() int64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *PendingTxsResponse) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type PendingTxRequest struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	NeedContent          bool     `protobuf:"varint,4,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AK2AccountRequest) Reset()         { *m = AK2AccountRequest{} }
-func (m *AK2AccountRequest) String() string { return proto.CompactTextString(m) }
-func (*AK2AccountRequest) ProtoMessage()    {}
-func (*AK2AccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{72}
+func (m *PendingTxRequest) Reset()         { *m = PendingTxRequest{} }
+func (m *PendingTxRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTxRequest) ProtoMessage()    {}
+func (*PendingTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{128}
 }
 
-func (m *AK2AccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AK2AccountRequest.Unmarshal(m, b)
+func (m *PendingTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxRequest.Unmarshal(m, b)
 }
-func (m *AK2AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AK2AccountRequest.Marshal(b, m, deterministic)
+func (m *PendingTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxRequest.Marshal(b, m, deterministic)
 }
-func (m *AK2AccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AK2AccountRequest.Merge(m, src)
+func (m *PendingTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxRequest.Merge(m, src)
 }
-func (m *AK2AccountRequest) XXX_Size() int {
-	return xxx_messageInfo_AK2AccountRequest.Size(m)
+func (m *PendingTxRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingTxRequest.Size(m)
 }
-func (m *AK2AccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AK2AccountRequest.DiscardUnknown(m)
+func (m *PendingTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AK2AccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_PendingTxRequest proto.InternalMessageInfo
 
-func (m *AK2AccountRequest) GetHeader() *Header {
+func (m *PendingTxRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *AK2AccountRequest) GetBcname() string {
+func (m *PendingTxRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *AK2AccountRequest) GetAddress() string {
+func (m *PendingTxRequest) GetTxid() []byte {
 	if m != nil {
-		return m.Address
+		return m.Txid
 	}
-	return ""
+	return nil
 }
 
-type AK2AccountResponse struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Account              []string `protobuf:"bytes,3,rep,name=account,proto3" json:"account,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingTxRequest) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
 }
 
-func (m *AK2AccountResponse) Reset()         { *m = AK2AccountResponse{} }
-func (m *AK2AccountResponse) String() string { return proto.CompactTextString(m) }
-func (*AK2AccountResponse) ProtoMessage()    {}
-func (*AK2AccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{73}
+type PendingTxResponse struct {
+	Header               *Header    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string     `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Tx                   *PendingTx `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *AK2AccountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AK2AccountResponse.Unmarshal(m, b)
+func (m *PendingTxResponse) Reset()         { *m = PendingTxResponse{} }
+func (m *PendingTxResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxResponse) ProtoMessage()    {}
+func (*PendingTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{129}
 }
-func (m *AK2AccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AK2AccountResponse.Marshal(b, m, deterministic)
+
+func (m *PendingTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxResponse.Unmarshal(m, b)
 }
-func (m *AK2AccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AK2AccountResponse.Merge(m, src)
+func (m *PendingTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxResponse.Marshal(b, m, deterministic)
 }
-func (m *AK2AccountResponse) XXX_Size() int {
-	return xxx_messageInfo_AK2AccountResponse.Size(m)
+func (m *PendingTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxResponse.Merge(m, src)
 }
-func (m *AK2AccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AK2AccountResponse.DiscardUnknown(m)
+func (m *PendingTxResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingTxResponse.Size(m)
+}
+func (m *PendingTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AK2AccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_PendingTxResponse proto.InternalMessageInfo
 
-func (m *AK2AccountResponse) GetHeader() *Header {
+func (m *PendingTxResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *AK2AccountResponse) GetBcname() string {
+func (m *PendingTxResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *AK2AccountResponse) GetAccount() []string {
+func (m *PendingTxResponse) GetTx() *PendingTx {
 	if m != nil {
-		return m.Account
+		return m.Tx
 	}
 	return nil
 }
 
-// Query account contracts request
-type GetAccountContractsRequest struct {
+type AddressTxHistoryRequest struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	NeedContent          bool     `protobuf:"varint,6,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAccountContractsRequest) Reset()         { *m = GetAccountContractsRequest{} }
-func (m *GetAccountContractsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountContractsRequest) ProtoMessage()    {}
-func (*GetAccountContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{74}
+func (m *AddressTxHistoryRequest) Reset()         { *m = AddressTxHistoryRequest{} }
+func (m *AddressTxHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressTxHistoryRequest) ProtoMessage()    {}
+func (*AddressTxHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{130}
 }
 
-func (m *GetAccountContractsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountContractsRequest.Unmarshal(m, b)
+func (m *AddressTxHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxHistoryRequest.Unmarshal(m, b)
 }
-func (m *GetAccountContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountContractsRequest.Marshal(b, m, deterministic)
+func (m *AddressTxHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAccountContractsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountContractsRequest.Merge(m, src)
+func (m *AddressTxHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxHistoryRequest.Merge(m, src)
 }
-func (m *GetAccountContractsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAccountContractsRequest.Size(m)
+func (m *AddressTxHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressTxHistoryRequest.Size(m)
 }
-func (m *GetAccountContractsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountContractsRequest.DiscardUnknown(m)
+func (m *AddressTxHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountContractsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddressTxHistoryRequest proto.InternalMessageInfo
 
-func (m *GetAccountContractsRequest) GetHeader() *Header {
+func (m *AddressTxHistoryRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *GetAccountContractsRequest) GetBcname() string {
+func (m *AddressTxHistoryRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *GetAccountContractsRequest) GetAccount() string {
+func (m *AddressTxHistoryRequest) GetAddress() string {
 	if m != nil {
-		return m.Account
+		return m.Address
 	}
 	return ""
 }
 
-// Query account contracts response
-type GetAccountContractsResponse struct {
-	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	ContractsStatus      []*ContractStatus `protobuf:"bytes,2,rep,name=contracts_status,json=contractsStatus,proto3" json:"contracts_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *GetAccountContractsResponse) Reset()         { *m = GetAccountContractsResponse{} }
-func (m *GetAccountContractsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAccountContractsResponse) ProtoMessage()    {}
-func (*GetAccountContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{75}
-}
-
-func (m *GetAccountContractsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountContractsResponse.Unmarshal(m, b)
-}
-func (m *GetAccountContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountContractsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetAccountContractsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountContractsResponse.Merge(m, src)
-}
-func (m *GetAccountContractsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAccountContractsResponse.Size(m)
-}
-func (m *GetAccountContractsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountContractsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetAccountContractsResponse proto.InternalMessageInfo
-
-func (m *GetAccountContractsResponse) GetHeader() *Header {
+func (m *AddressTxHistoryRequest) GetCursor() []byte {
 	if m != nil {
-		return m.Header
+		return m.Cursor
 	}
 	return nil
 }
 
-func (m *GetAccountContractsResponse) GetContractsStatus() []*ContractStatus {
+func (m *AddressTxHistoryRequest) GetLimit() int64 {
 	if m != nil {
-		return m.ContractsStatus
-	}
-	return nil
-}
-
-// Status of a contract
-type ContractStatus struct {
-	ContractName         string   `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
-	Txid                 string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
-	Desc                 []byte   `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	IsBanned             bool     `protobuf:"varint,4,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
-	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Runtime              string   `protobuf:"bytes,6,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
-func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
-func (*ContractStatus) ProtoMessage()    {}
-func (*ContractStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{76}
+func (m *AddressTxHistoryRequest) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
 }
 
-func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContractStatus.Unmarshal(m, b)
+type AddressTx struct {
+	Txid                 []byte       `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Blockid              []byte       `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Height               int64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Roles                []TxRole     `protobuf:"varint,4,rep,packed,name=roles,proto3,enum=pb.TxRole" json:"roles,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *ContractStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContractStatus.Marshal(b, m, deterministic)
+
+func (m *AddressTx) Reset()         { *m = AddressTx{} }
+func (m *AddressTx) String() string { return proto.CompactTextString(m) }
+func (*AddressTx) ProtoMessage()    {}
+func (*AddressTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{131}
 }
-func (m *ContractStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractStatus.Merge(m, src)
+
+func (m *AddressTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTx.Unmarshal(m, b)
 }
-func (m *ContractStatus) XXX_Size() int {
-	return xxx_messageInfo_ContractStatus.Size(m)
+func (m *AddressTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTx.Marshal(b, m, deterministic)
 }
-func (m *ContractStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractStatus.DiscardUnknown(m)
+func (m *AddressTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTx.Merge(m, src)
 }
-
-var xxx_messageInfo_ContractStatus proto.InternalMessageInfo
-
-func (m *ContractStatus) GetContractName() string {
-	if m != nil {
-		return m.ContractName
-	}
-	return ""
+func (m *AddressTx) XXX_Size() int {
+	return xxx_messageInfo_AddressTx.Size(m)
+}
+func (m *AddressTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTx.DiscardUnknown(m)
 }
 
-func (m *ContractStatus) GetTxid() string {
+var xxx_messageInfo_AddressTx proto.InternalMessageInfo
+
+func (m *AddressTx) GetTxid() []byte {
 	if m != nil {
 		return m.Txid
 	}
-	return ""
+	return nil
 }
 
-func (m *ContractStatus) GetDesc() []byte {
+func (m *AddressTx) GetBlockid() []byte {
 	if m != nil {
-		return m.Desc
+		return m.Blockid
 	}
 	return nil
 }
 
-func (m *ContractStatus) GetIsBanned() bool {
+func (m *AddressTx) GetHeight() int64 {
 	if m != nil {
-		return m.IsBanned
+		return m.Height
 	}
-	return false
+	return 0
 }
 
-func (m *ContractStatus) GetTimestamp() int64 {
+func (m *AddressTx) GetRoles() []TxRole {
 	if m != nil {
-		return m.Timestamp
+		return m.Roles
 	}
-	return 0
+	return nil
 }
 
-func (m *ContractStatus) GetRuntime() string {
+func (m *AddressTx) GetTx() *Transaction {
 	if m != nil {
-		return m.Runtime
+		return m.Tx
 	}
-	return ""
+	return nil
 }
 
-// PreExecWithSelectUTXORequest preExec + selectUtxo for request
-type PreExecWithSelectUTXORequest struct {
-	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	TotalAmount          int64             `protobuf:"varint,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
-	SignInfo             *SignatureInfo    `protobuf:"bytes,6,opt,name=signInfo,proto3" json:"signInfo,omitempty"`
-	NeedLock             bool              `protobuf:"varint,7,opt,name=needLock,proto3" json:"needLock,omitempty"`
-	Request              *InvokeRPCRequest `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type AddressTxHistoryResponse struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string       `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Txs                  []*AddressTx `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	NextCursor           []byte       `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *PreExecWithSelectUTXORequest) Reset()         { *m = PreExecWithSelectUTXORequest{} }
-func (m *PreExecWithSelectUTXORequest) String() string { return proto.CompactTextString(m) }
-func (*PreExecWithSelectUTXORequest) ProtoMessage()    {}
-func (*PreExecWithSelectUTXORequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{77}
+func (m *AddressTxHistoryResponse) Reset()         { *m = AddressTxHistoryResponse{} }
+func (m *AddressTxHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressTxHistoryResponse) ProtoMessage()    {}
+func (*AddressTxHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{132}
 }
 
-func (m *PreExecWithSelectUTXORequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PreExecWithSelectUTXORequest.Unmarshal(m, b)
+func (m *AddressTxHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxHistoryResponse.Unmarshal(m, b)
 }
-func (m *PreExecWithSelectUTXORequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PreExecWithSelectUTXORequest.Marshal(b, m, deterministic)
+func (m *AddressTxHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxHistoryResponse.Marshal(b, m, deterministic)
 }
-func (m *PreExecWithSelectUTXORequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PreExecWithSelectUTXORequest.Merge(m, src)
+func (m *AddressTxHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxHistoryResponse.Merge(m, src)
 }
-func (m *PreExecWithSelectUTXORequest) XXX_Size() int {
-	return xxx_messageInfo_PreExecWithSelectUTXORequest.Size(m)
+func (m *AddressTxHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressTxHistoryResponse.Size(m)
 }
-func (m *PreExecWithSelectUTXORequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PreExecWithSelectUTXORequest.DiscardUnknown(m)
+func (m *AddressTxHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PreExecWithSelectUTXORequest proto.InternalMessageInfo
+var xxx_messageInfo_AddressTxHistoryResponse proto.InternalMessageInfo
 
-func (m *PreExecWithSelectUTXORequest) GetHeader() *Header {
+func (m *AddressTxHistoryResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *PreExecWithSelectUTXORequest) GetBcname() string {
+func (m *AddressTxHistoryResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *PreExecWithSelectUTXORequest) GetAddress() string {
+func (m *AddressTxHistoryResponse) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *PreExecWithSelectUTXORequest) GetTotalAmount() int64 {
+func (m *AddressTxHistoryResponse) GetTxs() []*AddressTx {
 	if m != nil {
-		return m.TotalAmount
+		return m.Txs
 	}
-	return 0
+	return nil
 }
 
-func (m *PreExecWithSelectUTXORequest) GetSignInfo() *SignatureInfo {
+func (m *AddressTxHistoryResponse) GetNextCursor() []byte {
 	if m != nil {
-		return m.SignInfo
+		return m.NextCursor
 	}
 	return nil
 }
 
-func (m *PreExecWithSelectUTXORequest) GetNeedLock() bool {
+type ContractStatDataRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractStatDataRequest) Reset()         { *m = ContractStatDataRequest{} }
+func (m *ContractStatDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractStatDataRequest) ProtoMessage()    {}
+func (*ContractStatDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{133}
+}
+
+func (m *ContractStatDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractStatDataRequest.Unmarshal(m, b)
+}
+func (m *ContractStatDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractStatDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ContractStatDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStatDataRequest.Merge(m, src)
+}
+func (m *ContractStatDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ContractStatDataRequest.Size(m)
+}
+func (m *ContractStatDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStatDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStatDataRequest proto.InternalMessageInfo
+
+func (m *ContractStatDataRequest) GetHeader() *Header {
 	if m != nil {
-		return m.NeedLock
+		return m.Header
 	}
-	return false
+	return nil
 }
 
-func (m *PreExecWithSelectUTXORequest) GetRequest() *InvokeRPCRequest {
+func (m *ContractStatDataRequest) GetBcname() string {
 	if m != nil {
-		return m.Request
+		return m.Bcname
 	}
-	return nil
+	return ""
 }
 
-// PreExecWithSelectUTXOResponse preExec + selectUtxo for response
-type PreExecWithSelectUTXOResponse struct {
-	Header   *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname   string          `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Response *InvokeResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-	// for preExec & selectUTXO
-	UtxoOutput           *UtxoOutput `protobuf:"bytes,4,opt,name=utxoOutput,proto3" json:"utxoOutput,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ContractStatDataResponse struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Data                 *ContractStatData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PreExecWithSelectUTXOResponse) Reset()         { *m = PreExecWithSelectUTXOResponse{} }
-func (m *PreExecWithSelectUTXOResponse) String() string { return proto.CompactTextString(m) }
-func (*PreExecWithSelectUTXOResponse) ProtoMessage()    {}
-func (*PreExecWithSelectUTXOResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{78}
+func (m *ContractStatDataResponse) Reset()         { *m = ContractStatDataResponse{} }
+func (m *ContractStatDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractStatDataResponse) ProtoMessage()    {}
+func (*ContractStatDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{134}
 }
 
-func (m *PreExecWithSelectUTXOResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Unmarshal(m, b)
+func (m *ContractStatDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractStatDataResponse.Unmarshal(m, b)
 }
-func (m *PreExecWithSelectUTXOResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Marshal(b, m, deterministic)
+func (m *ContractStatDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractStatDataResponse.Marshal(b, m, deterministic)
 }
-func (m *PreExecWithSelectUTXOResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PreExecWithSelectUTXOResponse.Merge(m, src)
+func (m *ContractStatDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStatDataResponse.Merge(m, src)
 }
-func (m *PreExecWithSelectUTXOResponse) XXX_Size() int {
-	return xxx_messageInfo_PreExecWithSelectUTXOResponse.Size(m)
+func (m *ContractStatDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ContractStatDataResponse.Size(m)
 }
-func (m *PreExecWithSelectUTXOResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PreExecWithSelectUTXOResponse.DiscardUnknown(m)
+func (m *ContractStatDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStatDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PreExecWithSelectUTXOResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractStatDataResponse proto.InternalMessageInfo
 
-func (m *PreExecWithSelectUTXOResponse) GetHeader() *Header {
+func (m *ContractStatDataResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *PreExecWithSelectUTXOResponse) GetBcname() string {
+func (m *ContractStatDataResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *PreExecWithSelectUTXOResponse) GetResponse() *InvokeResponse {
+func (m *ContractStatDataResponse) GetData() *ContractStatData {
 	if m != nil {
-		return m.Response
+		return m.Data
 	}
 	return nil
 }
 
-func (m *PreExecWithSelectUTXOResponse) GetUtxoOutput() *UtxoOutput {
+type ContractStatData struct {
+	AccountCount         int64    `protobuf:"varint,1,opt,name=accountCount,proto3" json:"accountCount,omitempty"`
+	ContractCount        int64    `protobuf:"varint,2,opt,name=contractCount,proto3" json:"contractCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractStatData) Reset()         { *m = ContractStatData{} }
+func (m *ContractStatData) String() string { return proto.CompactTextString(m) }
+func (*ContractStatData) ProtoMessage()    {}
+func (*ContractStatData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{135}
+}
+
+func (m *ContractStatData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractStatData.Unmarshal(m, b)
+}
+func (m *ContractStatData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractStatData.Marshal(b, m, deterministic)
+}
+func (m *ContractStatData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStatData.Merge(m, src)
+}
+func (m *ContractStatData) XXX_Size() int {
+	return xxx_messageInfo_ContractStatData.Size(m)
+}
+func (m *ContractStatData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStatData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStatData proto.InternalMessageInfo
+
+func (m *ContractStatData) GetAccountCount() int64 {
 	if m != nil {
-		return m.UtxoOutput
+		return m.AccountCount
 	}
-	return nil
+	return 0
 }
 
-// ContractResponse is the response returnd by contract
-type ContractResponse struct {
-	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Body                 []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+func (m *ContractStatData) GetContractCount() int64 {
+	if m != nil {
+		return m.ContractCount
+	}
+	return 0
+}
+
+type ContractInfoRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ContractName         string   `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	NeedCode             bool     `protobuf:"varint,4,opt,name=need_code,json=needCode,proto3" json:"need_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContractResponse) Reset()         { *m = ContractResponse{} }
-func (m *ContractResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractResponse) ProtoMessage()    {}
-func (*ContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{79}
+func (m *ContractInfoRequest) Reset()         { *m = ContractInfoRequest{} }
+func (m *ContractInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractInfoRequest) ProtoMessage()    {}
+func (*ContractInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{136}
 }
 
-func (m *ContractResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContractResponse.Unmarshal(m, b)
+func (m *ContractInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractInfoRequest.Unmarshal(m, b)
 }
-func (m *ContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContractResponse.Marshal(b, m, deterministic)
+func (m *ContractInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *ContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractResponse.Merge(m, src)
+func (m *ContractInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInfoRequest.Merge(m, src)
 }
-func (m *ContractResponse) XXX_Size() int {
-	return xxx_messageInfo_ContractResponse.Size(m)
+func (m *ContractInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ContractInfoRequest.Size(m)
 }
-func (m *ContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractResponse.DiscardUnknown(m)
+func (m *ContractInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractInfoRequest proto.InternalMessageInfo
 
-func (m *ContractResponse) GetStatus() int32 {
+func (m *ContractInfoRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Status
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *ContractResponse) GetMessage() string {
+func (m *ContractInfoRequest) GetBcname() string {
 	if m != nil {
-		return m.Message
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *ContractResponse) GetBody() []byte {
+func (m *ContractInfoRequest) GetContractName() string {
 	if m != nil {
-		return m.Body
+		return m.ContractName
 	}
-	return nil
+	return ""
 }
 
-type ModifyBlock struct {
-	// txid交易被effective_txid的交易提出可修改区块链的请求
-	EffectiveTxid string `protobuf:"bytes,1,opt,name=effective_txid,json=effectiveTxid,proto3" json:"effective_txid,omitempty"`
-	// 本交易是否已被修改标记
-	Marked bool `protobuf:"varint,2,opt,name=marked,proto3" json:"marked,omitempty"`
-	// txid交易被修改生效的高度
-	EffectiveHeight int64 `protobuf:"varint,3,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
-	// 监管的public key
-	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// 监管地址对修改的交易id的签名
-	Sign                 string   `protobuf:"bytes,5,opt,name=sign,proto3" json:"sign,omitempty"`
+func (m *ContractInfoRequest) GetNeedCode() bool {
+	if m != nil {
+		return m.NeedCode
+	}
+	return false
+}
+
+type ContractInfoResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ContractName         string   `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Module               string   `protobuf:"bytes,4,opt,name=module,proto3" json:"module,omitempty"`
+	Runtime              string   `protobuf:"bytes,5,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Compiler             string   `protobuf:"bytes,6,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	VmCompiler           string   `protobuf:"bytes,7,opt,name=vm_compiler,json=vmCompiler,proto3" json:"vm_compiler,omitempty"`
+	CodeHash             []byte   `protobuf:"bytes,8,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Account              string   `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
+	DeployTxid           []byte   `protobuf:"bytes,10,opt,name=deploy_txid,json=deployTxid,proto3" json:"deploy_txid,omitempty"`
+	UpgradeTxid          []byte   `protobuf:"bytes,11,opt,name=upgrade_txid,json=upgradeTxid,proto3" json:"upgrade_txid,omitempty"`
+	Abi                  []byte   `protobuf:"bytes,12,opt,name=abi,proto3" json:"abi,omitempty"`
+	Code                 []byte   `protobuf:"bytes,13,opt,name=code,proto3" json:"code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ModifyBlock) Reset()         { *m = ModifyBlock{} }
-func (m *ModifyBlock) String() string { return proto.CompactTextString(m) }
-func (*ModifyBlock) ProtoMessage()    {}
-func (*ModifyBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{80}
+func (m *ContractInfoResponse) Reset()         { *m = ContractInfoResponse{} }
+func (m *ContractInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractInfoResponse) ProtoMessage()    {}
+func (*ContractInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{137}
 }
 
-func (m *ModifyBlock) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ModifyBlock.Unmarshal(m, b)
+func (m *ContractInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractInfoResponse.Unmarshal(m, b)
 }
-func (m *ModifyBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ModifyBlock.Marshal(b, m, deterministic)
+func (m *ContractInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractInfoResponse.Marshal(b, m, deterministic)
 }
-func (m *ModifyBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyBlock.Merge(m, src)
+func (m *ContractInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInfoResponse.Merge(m, src)
 }
-func (m *ModifyBlock) XXX_Size() int {
-	return xxx_messageInfo_ModifyBlock.Size(m)
+func (m *ContractInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_ContractInfoResponse.Size(m)
 }
-func (m *ModifyBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyBlock.DiscardUnknown(m)
+func (m *ContractInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyBlock proto.InternalMessageInfo
+var xxx_messageInfo_ContractInfoResponse proto.InternalMessageInfo
 
-func (m *ModifyBlock) GetEffectiveTxid() string {
+func (m *ContractInfoResponse) GetHeader() *Header {
 	if m != nil {
-		return m.EffectiveTxid
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *ModifyBlock) GetMarked() bool {
+func (m *ContractInfoResponse) GetBcname() string {
 	if m != nil {
-		return m.Marked
+		return m.Bcname
 	}
-	return false
+	return ""
 }
 
-func (m *ModifyBlock) GetEffectiveHeight() int64 {
+func (m *ContractInfoResponse) GetContractName() string {
 	if m != nil {
-		return m.EffectiveHeight
+		return m.ContractName
 	}
-	return 0
+	return ""
 }
 
-func (m *ModifyBlock) GetPublicKey() string {
+func (m *ContractInfoResponse) GetModule() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Module
 	}
 	return ""
 }
 
-func (m *ModifyBlock) GetSign() string {
+func (m *ContractInfoResponse) GetRuntime() string {
 	if m != nil {
-		return m.Sign
+		return m.Runtime
 	}
 	return ""
 }
 
-type HDInfo struct {
-	// HDPublickey
-	HdPublicKey []byte `protobuf:"bytes,1,opt,name=hd_public_key,json=hdPublicKey,proto3" json:"hd_public_key,omitempty"`
-	// original_hash
-	OriginalHash         []byte   `protobuf:"bytes,2,opt,name=original_hash,json=originalHash,proto3" json:"original_hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ContractInfoResponse) GetCompiler() string {
+	if m != nil {
+		return m.Compiler
+	}
+	return ""
 }
 
-func (m *HDInfo) Reset()         { *m = HDInfo{} }
-func (m *HDInfo) String() string { return proto.CompactTextString(m) }
-func (*HDInfo) ProtoMessage()    {}
-func (*HDInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{81}
+func (m *ContractInfoResponse) GetVmCompiler() string {
+	if m != nil {
+		return m.VmCompiler
+	}
+	return ""
 }
 
-func (m *HDInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HDInfo.Unmarshal(m, b)
-}
-func (m *HDInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HDInfo.Marshal(b, m, deterministic)
-}
-func (m *HDInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HDInfo.Merge(m, src)
+func (m *ContractInfoResponse) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
 }
-func (m *HDInfo) XXX_Size() int {
-	return xxx_messageInfo_HDInfo.Size(m)
+
+func (m *ContractInfoResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
-func (m *HDInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_HDInfo.DiscardUnknown(m)
+
+func (m *ContractInfoResponse) GetDeployTxid() []byte {
+	if m != nil {
+		return m.DeployTxid
+	}
+	return nil
 }
 
-var xxx_messageInfo_HDInfo proto.InternalMessageInfo
+func (m *ContractInfoResponse) GetUpgradeTxid() []byte {
+	if m != nil {
+		return m.UpgradeTxid
+	}
+	return nil
+}
 
-func (m *HDInfo) GetHdPublicKey() []byte {
+func (m *ContractInfoResponse) GetAbi() []byte {
 	if m != nil {
-		return m.HdPublicKey
+		return m.Abi
 	}
 	return nil
 }
 
-func (m *HDInfo) GetOriginalHash() []byte {
+func (m *ContractInfoResponse) GetCode() []byte {
 	if m != nil {
-		return m.OriginalHash
+		return m.Code
 	}
 	return nil
 }
 
-type UtxoRecordDetail struct {
-	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string      `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	AccountName          string      `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	OpenUtxoRecord       *UtxoRecord `protobuf:"bytes,4,opt,name=openUtxoRecord,proto3" json:"openUtxoRecord,omitempty"`
-	LockedUtxoRecord     *UtxoRecord `protobuf:"bytes,5,opt,name=lockedUtxoRecord,proto3" json:"lockedUtxoRecord,omitempty"`
-	FrozenUtxoRecord     *UtxoRecord `protobuf:"bytes,6,opt,name=frozenUtxoRecord,proto3" json:"frozenUtxoRecord,omitempty"`
-	DisplayCount         int64       `protobuf:"varint,7,opt,name=displayCount,proto3" json:"displayCount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ListContractKeysRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ContractName         string   `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	UseSnapshot          bool     `protobuf:"varint,7,opt,name=use_snapshot,json=useSnapshot,proto3" json:"use_snapshot,omitempty"`
+	Height               int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UtxoRecordDetail) Reset()         { *m = UtxoRecordDetail{} }
-func (m *UtxoRecordDetail) String() string { return proto.CompactTextString(m) }
-func (*UtxoRecordDetail) ProtoMessage()    {}
-func (*UtxoRecordDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{82}
+func (m *ListContractKeysRequest) Reset()         { *m = ListContractKeysRequest{} }
+func (m *ListContractKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListContractKeysRequest) ProtoMessage()    {}
+func (*ListContractKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{138}
 }
 
-func (m *UtxoRecordDetail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoRecordDetail.Unmarshal(m, b)
+func (m *ListContractKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListContractKeysRequest.Unmarshal(m, b)
 }
-func (m *UtxoRecordDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoRecordDetail.Marshal(b, m, deterministic)
+func (m *ListContractKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListContractKeysRequest.Marshal(b, m, deterministic)
 }
-func (m *UtxoRecordDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoRecordDetail.Merge(m, src)
+func (m *ListContractKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContractKeysRequest.Merge(m, src)
 }
-func (m *UtxoRecordDetail) XXX_Size() int {
-	return xxx_messageInfo_UtxoRecordDetail.Size(m)
+func (m *ListContractKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListContractKeysRequest.Size(m)
 }
-func (m *UtxoRecordDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoRecordDetail.DiscardUnknown(m)
+func (m *ListContractKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContractKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UtxoRecordDetail proto.InternalMessageInfo
+var xxx_messageInfo_ListContractKeysRequest proto.InternalMessageInfo
 
-func (m *UtxoRecordDetail) GetHeader() *Header {
+func (m *ListContractKeysRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *UtxoRecordDetail) GetBcname() string {
+func (m *ListContractKeysRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *UtxoRecordDetail) GetAccountName() string {
+func (m *ListContractKeysRequest) GetContractName() string {
 	if m != nil {
-		return m.AccountName
+		return m.ContractName
 	}
 	return ""
 }
 
-func (m *UtxoRecordDetail) GetOpenUtxoRecord() *UtxoRecord {
+func (m *ListContractKeysRequest) GetPrefix() []byte {
 	if m != nil {
-		return m.OpenUtxoRecord
+		return m.Prefix
 	}
 	return nil
 }
 
-func (m *UtxoRecordDetail) GetLockedUtxoRecord() *UtxoRecord {
+func (m *ListContractKeysRequest) GetCursor() []byte {
 	if m != nil {
-		return m.LockedUtxoRecord
+		return m.Cursor
 	}
 	return nil
 }
 
-func (m *UtxoRecordDetail) GetFrozenUtxoRecord() *UtxoRecord {
+func (m *ListContractKeysRequest) GetLimit() int64 {
 	if m != nil {
-		return m.FrozenUtxoRecord
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *UtxoRecordDetail) GetDisplayCount() int64 {
+func (m *ListContractKeysRequest) GetUseSnapshot() bool {
 	if m != nil {
-		return m.DisplayCount
+		return m.UseSnapshot
+	}
+	return false
+}
+
+func (m *ListContractKeysRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
 	return 0
 }
 
-type UtxoRecord struct {
-	UtxoCount            string     `protobuf:"bytes,1,opt,name=utxoCount,proto3" json:"utxoCount,omitempty"`
-	UtxoAmount           string     `protobuf:"bytes,2,opt,name=utxoAmount,proto3" json:"utxoAmount,omitempty"`
-	Item                 []*UtxoKey `protobuf:"bytes,3,rep,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type ContractKV struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UtxoRecord) Reset()         { *m = UtxoRecord{} }
-func (m *UtxoRecord) String() string { return proto.CompactTextString(m) }
-func (*UtxoRecord) ProtoMessage()    {}
-func (*UtxoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{83}
+func (m *ContractKV) Reset()         { *m = ContractKV{} }
+func (m *ContractKV) String() string { return proto.CompactTextString(m) }
+func (*ContractKV) ProtoMessage()    {}
+func (*ContractKV) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{139}
 }
 
-func (m *UtxoRecord) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoRecord.Unmarshal(m, b)
+func (m *ContractKV) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractKV.Unmarshal(m, b)
 }
-func (m *UtxoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoRecord.Marshal(b, m, deterministic)
+func (m *ContractKV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractKV.Marshal(b, m, deterministic)
 }
-func (m *UtxoRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoRecord.Merge(m, src)
+func (m *ContractKV) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractKV.Merge(m, src)
 }
-func (m *UtxoRecord) XXX_Size() int {
-	return xxx_messageInfo_UtxoRecord.Size(m)
+func (m *ContractKV) XXX_Size() int {
+	return xxx_messageInfo_ContractKV.Size(m)
 }
-func (m *UtxoRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoRecord.DiscardUnknown(m)
+func (m *ContractKV) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractKV.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UtxoRecord proto.InternalMessageInfo
-
-func (m *UtxoRecord) GetUtxoCount() string {
-	if m != nil {
-		return m.UtxoCount
-	}
-	return ""
-}
+var xxx_messageInfo_ContractKV proto.InternalMessageInfo
 
-func (m *UtxoRecord) GetUtxoAmount() string {
+func (m *ContractKV) GetKey() []byte {
 	if m != nil {
-		return m.UtxoAmount
+		return m.Key
 	}
-	return ""
+	return nil
 }
 
-func (m *UtxoRecord) GetItem() []*UtxoKey {
+func (m *ContractKV) GetValue() []byte {
 	if m != nil {
-		return m.Item
+		return m.Value
 	}
 	return nil
 }
 
-type UtxoKey struct {
-	RefTxid              string   `protobuf:"bytes,1,opt,name=refTxid,proto3" json:"refTxid,omitempty"`
-	Offset               string   `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListContractKeysResponse struct {
+	Header               *Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string        `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	ContractName         string        `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Kvs                  []*ContractKV `protobuf:"bytes,4,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	NextCursor           []byte        `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *UtxoKey) Reset()         { *m = UtxoKey{} }
-func (m *UtxoKey) String() string { return proto.CompactTextString(m) }
-func (*UtxoKey) ProtoMessage()    {}
-func (*UtxoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{84}
+func (m *ListContractKeysResponse) Reset()         { *m = ListContractKeysResponse{} }
+func (m *ListContractKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListContractKeysResponse) ProtoMessage()    {}
+func (*ListContractKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{140}
 }
 
-func (m *UtxoKey) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UtxoKey.Unmarshal(m, b)
+func (m *ListContractKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListContractKeysResponse.Unmarshal(m, b)
 }
-func (m *UtxoKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UtxoKey.Marshal(b, m, deterministic)
+func (m *ListContractKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListContractKeysResponse.Marshal(b, m, deterministic)
 }
-func (m *UtxoKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UtxoKey.Merge(m, src)
+func (m *ListContractKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContractKeysResponse.Merge(m, src)
 }
-func (m *UtxoKey) XXX_Size() int {
-	return xxx_messageInfo_UtxoKey.Size(m)
+func (m *ListContractKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListContractKeysResponse.Size(m)
 }
-func (m *UtxoKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_UtxoKey.DiscardUnknown(m)
+func (m *ListContractKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContractKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListContractKeysResponse proto.InternalMessageInfo
+
+func (m *ListContractKeysResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-var xxx_messageInfo_UtxoKey proto.InternalMessageInfo
-
-func (m *UtxoKey) GetRefTxid() string {
+func (m *ListContractKeysResponse) GetBcname() string {
 	if m != nil {
-		return m.RefTxid
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *UtxoKey) GetOffset() string {
+func (m *ListContractKeysResponse) GetContractName() string {
 	if m != nil {
-		return m.Offset
+		return m.ContractName
 	}
 	return ""
 }
 
-func (m *UtxoKey) GetAmount() string {
+func (m *ListContractKeysResponse) GetKvs() []*ContractKV {
 	if m != nil {
-		return m.Amount
+		return m.Kvs
 	}
-	return ""
+	return nil
 }
 
-type ContractStatDataRequest struct {
+func (m *ListContractKeysResponse) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+type StateAtHeightRequest struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Bucket               string   `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Blockid              []byte   `protobuf:"bytes,6,opt,name=blockid,proto3" json:"blockid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContractStatDataRequest) Reset()         { *m = ContractStatDataRequest{} }
-func (m *ContractStatDataRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractStatDataRequest) ProtoMessage()    {}
-func (*ContractStatDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{85}
+func (m *StateAtHeightRequest) Reset()         { *m = StateAtHeightRequest{} }
+func (m *StateAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*StateAtHeightRequest) ProtoMessage()    {}
+func (*StateAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{141}
 }
 
-func (m *ContractStatDataRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContractStatDataRequest.Unmarshal(m, b)
+func (m *StateAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateAtHeightRequest.Unmarshal(m, b)
 }
-func (m *ContractStatDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContractStatDataRequest.Marshal(b, m, deterministic)
+func (m *StateAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateAtHeightRequest.Marshal(b, m, deterministic)
 }
-func (m *ContractStatDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractStatDataRequest.Merge(m, src)
+func (m *StateAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAtHeightRequest.Merge(m, src)
 }
-func (m *ContractStatDataRequest) XXX_Size() int {
-	return xxx_messageInfo_ContractStatDataRequest.Size(m)
+func (m *StateAtHeightRequest) XXX_Size() int {
+	return xxx_messageInfo_StateAtHeightRequest.Size(m)
 }
-func (m *ContractStatDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractStatDataRequest.DiscardUnknown(m)
+func (m *StateAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAtHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractStatDataRequest proto.InternalMessageInfo
+var xxx_messageInfo_StateAtHeightRequest proto.InternalMessageInfo
 
-func (m *ContractStatDataRequest) GetHeader() *Header {
+func (m *StateAtHeightRequest) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *ContractStatDataRequest) GetBcname() string {
+func (m *StateAtHeightRequest) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-type ContractStatDataResponse struct {
-	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Data                 *ContractStatData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *StateAtHeightRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
 }
 
-func (m *ContractStatDataResponse) Reset()         { *m = ContractStatDataResponse{} }
-func (m *ContractStatDataResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractStatDataResponse) ProtoMessage()    {}
-func (*ContractStatDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{86}
+func (m *StateAtHeightRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *ContractStatDataResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContractStatDataResponse.Unmarshal(m, b)
+func (m *StateAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
-func (m *ContractStatDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContractStatDataResponse.Marshal(b, m, deterministic)
+
+func (m *StateAtHeightRequest) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
 }
-func (m *ContractStatDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractStatDataResponse.Merge(m, src)
+
+type StateAtHeightResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Bucket               string   `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Blockid              []byte   `protobuf:"bytes,6,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Height               int64    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ContractStatDataResponse) XXX_Size() int {
-	return xxx_messageInfo_ContractStatDataResponse.Size(m)
+
+func (m *StateAtHeightResponse) Reset()         { *m = StateAtHeightResponse{} }
+func (m *StateAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*StateAtHeightResponse) ProtoMessage()    {}
+func (*StateAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{142}
 }
-func (m *ContractStatDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractStatDataResponse.DiscardUnknown(m)
+
+func (m *StateAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateAtHeightResponse.Unmarshal(m, b)
+}
+func (m *StateAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateAtHeightResponse.Marshal(b, m, deterministic)
+}
+func (m *StateAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAtHeightResponse.Merge(m, src)
+}
+func (m *StateAtHeightResponse) XXX_Size() int {
+	return xxx_messageInfo_StateAtHeightResponse.Size(m)
+}
+func (m *StateAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAtHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractStatDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_StateAtHeightResponse proto.InternalMessageInfo
 
-func (m *ContractStatDataResponse) GetHeader() *Header {
+func (m *StateAtHeightResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *ContractStatDataResponse) GetBcname() string {
+func (m *StateAtHeightResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *ContractStatDataResponse) GetData() *ContractStatData {
+func (m *StateAtHeightResponse) GetBucket() string {
 	if m != nil {
-		return m.Data
+		return m.Bucket
 	}
-	return nil
-}
-
-type ContractStatData struct {
-	AccountCount         int64    `protobuf:"varint,1,opt,name=accountCount,proto3" json:"accountCount,omitempty"`
-	ContractCount        int64    `protobuf:"varint,2,opt,name=contractCount,proto3" json:"contractCount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *ContractStatData) Reset()         { *m = ContractStatData{} }
-func (m *ContractStatData) String() string { return proto.CompactTextString(m) }
-func (*ContractStatData) ProtoMessage()    {}
-func (*ContractStatData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{87}
+func (m *StateAtHeightResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *ContractStatData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContractStatData.Unmarshal(m, b)
-}
-func (m *ContractStatData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContractStatData.Marshal(b, m, deterministic)
-}
-func (m *ContractStatData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractStatData.Merge(m, src)
-}
-func (m *ContractStatData) XXX_Size() int {
-	return xxx_messageInfo_ContractStatData.Size(m)
-}
-func (m *ContractStatData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractStatData.DiscardUnknown(m)
+func (m *StateAtHeightResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-var xxx_messageInfo_ContractStatData proto.InternalMessageInfo
-
-func (m *ContractStatData) GetAccountCount() int64 {
+func (m *StateAtHeightResponse) GetBlockid() []byte {
 	if m != nil {
-		return m.AccountCount
+		return m.Blockid
 	}
-	return 0
+	return nil
 }
 
-func (m *ContractStatData) GetContractCount() int64 {
+func (m *StateAtHeightResponse) GetHeight() int64 {
 	if m != nil {
-		return m.ContractCount
+		return m.Height
 	}
 	return 0
 }
@@ -5923,7 +10248,7 @@ func (m *AddressContractsRequest) Reset()         { *m = AddressContractsRequest
 func (m *AddressContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsRequest) ProtoMessage()    {}
 func (*AddressContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{88}
+	return fileDescriptor_db0991b9525664ca, []int{143}
 }
 
 func (m *AddressContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5983,7 +10308,7 @@ func (m *ContractList) Reset()         { *m = ContractList{} }
 func (m *ContractList) String() string { return proto.CompactTextString(m) }
 func (*ContractList) ProtoMessage()    {}
 func (*ContractList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{89}
+	return fileDescriptor_db0991b9525664ca, []int{144}
 }
 
 func (m *ContractList) XXX_Unmarshal(b []byte) error {
@@ -6024,7 +10349,7 @@ func (m *AddressContractsResponse) Reset()         { *m = AddressContractsRespon
 func (m *AddressContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsResponse) ProtoMessage()    {}
 func (*AddressContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{90}
+	return fileDescriptor_db0991b9525664ca, []int{145}
 }
 
 func (m *AddressContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -6074,7 +10399,7 @@ func (m *CrossQueryRequest) Reset()         { *m = CrossQueryRequest{} }
 func (m *CrossQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryRequest) ProtoMessage()    {}
 func (*CrossQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{91}
+	return fileDescriptor_db0991b9525664ca, []int{146}
 }
 
 func (m *CrossQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6141,7 +10466,7 @@ func (m *CrossQueryResponse) Reset()         { *m = CrossQueryResponse{} }
 func (m *CrossQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryResponse) ProtoMessage()    {}
 func (*CrossQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{92}
+	return fileDescriptor_db0991b9525664ca, []int{147}
 }
 
 func (m *CrossQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6181,7 +10506,7 @@ func (m *CrossChainMeta) Reset()         { *m = CrossChainMeta{} }
 func (m *CrossChainMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossChainMeta) ProtoMessage()    {}
 func (*CrossChainMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{93}
+	return fileDescriptor_db0991b9525664ca, []int{148}
 }
 
 func (m *CrossChainMeta) XXX_Unmarshal(b []byte) error {
@@ -6230,7 +10555,7 @@ func (m *CrossEndorsor) Reset()         { *m = CrossEndorsor{} }
 func (m *CrossEndorsor) String() string { return proto.CompactTextString(m) }
 func (*CrossEndorsor) ProtoMessage()    {}
 func (*CrossEndorsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{94}
+	return fileDescriptor_db0991b9525664ca, []int{149}
 }
 
 func (m *CrossEndorsor) XXX_Unmarshal(b []byte) error {
@@ -6284,7 +10609,7 @@ func (m *CrossQueryMeta) Reset()         { *m = CrossQueryMeta{} }
 func (m *CrossQueryMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryMeta) ProtoMessage()    {}
 func (*CrossQueryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{95}
+	return fileDescriptor_db0991b9525664ca, []int{150}
 }
 
 func (m *CrossQueryMeta) XXX_Unmarshal(b []byte) error {
@@ -6332,7 +10657,7 @@ func (m *CrossQueryInfo) Reset()         { *m = CrossQueryInfo{} }
 func (m *CrossQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryInfo) ProtoMessage()    {}
 func (*CrossQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{96}
+	return fileDescriptor_db0991b9525664ca, []int{151}
 }
 
 func (m *CrossQueryInfo) XXX_Unmarshal(b []byte) error {
@@ -6387,7 +10712,7 @@ func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
 func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractEvent) ProtoMessage()    {}
 func (*ContractEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{97}
+	return fileDescriptor_db0991b9525664ca, []int{152}
 }
 
 func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
@@ -6435,15 +10760,31 @@ func init() {
 	proto.RegisterEnum("pb.ViewOption", ViewOption_name, ViewOption_value)
 	proto.RegisterEnum("pb.PermissionRule", PermissionRule_name, PermissionRule_value)
 	proto.RegisterEnum("pb.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterEnum("pb.AccountChangeType", AccountChangeType_name, AccountChangeType_value)
+	proto.RegisterEnum("pb.TxRole", TxRole_name, TxRole_value)
+	proto.RegisterEnum("pb.PendingTxState", PendingTxState_name, PendingTxState_value)
 	proto.RegisterEnum("pb.Block_EBlockStatus", Block_EBlockStatus_name, Block_EBlockStatus_value)
 	proto.RegisterType((*Header)(nil), "pb.Header")
 	proto.RegisterType((*TxDataAccount)(nil), "pb.TxDataAccount")
 	proto.RegisterType((*TxData)(nil), "pb.TxData")
 	proto.RegisterType((*TxStatus)(nil), "pb.TxStatus")
 	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
+	proto.RegisterType((*BatchTxRequest)(nil), "pb.BatchTxRequest")
+	proto.RegisterType((*WaitTxRequest)(nil), "pb.WaitTxRequest")
+	proto.RegisterType((*WaitTxResponse)(nil), "pb.WaitTxResponse")
+	proto.RegisterType((*TxProofRequest)(nil), "pb.TxProofRequest")
+	proto.RegisterType((*TxProofResponse)(nil), "pb.TxProofResponse")
 	proto.RegisterType((*Block)(nil), "pb.Block")
 	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
 	proto.RegisterType((*BlockHeight)(nil), "pb.BlockHeight")
+	proto.RegisterType((*BlockHeadersRequest)(nil), "pb.BlockHeadersRequest")
+	proto.RegisterType((*BlockHeader)(nil), "pb.BlockHeader")
+	proto.RegisterMapType((map[string]string)(nil), "pb.BlockHeader.FailedTxsEntry")
+	proto.RegisterType((*BlockHeaders)(nil), "pb.BlockHeaders")
+	proto.RegisterType((*ContractEventsRequest)(nil), "pb.ContractEventsRequest")
+	proto.RegisterType((*ContractEventInfo)(nil), "pb.ContractEventInfo")
+	proto.RegisterType((*ContractEventsResponse)(nil), "pb.ContractEventsResponse")
+	proto.RegisterType((*BlockRangeRequest)(nil), "pb.BlockRangeRequest")
 	proto.RegisterType((*CommonReply)(nil), "pb.CommonReply")
 	proto.RegisterType((*CommonIn)(nil), "pb.CommonIn")
 	proto.RegisterType((*TokenDetail)(nil), "pb.TokenDetail")
@@ -6451,6 +10792,9 @@ func init() {
 	proto.RegisterType((*TokenFrozenDetail)(nil), "pb.TokenFrozenDetail")
 	proto.RegisterType((*TokenFrozenDetails)(nil), "pb.TokenFrozenDetails")
 	proto.RegisterType((*AddressBalanceStatus)(nil), "pb.AddressBalanceStatus")
+	proto.RegisterType((*TokenSupplyRequest)(nil), "pb.TokenSupplyRequest")
+	proto.RegisterType((*TokenHolder)(nil), "pb.TokenHolder")
+	proto.RegisterType((*TokenSupplyResponse)(nil), "pb.TokenSupplyResponse")
 	proto.RegisterType((*TxInput)(nil), "pb.TxInput")
 	proto.RegisterType((*TxOutput)(nil), "pb.TxOutput")
 	proto.RegisterType((*XuperSignature)(nil), "pb.XuperSignature")
@@ -6469,12 +10813,17 @@ func init() {
 	proto.RegisterType((*BCSpeeds)(nil), "pb.BCSpeeds")
 	proto.RegisterMapType((map[string]float64)(nil), "pb.BCSpeeds.BcSpeedEntry")
 	proto.RegisterType((*SystemsStatus)(nil), "pb.SystemsStatus")
+	proto.RegisterType((*ChainStatsRequest)(nil), "pb.ChainStatsRequest")
+	proto.RegisterType((*BlockIntervalStats)(nil), "pb.BlockIntervalStats")
+	proto.RegisterType((*ContractInvokeStat)(nil), "pb.ContractInvokeStat")
+	proto.RegisterType((*ChainStatsResponse)(nil), "pb.ChainStatsResponse")
 	proto.RegisterType((*SystemsStatusReply)(nil), "pb.SystemsStatusReply")
 	proto.RegisterType((*ConsensusStatRequest)(nil), "pb.ConsensusStatRequest")
 	proto.RegisterType((*ConsensusStatus)(nil), "pb.ConsensusStatus")
 	proto.RegisterType((*RawUrl)(nil), "pb.RawUrl")
 	proto.RegisterType((*Utxo)(nil), "pb.Utxo")
 	proto.RegisterType((*UtxoInput)(nil), "pb.UtxoInput")
+	proto.RegisterType((*UtxoLockRelease)(nil), "pb.UtxoLockRelease")
 	proto.RegisterType((*UtxoOutput)(nil), "pb.UtxoOutput")
 	proto.RegisterType((*NativeCodeDesc)(nil), "pb.NativeCodeDesc")
 	proto.RegisterType((*WasmCodeDesc)(nil), "pb.WasmCodeDesc")
@@ -6497,6 +10846,19 @@ func init() {
 	proto.RegisterType((*DposStatusRequest)(nil), "pb.DposStatusRequest")
 	proto.RegisterType((*DposStatusResponse)(nil), "pb.DposStatusResponse")
 	proto.RegisterType((*DposStatus)(nil), "pb.DposStatus")
+	proto.RegisterType((*XpoaValidatorsRequest)(nil), "pb.XpoaValidatorsRequest")
+	proto.RegisterType((*XpoaValidator)(nil), "pb.XpoaValidator")
+	proto.RegisterType((*XpoaValidatorsResponse)(nil), "pb.XpoaValidatorsResponse")
+	proto.RegisterType((*XpoaStatusRequest)(nil), "pb.XpoaStatusRequest")
+	proto.RegisterType((*XpoaStatusResponse)(nil), "pb.XpoaStatusResponse")
+	proto.RegisterType((*GovernTokenBalanceRequest)(nil), "pb.GovernTokenBalanceRequest")
+	proto.RegisterType((*GovernTokenBalanceResponse)(nil), "pb.GovernTokenBalanceResponse")
+	proto.RegisterMapType((map[string]string)(nil), "pb.GovernTokenBalanceResponse.LockedBalancesEntry")
+	proto.RegisterType((*ProposalQueryRequest)(nil), "pb.ProposalQueryRequest")
+	proto.RegisterType((*ProposalTrigger)(nil), "pb.ProposalTrigger")
+	proto.RegisterType((*ProposalLock)(nil), "pb.ProposalLock")
+	proto.RegisterType((*ProposalInfo)(nil), "pb.ProposalInfo")
+	proto.RegisterType((*ProposalQueryResponse)(nil), "pb.ProposalQueryResponse")
 	proto.RegisterType((*InvokeRPCRequest)(nil), "pb.InvokeRPCRequest")
 	proto.RegisterType((*InvokeRPCResponse)(nil), "pb.InvokeRPCResponse")
 	proto.RegisterType((*InvokeRequest)(nil), "pb.InvokeRequest")
@@ -6517,20 +10879,43 @@ func init() {
 	proto.RegisterType((*ResourceLimit)(nil), "pb.ResourceLimit")
 	proto.RegisterType((*AK2AccountRequest)(nil), "pb.AK2AccountRequest")
 	proto.RegisterType((*AK2AccountResponse)(nil), "pb.AK2AccountResponse")
+	proto.RegisterType((*AccountChange)(nil), "pb.AccountChange")
+	proto.RegisterType((*AccountHistoryRequest)(nil), "pb.AccountHistoryRequest")
+	proto.RegisterType((*AccountHistoryResponse)(nil), "pb.AccountHistoryResponse")
 	proto.RegisterType((*GetAccountContractsRequest)(nil), "pb.GetAccountContractsRequest")
 	proto.RegisterType((*GetAccountContractsResponse)(nil), "pb.GetAccountContractsResponse")
 	proto.RegisterType((*ContractStatus)(nil), "pb.ContractStatus")
 	proto.RegisterType((*PreExecWithSelectUTXORequest)(nil), "pb.PreExecWithSelectUTXORequest")
 	proto.RegisterType((*PreExecWithSelectUTXOResponse)(nil), "pb.PreExecWithSelectUTXOResponse")
+	proto.RegisterType((*SimulateTxRequest)(nil), "pb.SimulateTxRequest")
+	proto.RegisterType((*BalanceChange)(nil), "pb.BalanceChange")
+	proto.RegisterType((*KeyChange)(nil), "pb.KeyChange")
+	proto.RegisterType((*SimulateTxResponse)(nil), "pb.SimulateTxResponse")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "pb.EstimateFeeResponse")
 	proto.RegisterType((*ContractResponse)(nil), "pb.ContractResponse")
 	proto.RegisterType((*ModifyBlock)(nil), "pb.ModifyBlock")
 	proto.RegisterType((*HDInfo)(nil), "pb.HDInfo")
 	proto.RegisterType((*UtxoRecordDetail)(nil), "pb.UtxoRecordDetail")
 	proto.RegisterType((*UtxoRecord)(nil), "pb.UtxoRecord")
 	proto.RegisterType((*UtxoKey)(nil), "pb.UtxoKey")
+	proto.RegisterType((*PendingTx)(nil), "pb.PendingTx")
+	proto.RegisterType((*PendingTxsRequest)(nil), "pb.PendingTxsRequest")
+	proto.RegisterType((*PendingTxsResponse)(nil), "pb.PendingTxsResponse")
+	proto.RegisterType((*PendingTxRequest)(nil), "pb.PendingTxRequest")
+	proto.RegisterType((*PendingTxResponse)(nil), "pb.PendingTxResponse")
+	proto.RegisterType((*AddressTxHistoryRequest)(nil), "pb.AddressTxHistoryRequest")
+	proto.RegisterType((*AddressTx)(nil), "pb.AddressTx")
+	proto.RegisterType((*AddressTxHistoryResponse)(nil), "pb.AddressTxHistoryResponse")
 	proto.RegisterType((*ContractStatDataRequest)(nil), "pb.ContractStatDataRequest")
 	proto.RegisterType((*ContractStatDataResponse)(nil), "pb.ContractStatDataResponse")
 	proto.RegisterType((*ContractStatData)(nil), "pb.ContractStatData")
+	proto.RegisterType((*ContractInfoRequest)(nil), "pb.ContractInfoRequest")
+	proto.RegisterType((*ContractInfoResponse)(nil), "pb.ContractInfoResponse")
+	proto.RegisterType((*ListContractKeysRequest)(nil), "pb.ListContractKeysRequest")
+	proto.RegisterType((*ContractKV)(nil), "pb.ContractKV")
+	proto.RegisterType((*ListContractKeysResponse)(nil), "pb.ListContractKeysResponse")
+	proto.RegisterType((*StateAtHeightRequest)(nil), "pb.StateAtHeightRequest")
+	proto.RegisterType((*StateAtHeightResponse)(nil), "pb.StateAtHeightResponse")
 	proto.RegisterType((*AddressContractsRequest)(nil), "pb.AddressContractsRequest")
 	proto.RegisterType((*ContractList)(nil), "pb.ContractList")
 	proto.RegisterType((*AddressContractsResponse)(nil), "pb.AddressContractsResponse")
@@ -6547,386 +10932,583 @@ func init() {
 func init() { proto.RegisterFile("xchain.proto", fileDescriptor_db0991b9525664ca) }
 
 var fileDescriptor_db0991b9525664ca = []byte{
-	// 6052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0xf8, 0x36, 0x29, 0xf1, 0xe3, 0xf1, 0x43, 0x54, 0xcd, 0x48, 0xc3, 0xa1, 0xb4, 0xf3, 0xd1,
-	0x3b, 0xbb, 0x2b, 0xcf, 0xfe, 0xac, 0xf9, 0xad, 0x6c, 0xff, 0x76, 0x31, 0xb6, 0xd7, 0x3f, 0x8a,
-	0xa2, 0x66, 0x68, 0x69, 0x48, 0x6d, 0x93, 0x9c, 0xd1, 0xc2, 0x01, 0xda, 0x2d, 0xb2, 0x24, 0xb5,
-	0x45, 0x76, 0xd3, 0xdd, 0x4d, 0x0d, 0xb5, 0x36, 0x92, 0x8d, 0x91, 0x93, 0x6f, 0x49, 0x80, 0xdc,
-	0x62, 0x04, 0x39, 0x06, 0xc8, 0x25, 0x08, 0x90, 0x43, 0x80, 0x00, 0x31, 0x02, 0x1f, 0x73, 0x09,
-	0x72, 0x48, 0xae, 0x0e, 0xf2, 0x1f, 0xe4, 0x1e, 0xd4, 0xab, 0xaa, 0xee, 0x6a, 0x7e, 0xcc, 0x8e,
-	0xbc, 0xe3, 0xbd, 0x8c, 0x58, 0xaf, 0x5e, 0xbd, 0xaa, 0xf7, 0xaa, 0xea, 0x7d, 0xd5, 0xeb, 0x81,
-	0xfc, 0xa4, 0x77, 0x6e, 0xd9, 0xce, 0xf6, 0xc8, 0x73, 0x03, 0x97, 0x24, 0x46, 0x27, 0x95, 0xcd,
-	0x33, 0xd7, 0x3d, 0x1b, 0xd0, 0x47, 0xd6, 0xc8, 0x7e, 0x64, 0x39, 0x8e, 0x1b, 0x58, 0x81, 0xed,
-	0x3a, 0x3e, 0xc7, 0xa8, 0x94, 0x10, 0x9d, 0xf6, 0x4f, 0x4e, 0x03, 0x0e, 0xd1, 0x4f, 0x21, 0xf5,
-	0x94, 0x5a, 0x7d, 0xea, 0x91, 0x9b, 0xb0, 0x3c, 0x70, 0xcf, 0xec, 0x7e, 0x59, 0xbb, 0xa7, 0x6d,
-	0x65, 0x0d, 0xde, 0x20, 0x1b, 0x90, 0x3d, 0xf5, 0xdc, 0xa1, 0xe9, 0xb8, 0x7d, 0x5a, 0x4e, 0x60,
-	0x4f, 0x86, 0x01, 0x9a, 0x6e, 0x9f, 0x92, 0x6f, 0xc0, 0x32, 0xf5, 0x3c, 0xd7, 0x2b, 0x27, 0xef,
-	0x69, 0x5b, 0xc5, 0x9d, 0x1b, 0xdb, 0xa3, 0x93, 0xed, 0xe3, 0x1a, 0x9b, 0xa2, 0xce, 0xc0, 0x75,
-	0x67, 0x3c, 0x34, 0x38, 0x86, 0x7e, 0x0a, 0x85, 0xce, 0x64, 0xcf, 0x0a, 0xac, 0x6a, 0xaf, 0xe7,
-	0x8e, 0x9d, 0x80, 0x94, 0x21, 0x6d, 0xf5, 0xfb, 0x1e, 0xf5, 0x7d, 0x31, 0xa1, 0x6c, 0x92, 0x75,
-	0x48, 0x59, 0x43, 0x86, 0x23, 0xe6, 0x13, 0x2d, 0xf2, 0x0e, 0x14, 0x4e, 0x3d, 0xf7, 0x73, 0xea,
-	0x98, 0xe7, 0xd4, 0x3e, 0x3b, 0x0f, 0x70, 0xd6, 0xa4, 0x91, 0xe7, 0xc0, 0xa7, 0x08, 0xd3, 0x7f,
-	0x9b, 0x80, 0x14, 0x9f, 0x88, 0xe8, 0x90, 0x3a, 0x47, 0xd6, 0xca, 0x85, 0x7b, 0xda, 0x56, 0x6e,
-	0x07, 0xd8, 0xf2, 0x38, 0xb3, 0x86, 0xe8, 0x21, 0x04, 0x96, 0x82, 0x89, 0xe0, 0x39, 0x6f, 0xe0,
-	0x6f, 0x36, 0xff, 0x49, 0xcf, 0xb1, 0x86, 0x92, 0x5f, 0xd1, 0x0a, 0x45, 0xc1, 0xd6, 0x89, 0x73,
-	0x0b, 0x51, 0x54, 0xfb, 0x7d, 0x8f, 0xdc, 0x85, 0x1c, 0x76, 0x8e, 0xc6, 0x27, 0x17, 0xf4, 0xaa,
-	0xbc, 0x84, 0xdd, 0xc0, 0x40, 0x47, 0x08, 0x09, 0x11, 0xfc, 0x9e, 0xc7, 0x10, 0x96, 0x23, 0x84,
-	0x36, 0x42, 0x18, 0xf9, 0xb1, 0x4f, 0x3d, 0xd3, 0xb7, 0xcf, 0x9c, 0x72, 0x11, 0xd7, 0x93, 0x61,
-	0x80, 0xb6, 0x7d, 0xe6, 0x90, 0x0f, 0x20, 0x6d, 0x71, 0xc1, 0x95, 0x53, 0xf7, 0x92, 0x5b, 0xb9,
-	0x9d, 0x55, 0xc6, 0x4c, 0x4c, 0xa2, 0x86, 0xc4, 0x60, 0x3b, 0xe9, 0xb8, 0x4e, 0x8f, 0x96, 0x33,
-	0x7c, 0x27, 0xb1, 0x41, 0x36, 0x21, 0x1b, 0xd8, 0x43, 0xea, 0x07, 0xd6, 0x70, 0x54, 0xce, 0xa2,
-	0xe8, 0x22, 0x00, 0x13, 0x44, 0x9f, 0xfa, 0xbd, 0x72, 0x9e, 0x0b, 0x82, 0xfd, 0x66, 0x5b, 0x74,
-	0x49, 0x3d, 0xdf, 0x76, 0x9d, 0xf2, 0xca, 0x3d, 0x6d, 0x6b, 0xd9, 0x90, 0x4d, 0xfd, 0x37, 0x1a,
-	0x64, 0x3a, 0x93, 0x76, 0x60, 0x05, 0x63, 0x5f, 0x91, 0xb3, 0xb6, 0x50, 0xce, 0x8b, 0x64, 0x2a,
-	0xe5, 0x9f, 0x54, 0xe4, 0xff, 0x4d, 0x48, 0xf9, 0x48, 0x19, 0xa5, 0x58, 0xdc, 0x59, 0x43, 0x56,
-	0x3d, 0xcb, 0xf1, 0xad, 0x1e, 0x3b, 0xcc, 0x7c, 0x5a, 0x43, 0x20, 0x91, 0x0a, 0x64, 0xfa, 0xb6,
-	0x1f, 0x58, 0x8c, 0xe1, 0x65, 0x64, 0x2b, 0x6c, 0x93, 0xbb, 0x90, 0x08, 0x26, 0xe5, 0x34, 0x2e,
-	0x6b, 0x65, 0x8a, 0x8c, 0x91, 0x08, 0x26, 0x7a, 0x13, 0x32, 0xbb, 0x56, 0xd0, 0x3b, 0xef, 0x4c,
-	0x5e, 0x8f, 0x8f, 0x3b, 0x90, 0xec, 0x4c, 0xfc, 0x72, 0x02, 0xf7, 0x20, 0xcf, 0xf7, 0x40, 0xac,
-	0x87, 0x75, 0xe8, 0xff, 0xa3, 0xc1, 0xf2, 0xee, 0xc0, 0xed, 0x5d, 0x7c, 0x25, 0xa9, 0x94, 0x21,
-	0x7d, 0xc2, 0x88, 0x84, 0x82, 0x91, 0x4d, 0xb2, 0x3d, 0x25, 0x9b, 0x75, 0x46, 0x15, 0x27, 0xdc,
-	0xae, 0xe3, 0x9f, 0x29, 0xe1, 0xbc, 0x0f, 0xcb, 0x38, 0x14, 0x25, 0x23, 0x4e, 0x4d, 0xc3, 0x09,
-	0xa8, 0xe7, 0x58, 0x03, 0xc4, 0x37, 0x78, 0xbf, 0xfe, 0x7d, 0xc8, 0xab, 0x04, 0x48, 0x16, 0x96,
-	0xeb, 0x86, 0xd1, 0x32, 0x4a, 0x6f, 0xb1, 0x9f, 0x1d, 0xa3, 0xdb, 0x3c, 0x28, 0x69, 0x04, 0x20,
-	0xb5, 0x6b, 0x54, 0x9b, 0xb5, 0xa7, 0xa5, 0x04, 0xc9, 0x41, 0xba, 0xd9, 0xaa, 0x1f, 0x37, 0xda,
-	0x9d, 0x52, 0x52, 0xff, 0x85, 0x06, 0x69, 0x1c, 0xde, 0xd8, 0x53, 0x38, 0x5f, 0x7a, 0x0d, 0xce,
-	0xb5, 0x45, 0x9c, 0x27, 0xe2, 0x9c, 0xdf, 0x87, 0xbc, 0x43, 0x69, 0xdf, 0xec, 0xb9, 0x4e, 0x40,
-	0x1d, 0x7e, 0xf9, 0x33, 0x46, 0x8e, 0xc1, 0x6a, 0x1c, 0xa4, 0x5b, 0x90, 0xc3, 0x35, 0x70, 0x55,
-	0xa0, 0xac, 0x23, 0x79, 0xed, 0x75, 0xac, 0xb3, 0xb1, 0xa8, 0x64, 0x12, 0x78, 0xa4, 0x44, 0x4b,
-	0xff, 0x10, 0x72, 0x35, 0x77, 0x38, 0x74, 0x1d, 0x83, 0x8e, 0x06, 0x57, 0xaf, 0xb3, 0xc9, 0xba,
-	0x09, 0x19, 0x3e, 0xa4, 0xe1, 0xbc, 0xd6, 0xa1, 0x78, 0x04, 0xb9, 0x4b, 0x9b, 0xbe, 0x34, 0xdd,
-	0x11, 0x3b, 0xa5, 0x38, 0x7f, 0x71, 0xa7, 0xc8, 0x10, 0x9f, 0xdb, 0xf4, 0x65, 0x0b, 0xa1, 0x06,
-	0x5c, 0x86, 0xbf, 0xf5, 0x9f, 0x40, 0xae, 0xe3, 0x5e, 0x50, 0x67, 0x8f, 0x06, 0x96, 0x3d, 0x78,
-	0xa5, 0x68, 0xad, 0x01, 0x5e, 0x13, 0x7e, 0xda, 0x64, 0xf3, 0x3a, 0x6a, 0x7c, 0x04, 0x85, 0x2a,
-	0x57, 0xd3, 0xd7, 0xb8, 0xfc, 0x8a, 0xaa, 0x4f, 0xc4, 0x55, 0xfd, 0x7d, 0x48, 0x9e, 0xf4, 0xfc,
-	0x72, 0x12, 0xaf, 0x13, 0xbf, 0xa0, 0x11, 0x27, 0x06, 0xeb, 0xd3, 0x1b, 0xb0, 0x8a, 0xb0, 0x7d,
-	0xd4, 0xf2, 0x82, 0x47, 0x85, 0x17, 0x2d, 0xce, 0x4b, 0x05, 0x32, 0xb6, 0xcf, 0x71, 0x71, 0xb2,
-	0x8c, 0x11, 0xb6, 0xf5, 0x2f, 0x34, 0x20, 0x33, 0xb4, 0xfc, 0x85, 0x02, 0x7b, 0x1f, 0x92, 0xc1,
-	0x69, 0x5f, 0xdc, 0xf5, 0xb5, 0x70, 0x71, 0xea, 0x60, 0x83, 0x61, 0x5c, 0x47, 0x7e, 0x5f, 0x68,
-	0x70, 0x53, 0x08, 0x70, 0x97, 0xaf, 0xf8, 0x8d, 0xc8, 0xf1, 0x21, 0x2c, 0x05, 0xa7, 0x7d, 0x29,
-	0xc8, 0xf5, 0xb9, 0x6b, 0xf5, 0x0d, 0xc4, 0xd1, 0xff, 0x52, 0x83, 0x74, 0x67, 0xd2, 0x70, 0x46,
-	0xe3, 0x80, 0xdc, 0x86, 0x8c, 0x47, 0x4f, 0x4d, 0xc5, 0x04, 0xa6, 0x3d, 0x7a, 0xda, 0x61, 0x5a,
-	0xf8, 0x6d, 0x00, 0xd6, 0xe5, 0x9e, 0x9e, 0xfa, 0x94, 0xdf, 0x82, 0x65, 0x23, 0xeb, 0xd1, 0xd3,
-	0x16, 0x02, 0xe2, 0xc6, 0x70, 0x99, 0x5b, 0xab, 0xd0, 0x18, 0x46, 0x16, 0x3c, 0x85, 0x3d, 0x0b,
-	0x2d, 0x78, 0x7a, 0x8e, 0x05, 0xff, 0x31, 0x33, 0x2d, 0xad, 0x71, 0xc0, 0xd6, 0x17, 0x11, 0xd2,
-	0x62, 0x84, 0x6e, 0x41, 0x3a, 0x70, 0xf9, 0xdc, 0x5c, 0x4d, 0xa4, 0x02, 0x17, 0x67, 0x9e, 0x99,
-	0x61, 0x69, 0xce, 0x0c, 0x2d, 0x28, 0x1e, 0x8f, 0x47, 0xdc, 0xb2, 0x5a, 0xc1, 0xd8, 0x63, 0x76,
-	0x22, 0x37, 0x1a, 0x9f, 0x0c, 0xec, 0x9e, 0x79, 0x41, 0xaf, 0x98, 0x43, 0x92, 0xdc, 0xca, 0x1b,
-	0xc0, 0x41, 0x07, 0xf4, 0xca, 0x67, 0xc6, 0xd3, 0x97, 0xd8, 0x62, 0xca, 0x08, 0xa0, 0xff, 0x6b,
-	0x0a, 0x72, 0x8a, 0x65, 0x99, 0xeb, 0x55, 0x2c, 0xd6, 0x6c, 0x5b, 0x90, 0x0d, 0x26, 0xa6, 0xcd,
-	0x36, 0x44, 0xee, 0x60, 0x8e, 0x5b, 0x16, 0xdc, 0x24, 0x23, 0x13, 0xf0, 0x1f, 0x3e, 0xf9, 0x00,
-	0x20, 0x98, 0x98, 0x2e, 0xca, 0x86, 0x59, 0x00, 0xc5, 0x08, 0x71, 0x81, 0x19, 0xd9, 0x40, 0xfc,
-	0xf2, 0x43, 0x8b, 0x9e, 0x52, 0x2c, 0x7a, 0x05, 0x32, 0x3d, 0xd7, 0x76, 0x4e, 0x2c, 0x9f, 0xa2,
-	0xec, 0x33, 0x46, 0xd8, 0xfe, 0x9d, 0xbc, 0x06, 0xc5, 0x43, 0x80, 0x98, 0x87, 0x80, 0x67, 0x75,
-	0x1c, 0xb8, 0x67, 0xd4, 0x29, 0xe7, 0x70, 0x22, 0xd9, 0x24, 0x3b, 0x50, 0x08, 0xd9, 0x35, 0xe9,
-	0x24, 0x28, 0xdf, 0x42, 0x3e, 0x8a, 0x0a, 0xcb, 0xf5, 0x49, 0x60, 0xe4, 0x24, 0xd7, 0xf5, 0x49,
-	0x40, 0xbe, 0x03, 0xc5, 0x88, 0x71, 0x1c, 0x54, 0x56, 0x54, 0x86, 0x60, 0x99, 0x8d, 0xca, 0x87,
-	0xfc, 0xb3, 0x61, 0x9f, 0xc0, 0x2a, 0x33, 0x17, 0x9e, 0xd5, 0x0b, 0x4c, 0x8f, 0xfe, 0x74, 0x4c,
-	0xfd, 0xc0, 0x2f, 0xdf, 0x8e, 0xfc, 0xa7, 0x86, 0x73, 0xe9, 0x5e, 0x50, 0x83, 0xf7, 0x18, 0x25,
-	0x89, 0x2b, 0x00, 0xb8, 0xeb, 0xb6, 0x63, 0x07, 0xb6, 0x15, 0xb8, 0x5e, 0xb9, 0x82, 0x62, 0x89,
-	0x00, 0xcc, 0x22, 0x59, 0xe3, 0xe0, 0x1c, 0x29, 0xdb, 0x1e, 0x2d, 0x6f, 0xdc, 0x4b, 0x6e, 0x65,
-	0x8d, 0x1c, 0x83, 0x19, 0x1c, 0x44, 0x1e, 0xc3, 0x4a, 0x88, 0x8f, 0x8e, 0x9d, 0x5f, 0xde, 0x8c,
-	0xa6, 0x0f, 0xcf, 0x5f, 0xc3, 0x39, 0x75, 0x8d, 0x62, 0x88, 0xc9, 0xe0, 0x3e, 0xf9, 0x01, 0x10,
-	0x95, 0xbc, 0x18, 0xfe, 0xf6, 0xa2, 0xe1, 0x25, 0x65, 0x5e, 0x4e, 0xe0, 0x9b, 0x40, 0x3c, 0xda,
-	0xa3, 0xf6, 0x25, 0xed, 0x9b, 0xd1, 0x1e, 0xde, 0xc1, 0x3d, 0x5c, 0x95, 0x3d, 0x9d, 0x70, 0x2f,
-	0x3f, 0x04, 0x98, 0xb0, 0x5b, 0xc1, 0x1d, 0xd0, 0xbb, 0xa8, 0x85, 0x08, 0xaa, 0xb2, 0xd8, 0x5d,
-	0x31, 0xb2, 0x13, 0xd9, 0x26, 0x3b, 0x90, 0x1f, 0xba, 0x7d, 0xfb, 0xf4, 0xca, 0xe4, 0x4e, 0xc6,
-	0xbd, 0xc8, 0xd1, 0x7a, 0x86, 0x70, 0xee, 0x62, 0xe4, 0x86, 0x51, 0x83, 0xbc, 0x03, 0xe9, 0xa7,
-	0x7b, 0xa6, 0xed, 0x9c, 0xba, 0xe5, 0xfb, 0x8a, 0xa6, 0xdb, 0x43, 0x26, 0x52, 0xfc, 0xaf, 0xee,
-	0x03, 0x1c, 0xd2, 0xfe, 0x19, 0xf5, 0x9e, 0xd1, 0xc0, 0x62, 0x82, 0xf6, 0x5c, 0x37, 0x30, 0xe5,
-	0xfd, 0xe1, 0xd7, 0x2a, 0xc7, 0x60, 0xbb, 0xe2, 0x0e, 0xdd, 0x85, 0x5c, 0x60, 0x8f, 0xcc, 0xf8,
-	0x0d, 0x83, 0xc0, 0x1e, 0xed, 0x46, 0xee, 0x43, 0xe0, 0x8d, 0x9d, 0x8b, 0x78, 0xec, 0x90, 0x43,
-	0x98, 0x50, 0x0b, 0xbf, 0x5c, 0x86, 0x4c, 0x37, 0x98, 0xb8, 0x38, 0xe7, 0xbb, 0x50, 0x1c, 0x58,
-	0x01, 0xf5, 0xa7, 0x67, 0x2d, 0x70, 0xa8, 0x24, 0xab, 0x43, 0x81, 0xfd, 0x62, 0x6a, 0xc3, 0x1c,
-	0xd8, 0x7e, 0x80, 0xd6, 0x22, 0x6b, 0xe4, 0x18, 0xf0, 0x80, 0x5e, 0x1d, 0xda, 0x7e, 0xc0, 0x34,
-	0xe9, 0x38, 0x98, 0xb8, 0x66, 0xe0, 0x06, 0xd6, 0x40, 0x04, 0x0e, 0x59, 0x06, 0xe9, 0x30, 0x00,
-	0xbb, 0x93, 0xd6, 0xe5, 0xd9, 0x1e, 0x1d, 0x58, 0x57, 0x42, 0x5b, 0x85, 0x6d, 0xf2, 0x7f, 0x60,
-	0x75, 0xec, 0xf4, 0x5c, 0xe7, 0xd4, 0xf6, 0x86, 0x9d, 0x49, 0x95, 0xab, 0x42, 0xee, 0xe4, 0xce,
-	0x76, 0x90, 0x07, 0x50, 0x1c, 0x5a, 0x13, 0xbe, 0x60, 0xd3, 0xb7, 0x3f, 0xa7, 0x78, 0xf7, 0x93,
-	0x46, 0x7e, 0x68, 0x4d, 0xb8, 0x6f, 0x67, 0x7f, 0x4e, 0xc9, 0xff, 0x67, 0xc7, 0xc2, 0xa7, 0xde,
-	0xa5, 0x70, 0xa6, 0xd8, 0x89, 0xf7, 0xcb, 0xe9, 0x45, 0xb7, 0x62, 0x55, 0x22, 0xd7, 0x24, 0x2e,
-	0xa3, 0x70, 0xea, 0x7a, 0x27, 0x76, 0xbf, 0x4f, 0x9d, 0x90, 0x04, 0xaa, 0x8d, 0xf9, 0x14, 0x42,
-	0x64, 0x49, 0x82, 0x7c, 0x1f, 0x36, 0x1c, 0xfa, 0xd2, 0x14, 0x01, 0x8b, 0xe9, 0x51, 0xdf, 0x1d,
-	0x7b, 0x3d, 0x6a, 0x0a, 0x65, 0xcf, 0xf5, 0x4c, 0xd9, 0xa1, 0x2f, 0x65, 0x6c, 0x23, 0x10, 0x04,
-	0xa3, 0x1f, 0xc3, 0x2d, 0xdb, 0xf3, 0x28, 0xea, 0x9a, 0x93, 0x01, 0x55, 0x9c, 0x3e, 0x54, 0x43,
-	0x49, 0x63, 0x51, 0xf7, 0xf4, 0xc8, 0xf6, 0xc0, 0xee, 0xd3, 0x17, 0xb6, 0xd3, 0x77, 0x5f, 0xa2,
-	0x9a, 0x9a, 0x1a, 0xa9, 0x74, 0x93, 0x2d, 0xc8, 0x9c, 0x59, 0xfe, 0x91, 0x67, 0xf7, 0x28, 0x06,
-	0x49, 0x42, 0xf3, 0x3e, 0x11, 0x30, 0x23, 0xec, 0x25, 0x35, 0xb8, 0x79, 0xe6, 0xb9, 0xe3, 0x91,
-	0x89, 0xc1, 0x76, 0x24, 0xa0, 0xc2, 0x22, 0x01, 0x11, 0x44, 0x47, 0x87, 0x41, 0x4a, 0x48, 0xff,
-	0x1c, 0x32, 0x92, 0x34, 0xb3, 0xd2, 0xbd, 0xd1, 0xd8, 0xf4, 0xac, 0x80, 0xbb, 0x28, 0x49, 0x23,
-	0xdd, 0x1b, 0x8d, 0x0d, 0x2b, 0xc0, 0xae, 0x21, 0x1d, 0xf2, 0x2e, 0xee, 0xa9, 0xa6, 0x87, 0x74,
-	0x88, 0x5d, 0x1b, 0x90, 0xed, 0xdb, 0xfe, 0x05, 0xef, 0x4b, 0x86, 0x81, 0xd1, 0x85, 0xec, 0x9c,
-	0x9c, 0x52, 0xca, 0x3b, 0xc5, 0xa9, 0x63, 0x00, 0xd6, 0xa9, 0xff, 0xf3, 0x32, 0x14, 0x62, 0x41,
-	0x82, 0xaa, 0xe7, 0xb5, 0xb8, 0x9e, 0x0f, 0xad, 0x06, 0xf7, 0x10, 0x84, 0xd5, 0x58, 0x1c, 0xc0,
-	0xdc, 0x86, 0xcc, 0xc8, 0xa3, 0xe6, 0xb9, 0xe5, 0x9f, 0xe3, 0xbc, 0x79, 0x23, 0x3d, 0xf2, 0xe8,
-	0x53, 0xcb, 0x3f, 0x67, 0x17, 0x61, 0xe4, 0xb9, 0x23, 0xd7, 0xa7, 0xa1, 0x47, 0x21, 0xdb, 0xcc,
-	0x98, 0xa1, 0x5a, 0x12, 0xc6, 0x8c, 0xfd, 0x66, 0xce, 0x81, 0x88, 0xb6, 0xd3, 0xdc, 0x07, 0x18,
-	0x85, 0x91, 0xf6, 0x90, 0x7a, 0x17, 0x03, 0x6a, 0x32, 0x0d, 0x81, 0xe7, 0x32, 0x6f, 0x00, 0x07,
-	0x19, 0xae, 0x1b, 0x28, 0xce, 0x7d, 0x56, 0x75, 0xee, 0xe3, 0xb6, 0x0e, 0xa6, 0x6d, 0xdd, 0xb7,
-	0x98, 0x06, 0x09, 0x6d, 0xbc, 0x5f, 0xce, 0x29, 0x16, 0x48, 0x89, 0x2a, 0x63, 0x48, 0x8c, 0xdd,
-	0x60, 0x62, 0xf2, 0xc0, 0x3d, 0xcf, 0x25, 0x17, 0x4c, 0x6a, 0x78, 0x88, 0xa3, 0x65, 0x06, 0x1e,
-	0xa5, 0xe5, 0x02, 0xf7, 0x39, 0x38, 0xa8, 0xe3, 0x51, 0x14, 0x62, 0x6f, 0xec, 0x75, 0xa8, 0x37,
-	0x2c, 0x97, 0xc4, 0xae, 0xf3, 0x26, 0xb9, 0x07, 0xb9, 0xde, 0xd8, 0xc3, 0xad, 0x69, 0x8e, 0x87,
-	0xe5, 0x55, 0xae, 0xcb, 0x14, 0x10, 0xf9, 0x01, 0xc0, 0xa9, 0x65, 0x0f, 0x98, 0xe6, 0x9f, 0xf8,
-	0x65, 0x82, 0x4b, 0xbd, 0x37, 0x13, 0xfc, 0x6d, 0xef, 0x23, 0x4e, 0x67, 0xe2, 0xd7, 0x9d, 0xc0,
-	0xbb, 0x32, 0xb2, 0xa7, 0xb2, 0x4d, 0xee, 0x00, 0x04, 0x96, 0x77, 0x46, 0x83, 0x5d, 0x3b, 0xf0,
-	0xcb, 0x37, 0x70, 0xe9, 0x0a, 0x84, 0x6c, 0x41, 0xfa, 0x87, 0x63, 0x3f, 0xb0, 0x4f, 0xaf, 0xca,
-	0x37, 0xf1, 0x5c, 0xa3, 0xfd, 0xfe, 0x74, 0xec, 0x7a, 0xe3, 0x61, 0x8d, 0x7a, 0x81, 0x21, 0xbb,
-	0x99, 0x08, 0x6c, 0xc7, 0x44, 0x45, 0x8b, 0x69, 0x8d, 0x8c, 0x91, 0xb6, 0x9d, 0x0e, 0x6b, 0xb2,
-	0x53, 0xe8, 0xd0, 0x49, 0xc0, 0x4f, 0xc3, 0x0a, 0xdf, 0x72, 0x06, 0x60, 0xc7, 0xa1, 0xf2, 0x3d,
-	0x28, 0xc6, 0x97, 0x47, 0x4a, 0x90, 0x64, 0xbb, 0xcd, 0xbd, 0x74, 0xf6, 0x93, 0x9d, 0xbe, 0x4b,
-	0x6b, 0x30, 0x96, 0x11, 0x0d, 0x6f, 0x3c, 0x4e, 0x7c, 0xac, 0xe9, 0xbf, 0xd5, 0x20, 0xb3, 0x5b,
-	0x7b, 0x03, 0x19, 0x0a, 0x1d, 0x96, 0x86, 0x34, 0xb0, 0x44, 0x0c, 0x89, 0x5c, 0x46, 0xa6, 0xc9,
-	0xc0, 0xbe, 0x28, 0xca, 0x5e, 0x7a, 0x75, 0x94, 0xcd, 0x94, 0xc8, 0x58, 0x58, 0x18, 0x11, 0x91,
-	0xa3, 0x12, 0x91, 0x56, 0xc7, 0x08, 0x7b, 0xc9, 0x03, 0x28, 0x9c, 0x78, 0x96, 0xd3, 0x3b, 0x17,
-	0x96, 0x06, 0xd3, 0x3e, 0x59, 0x23, 0x0e, 0xd4, 0xdb, 0x90, 0xdb, 0xad, 0x75, 0xec, 0xd1, 0x35,
-	0xf8, 0xbc, 0x07, 0x79, 0xdb, 0xe7, 0xdb, 0x61, 0x06, 0xf6, 0x48, 0x04, 0x49, 0x60, 0xfb, 0xb8,
-	0x25, 0x1d, 0x7b, 0x84, 0x44, 0x19, 0x7d, 0x54, 0x48, 0xaf, 0x4b, 0x34, 0x87, 0x0c, 0xa2, 0xc6,
-	0xf3, 0xa5, 0x11, 0x54, 0x40, 0xfa, 0x17, 0x09, 0x48, 0xb5, 0x47, 0x94, 0xf6, 0x7d, 0xf2, 0x11,
-	0x64, 0xdb, 0xe3, 0x21, 0x6f, 0xa0, 0xab, 0x9d, 0xdb, 0xb9, 0x8d, 0xfe, 0x0c, 0x42, 0xb6, 0xc3,
-	0x3e, 0x71, 0x26, 0xc3, 0x36, 0xf9, 0x36, 0x64, 0x76, 0x7b, 0x62, 0x1c, 0x8f, 0xca, 0xca, 0xca,
-	0x38, 0xd9, 0xc5, 0x87, 0x85, 0x98, 0xec, 0x1c, 0xc5, 0x49, 0x7e, 0xd9, 0x39, 0xd2, 0x94, 0x73,
-	0x54, 0x69, 0x40, 0x21, 0x46, 0x78, 0xce, 0x60, 0x5d, 0x1d, 0x2c, 0x76, 0x74, 0xb7, 0xc6, 0xc7,
-	0xa8, 0x47, 0xf2, 0x67, 0x78, 0x22, 0x39, 0x2b, 0xdf, 0x82, 0xb4, 0x20, 0xab, 0x4a, 0x40, 0x76,
-	0x4b, 0x5e, 0x38, 0x2b, 0x12, 0xb3, 0xf2, 0x18, 0xf2, 0x6a, 0xc7, 0x75, 0xf8, 0xd0, 0xff, 0x4a,
-	0x83, 0x42, 0xfb, 0xca, 0x0f, 0xe8, 0xf0, 0x3a, 0x91, 0xfb, 0x07, 0x00, 0x27, 0x3d, 0xdf, 0x14,
-	0x29, 0x27, 0x25, 0xeb, 0x25, 0xaf, 0x96, 0x91, 0x3d, 0xe9, 0x29, 0x04, 0x7d, 0xbe, 0x39, 0x4a,
-	0xbe, 0x45, 0x88, 0x41, 0xf4, 0xa0, 0x8e, 0xa7, 0xd4, 0xeb, 0x7a, 0x03, 0x1e, 0xbf, 0x64, 0x8d,
-	0xb0, 0xad, 0x7b, 0x40, 0x62, 0x2b, 0x7c, 0xed, 0x14, 0x0b, 0xf9, 0x18, 0x8a, 0x3e, 0x1f, 0x19,
-	0x2d, 0x35, 0xbc, 0x88, 0x71, 0x9a, 0x05, 0x5f, 0x6d, 0xea, 0x06, 0xdc, 0xac, 0xb9, 0x8e, 0x4f,
-	0x1d, 0x7f, 0x8c, 0x20, 0x61, 0x92, 0xbf, 0x8a, 0xc6, 0xd0, 0x7f, 0xad, 0xc1, 0x4a, 0x8c, 0xe8,
-	0xeb, 0x87, 0xf7, 0xd2, 0xc8, 0x8a, 0xf0, 0x5e, 0x1a, 0xd9, 0x77, 0xa1, 0xd8, 0x93, 0x04, 0x4d,
-	0x9c, 0x91, 0x7b, 0x91, 0x85, 0x10, 0xda, 0x64, 0xaa, 0xea, 0x3e, 0xe4, 0xfd, 0xc0, 0xf2, 0x02,
-	0x35, 0xf6, 0xcd, 0x1a, 0x39, 0x84, 0x09, 0xff, 0xe7, 0x7d, 0x58, 0xb9, 0xb4, 0x06, 0x76, 0x9f,
-	0x85, 0x19, 0x3e, 0xf7, 0xc2, 0x79, 0x26, 0xba, 0x18, 0x81, 0xd1, 0x03, 0xdf, 0x83, 0x94, 0x61,
-	0xbd, 0xec, 0x7a, 0x83, 0xd7, 0x15, 0x85, 0x87, 0xd8, 0x52, 0x14, 0xbc, 0xa5, 0xff, 0x52, 0x83,
-	0x25, 0xa6, 0xdc, 0x16, 0x06, 0xf2, 0xeb, 0x20, 0x22, 0xf7, 0xa9, 0x38, 0xbe, 0x02, 0x99, 0xc0,
-	0xe5, 0x99, 0x73, 0xe1, 0x41, 0x84, 0x6d, 0x26, 0x27, 0x91, 0xa4, 0x90, 0x1e, 0x84, 0xcc, 0x59,
-	0x6c, 0x42, 0x94, 0xa1, 0x40, 0xbe, 0xd4, 0x94, 0x85, 0xfe, 0xef, 0x1a, 0x64, 0xd9, 0x62, 0x78,
-	0xea, 0xe3, 0x2b, 0xe6, 0x67, 0x65, 0x22, 0x26, 0x19, 0x4f, 0xc4, 0x6c, 0x42, 0x96, 0x67, 0x0d,
-	0xa2, 0x47, 0x80, 0x08, 0x80, 0x0e, 0x06, 0xf3, 0xf9, 0x9b, 0xec, 0xde, 0x73, 0xb9, 0x47, 0x00,
-	0xc6, 0xb3, 0xcc, 0xf7, 0x0b, 0x8f, 0x26, 0xca, 0xff, 0x57, 0x20, 0xe3, 0x50, 0xda, 0x3f, 0x64,
-	0x46, 0x26, 0xc3, 0x03, 0x77, 0xd9, 0xd6, 0x7f, 0x0e, 0xc0, 0xd8, 0x12, 0x29, 0x93, 0xd7, 0xe1,
-	0xeb, 0x01, 0x37, 0x43, 0x87, 0x32, 0x60, 0xc9, 0xed, 0x64, 0xa4, 0x19, 0x32, 0xc2, 0x1e, 0x66,
-	0x82, 0x70, 0x71, 0x6d, 0x3a, 0xa0, 0xbd, 0x80, 0xf6, 0xe5, 0xa1, 0x8b, 0x01, 0xf5, 0xbf, 0xd6,
-	0xa0, 0xd8, 0xb4, 0x02, 0xfb, 0x92, 0xd6, 0xdc, 0x3e, 0xdd, 0xa3, 0x7e, 0x8f, 0x39, 0x6b, 0x4a,
-	0x3a, 0x6d, 0x49, 0x8a, 0x6c, 0xc1, 0xe1, 0x5e, 0x87, 0x54, 0xdf, 0x3e, 0xa3, 0x7e, 0x20, 0x36,
-	0x5a, 0xb4, 0x98, 0x4d, 0x19, 0x79, 0xf4, 0xf2, 0xb9, 0x18, 0x25, 0x0e, 0xb3, 0x02, 0x22, 0x5b,
-	0xb0, 0x82, 0xb1, 0x68, 0x75, 0x64, 0x4b, 0x2c, 0xbe, 0xe9, 0xd3, 0x60, 0xb6, 0xc8, 0xfc, 0x0b,
-	0xcb, 0x1f, 0x86, 0x4b, 0x64, 0x67, 0x68, 0xec, 0x30, 0xe7, 0x4e, 0x26, 0x10, 0x45, 0x93, 0xa7,
-	0x48, 0x86, 0x23, 0x7b, 0x40, 0x3d, 0xf9, 0xde, 0x25, 0xdb, 0x0b, 0x97, 0x7a, 0x17, 0x72, 0x97,
-	0x43, 0x33, 0x1c, 0x26, 0x1e, 0x7f, 0x2e, 0x87, 0x35, 0x39, 0xf0, 0x1d, 0x28, 0x84, 0x89, 0x88,
-	0xe0, 0x6a, 0x44, 0xc5, 0xe6, 0xe7, 0x25, 0xb0, 0x73, 0x35, 0xa2, 0xfa, 0x00, 0x4a, 0x91, 0x20,
-	0x85, 0xde, 0x78, 0x4f, 0x24, 0x71, 0xb4, 0x28, 0x1c, 0x8f, 0x0b, 0x5b, 0x24, 0x76, 0xd6, 0xc3,
-	0x77, 0x01, 0xee, 0x87, 0xcb, 0xfc, 0x7f, 0x19, 0xd2, 0xe7, 0xd4, 0x1a, 0x04, 0xe7, 0x57, 0x22,
-	0x61, 0x2e, 0x9b, 0x7a, 0x1b, 0xd6, 0xf6, 0x46, 0xae, 0x5f, 0xb3, 0x9c, 0x3e, 0xbb, 0xf7, 0xd4,
-	0x7f, 0x13, 0xaa, 0xaf, 0x0f, 0xeb, 0xd3, 0x44, 0xfd, 0x11, 0xd3, 0x51, 0xaf, 0x45, 0xf5, 0x3d,
-	0x28, 0xf6, 0xc2, 0x91, 0x4c, 0x0b, 0x09, 0x47, 0x62, 0x0a, 0xaa, 0x7b, 0x50, 0x61, 0xb3, 0x34,
-	0xdd, 0xa1, 0xed, 0x58, 0x01, 0x35, 0x68, 0xcf, 0xf5, 0xfa, 0x6f, 0x62, 0xfd, 0x8b, 0x2f, 0xb6,
-	0xbe, 0x07, 0x25, 0x75, 0x4e, 0xb6, 0x0e, 0x76, 0x9d, 0xc3, 0x95, 0x89, 0x63, 0x14, 0x01, 0xc2,
-	0x24, 0x20, 0x9f, 0x01, 0x7f, 0xeb, 0x7f, 0xac, 0xc1, 0xc6, 0xdc, 0xa5, 0x5f, 0x43, 0x4a, 0x9f,
-	0xc0, 0x8a, 0x13, 0x1f, 0x2e, 0xee, 0xf0, 0x4d, 0x86, 0x3c, 0xbd, 0x48, 0x63, 0x1a, 0x59, 0xff,
-	0x29, 0xdc, 0x0e, 0x91, 0xe8, 0xd7, 0x23, 0xbc, 0x8e, 0xb2, 0x61, 0xf4, 0x77, 0x62, 0x7a, 0x9e,
-	0x30, 0x1d, 0x7e, 0xd8, 0x9e, 0xbb, 0x5f, 0xd3, 0x11, 0xf8, 0x04, 0xe0, 0x32, 0x9c, 0xeb, 0x77,
-	0xd8, 0xfc, 0x97, 0x70, 0x6b, 0x66, 0xbd, 0xd7, 0x10, 0xc1, 0xc7, 0xb0, 0xc2, 0xa6, 0x67, 0x86,
-	0x2e, 0xbe, 0xef, 0x18, 0x93, 0x44, 0x2b, 0x33, 0xa6, 0xd1, 0x74, 0x37, 0x9a, 0xb8, 0xff, 0xb5,
-	0x48, 0xea, 0x23, 0xc8, 0x5d, 0x46, 0x93, 0xa1, 0x57, 0xea, 0x06, 0x62, 0x0e, 0x16, 0xa5, 0xb1,
-	0xc6, 0x5c, 0x11, 0xfd, 0x0c, 0xca, 0xb3, 0x2b, 0xbd, 0x86, 0x8c, 0xbe, 0x0b, 0x25, 0x9c, 0x78,
-	0x56, 0x48, 0x2b, 0x52, 0x48, 0x02, 0x6e, 0xcc, 0x20, 0xea, 0x36, 0x17, 0x53, 0xed, 0x9c, 0xf6,
-	0x2e, 0x0c, 0xea, 0x8f, 0x07, 0xc1, 0x1b, 0x11, 0x13, 0xe3, 0x93, 0xc5, 0xf0, 0x3c, 0x05, 0x83,
-	0xbf, 0xf5, 0x80, 0xf3, 0x19, 0x9f, 0xea, 0x9a, 0xd7, 0x81, 0xd1, 0x4c, 0x44, 0x34, 0x31, 0x29,
-	0x10, 0xd1, 0xc3, 0x87, 0x84, 0xac, 0xa1, 0x82, 0xf4, 0x16, 0xac, 0xb2, 0x59, 0xa5, 0x77, 0xfd,
-	0xd5, 0xd5, 0xfd, 0x8f, 0x81, 0xa8, 0x04, 0xaf, 0xa5, 0xea, 0x53, 0x31, 0x4f, 0xbd, 0x28, 0x75,
-	0x57, 0xfc, 0xfd, 0x5a, 0xff, 0x95, 0x06, 0x10, 0x81, 0x43, 0xbe, 0x35, 0x85, 0xef, 0x0d, 0xc8,
-	0xf2, 0x8c, 0xa7, 0x33, 0x96, 0x02, 0xc9, 0x9c, 0xc8, 0x3c, 0x88, 0x9a, 0x53, 0x12, 0x25, 0x1b,
-	0x61, 0x4e, 0xe9, 0x3e, 0xe4, 0xe5, 0x6f, 0x1c, 0xcb, 0xd3, 0x60, 0x39, 0x09, 0x63, 0xc3, 0xa7,
-	0x64, 0xba, 0x3c, 0x2b, 0xd3, 0x7f, 0xd2, 0xa0, 0x24, 0xb2, 0x79, 0x47, 0xb5, 0x37, 0x71, 0x5c,
-	0xbe, 0x09, 0x99, 0xf0, 0xa9, 0x22, 0xb9, 0x28, 0x29, 0x1b, 0xa2, 0xc4, 0x9f, 0x28, 0x96, 0xbe,
-	0xec, 0x89, 0x62, 0x79, 0xe6, 0x89, 0x42, 0xff, 0x23, 0x58, 0x55, 0xd6, 0x7f, 0x8d, 0x2d, 0x5c,
-	0xc4, 0xc0, 0x36, 0x63, 0x80, 0xd3, 0x11, 0x81, 0x20, 0x51, 0x19, 0xe0, 0x3d, 0x46, 0x88, 0xa3,
-	0xff, 0x7d, 0x02, 0x0a, 0x31, 0xee, 0x30, 0x33, 0xe6, 0xf6, 0xc7, 0x03, 0x6a, 0x2a, 0x6e, 0x24,
-	0x70, 0x10, 0x06, 0x3a, 0xaa, 0x3b, 0xa5, 0xac, 0x20, 0x74, 0xa7, 0x10, 0x09, 0xf3, 0x6b, 0xc1,
-	0xb9, 0xdb, 0x57, 0x23, 0x26, 0xe0, 0x20, 0x44, 0x78, 0x04, 0x4b, 0x96, 0x77, 0x26, 0xdf, 0xd1,
-	0x36, 0x66, 0xa4, 0xbc, 0x5d, 0xf5, 0xce, 0x44, 0x36, 0x01, 0x11, 0xc9, 0x63, 0x58, 0x09, 0x33,
-	0xd5, 0x03, 0x7b, 0x68, 0x07, 0x3e, 0x0a, 0x54, 0xec, 0x90, 0xcc, 0x51, 0x1f, 0xb2, 0x1e, 0xa3,
-	0xe8, 0xa9, 0x4d, 0x7f, 0xea, 0x49, 0x34, 0x2c, 0x6a, 0xaa, 0x7c, 0x04, 0xd9, 0x70, 0x9a, 0x2f,
-	0x0b, 0xe8, 0xf3, 0x6a, 0x40, 0xff, 0x9f, 0x09, 0x28, 0xc6, 0x65, 0xca, 0x2e, 0x95, 0x78, 0x45,
-	0xd4, 0xe6, 0x3e, 0xa9, 0x89, 0x5e, 0xf2, 0x0d, 0x48, 0xcb, 0x37, 0xc4, 0xc4, 0xfc, 0x67, 0x34,
-	0xd9, 0xcf, 0xee, 0x8f, 0xb2, 0x99, 0x49, 0x16, 0x93, 0xc8, 0x36, 0xb9, 0x8d, 0x19, 0x71, 0x73,
-	0xec, 0xd3, 0xbe, 0xb8, 0x3b, 0xe9, 0x33, 0xcb, 0xef, 0xfa, 0xb4, 0x1f, 0x3b, 0xc4, 0xcb, 0x5f,
-	0x7e, 0x88, 0x77, 0x58, 0xdc, 0xc6, 0xa9, 0xfa, 0xa2, 0xbe, 0x09, 0x9d, 0x99, 0x5a, 0xf8, 0x20,
-	0x27, 0x4e, 0x4d, 0x84, 0x46, 0x3e, 0xe0, 0xaf, 0x2a, 0xfc, 0x8d, 0x50, 0x3c, 0x5f, 0xc4, 0x9e,
-	0x4d, 0x95, 0x6e, 0xb2, 0x0d, 0xb9, 0x71, 0x18, 0x22, 0xf9, 0xe5, 0xcc, 0x9c, 0x97, 0x53, 0x15,
-	0x41, 0x1f, 0x01, 0x44, 0x72, 0xc3, 0x93, 0x3e, 0xee, 0x5d, 0xd0, 0x20, 0x2c, 0x10, 0xc0, 0x96,
-	0xdc, 0x2e, 0xbe, 0x35, 0xb8, 0x5d, 0xea, 0x7b, 0x7a, 0xf2, 0x55, 0xef, 0xe9, 0x4b, 0xd3, 0xc1,
-	0xe9, 0x33, 0xc8, 0x29, 0x1b, 0x70, 0x8d, 0x29, 0xc3, 0x13, 0x92, 0x54, 0x4e, 0x88, 0x5e, 0x85,
-	0x42, 0xec, 0x79, 0x90, 0xe9, 0x89, 0x23, 0xf9, 0x9c, 0x2d, 0xdd, 0x95, 0x10, 0xc0, 0xf4, 0x2a,
-	0x86, 0x9d, 0x9c, 0x2e, 0xfe, 0xd6, 0x7f, 0x04, 0x2b, 0x47, 0xd4, 0x1b, 0xda, 0x3e, 0x8b, 0xa0,
-	0x9e, 0xb9, 0x7d, 0x3a, 0x60, 0xd1, 0x88, 0x37, 0x1e, 0xf0, 0x1b, 0x59, 0xe4, 0xd7, 0x3a, 0x42,
-	0x31, 0xc6, 0x03, 0x6a, 0x60, 0x3f, 0x53, 0x9b, 0x56, 0xaf, 0x47, 0x47, 0xc1, 0x73, 0x25, 0x19,
-	0xa5, 0x82, 0xf4, 0xdb, 0xb0, 0x5c, 0xbd, 0x68, 0x73, 0x86, 0xac, 0x0b, 0x7e, 0x60, 0xb3, 0x06,
-	0xfb, 0xa9, 0xff, 0x85, 0x06, 0x29, 0xec, 0xf3, 0xc9, 0x16, 0x2c, 0xf9, 0x34, 0x3c, 0xce, 0x78,
-	0x24, 0x78, 0xcf, 0x36, 0xfb, 0x47, 0x5c, 0x4d, 0x86, 0x41, 0xee, 0x00, 0xd0, 0xc9, 0x88, 0x39,
-	0x1f, 0x51, 0x84, 0xa9, 0x40, 0x2a, 0xbb, 0x90, 0x0d, 0x87, 0xcc, 0xb9, 0x66, 0x77, 0xe3, 0x29,
-	0xbc, 0x6c, 0x38, 0x93, 0x7a, 0xe3, 0x7e, 0xad, 0x41, 0xb2, 0xda, 0x1b, 0x90, 0x77, 0x20, 0x31,
-	0x1a, 0x0a, 0xc5, 0x78, 0x23, 0x2e, 0x03, 0x14, 0x93, 0x91, 0x18, 0x0d, 0xc9, 0xb7, 0x21, 0x6b,
-	0x5d, 0xf8, 0x2f, 0x64, 0x0d, 0x51, 0x58, 0x96, 0x51, 0xed, 0x0d, 0xb6, 0xab, 0xb2, 0x43, 0x64,
-	0x38, 0x43, 0x44, 0xa6, 0x77, 0x2d, 0x64, 0x50, 0x4d, 0xa1, 0x71, 0x96, 0x0d, 0xd1, 0x53, 0xf9,
-	0x1e, 0x14, 0xe3, 0x04, 0xae, 0x95, 0x07, 0xfc, 0x6f, 0x0d, 0xb2, 0xd5, 0xde, 0xe0, 0x0d, 0x24,
-	0xc6, 0xf9, 0x26, 0x33, 0x25, 0xd6, 0x8c, 0xf4, 0xab, 0x0a, 0x22, 0x3a, 0xc4, 0x34, 0xb2, 0x30,
-	0x4f, 0x71, 0x2d, 0x7d, 0x07, 0x14, 0x95, 0x2c, 0xab, 0x22, 0x15, 0x25, 0xcd, 0xdc, 0x6c, 0xfe,
-	0xcc, 0x49, 0xfb, 0xa8, 0x3a, 0x33, 0x46, 0x04, 0x20, 0xb7, 0x21, 0x69, 0xf5, 0x06, 0xa2, 0xc0,
-	0x2f, 0x2d, 0xe4, 0x6b, 0x30, 0x98, 0xfe, 0x27, 0x1a, 0xe4, 0x1b, 0x7d, 0xea, 0x04, 0x76, 0x70,
-	0x55, 0x1d, 0x07, 0xe7, 0xe1, 0x13, 0x92, 0x36, 0xf7, 0x09, 0x29, 0x11, 0x7b, 0x42, 0x22, 0xb0,
-	0xa4, 0x54, 0x79, 0xe2, 0x6f, 0xc4, 0xa5, 0xd4, 0x6b, 0xec, 0x09, 0x3e, 0x44, 0x2b, 0xfe, 0x6a,
-	0x24, 0x93, 0x3a, 0x12, 0xa0, 0x7f, 0x07, 0x0a, 0xea, 0x2a, 0x7c, 0xf2, 0x00, 0x96, 0x98, 0xf9,
-	0x15, 0x67, 0xba, 0x84, 0x6a, 0x51, 0x41, 0x30, 0xb0, 0x57, 0x3f, 0x80, 0x42, 0xcc, 0x9e, 0xb0,
-	0x61, 0x98, 0x38, 0xe0, 0x57, 0xaf, 0xa4, 0x1a, 0x9c, 0xce, 0xd5, 0x88, 0x1a, 0xd8, 0x8b, 0x35,
-	0xbc, 0x0c, 0x5d, 0xf8, 0x41, 0xbc, 0xa1, 0xdb, 0xb0, 0x5a, 0x3d, 0xd8, 0x09, 0x9f, 0x52, 0x7f,
-	0x9f, 0x9e, 0xff, 0x4f, 0x80, 0xa8, 0x53, 0xbd, 0x01, 0x77, 0xa2, 0x1c, 0x55, 0xbe, 0x72, 0x97,
-	0x56, 0x36, 0x75, 0x0f, 0x2a, 0x4f, 0x68, 0x20, 0xe6, 0x0a, 0x5f, 0xa7, 0xdf, 0x14, 0x7f, 0xe1,
-	0x9c, 0x9a, 0x3a, 0xe7, 0x17, 0x1a, 0x6c, 0xcc, 0x9d, 0xf4, 0x1a, 0x9c, 0x7e, 0x1f, 0xc2, 0x4a,
-	0x93, 0xa9, 0xd4, 0x3a, 0x51, 0x8d, 0x9e, 0xf0, 0x84, 0x57, 0x42, 0x5c, 0x91, 0xb2, 0xfe, 0x3b,
-	0x0d, 0x8a, 0x71, 0x9c, 0x59, 0x7f, 0x48, 0x9b, 0x73, 0xd3, 0xe6, 0xc4, 0x5b, 0x61, 0x8d, 0x50,
-	0x52, 0xa9, 0x11, 0xda, 0x80, 0xac, 0xed, 0x9b, 0x27, 0x96, 0xe3, 0x08, 0xbb, 0x8e, 0x25, 0x74,
-	0xbb, 0xd8, 0x9e, 0x3d, 0xec, 0xd3, 0xe5, 0x40, 0x32, 0xab, 0x96, 0x8a, 0x65, 0xd5, 0xf4, 0x3f,
-	0x4d, 0xc0, 0xe6, 0x91, 0x47, 0xeb, 0x13, 0xda, 0x7b, 0x61, 0x07, 0xe7, 0x3c, 0x7b, 0xd8, 0xed,
-	0x1c, 0xb7, 0x7e, 0xaf, 0xc7, 0x91, 0xe9, 0x28, 0xcc, 0x56, 0x8a, 0xca, 0x09, 0xe1, 0xe1, 0x2b,
-	0x20, 0xe6, 0xa9, 0x30, 0x4d, 0x80, 0xd9, 0xa6, 0x94, 0xf2, 0x68, 0x10, 0xab, 0xad, 0x09, 0x51,
-	0x62, 0x79, 0xd8, 0x74, 0x3c, 0x0f, 0x4b, 0xb6, 0x21, 0x2d, 0x3c, 0x1a, 0xf1, 0xb6, 0x77, 0x53,
-	0xf1, 0x79, 0xc2, 0xe0, 0xc0, 0x90, 0x48, 0xfa, 0x3f, 0x6a, 0xf0, 0xf6, 0x02, 0x99, 0x7c, 0xfd,
-	0x6e, 0x38, 0xd9, 0xe6, 0xfe, 0x14, 0x77, 0x41, 0xc4, 0x43, 0x66, 0x51, 0x66, 0x85, 0x85, 0x8f,
-	0xa4, 0x60, 0xe8, 0xc7, 0x50, 0x9a, 0x76, 0xcf, 0x94, 0x2c, 0xa4, 0x36, 0x9d, 0x85, 0x1c, 0x52,
-	0xdf, 0xb7, 0xce, 0xc2, 0xd2, 0x53, 0xd1, 0x64, 0x07, 0xf0, 0xc4, 0xed, 0xcb, 0x1c, 0x3f, 0xfe,
-	0xd6, 0xff, 0x46, 0x83, 0x9c, 0x52, 0x3e, 0x44, 0xde, 0x85, 0x22, 0x3d, 0x3d, 0xa5, 0xbd, 0xc0,
-	0xbe, 0xa4, 0x51, 0xa9, 0x62, 0xd6, 0x28, 0x84, 0xd0, 0x8e, 0x28, 0xdb, 0x1f, 0x5a, 0xde, 0x05,
-	0xed, 0x8b, 0x27, 0x4d, 0xd1, 0x22, 0xdf, 0x80, 0x52, 0x34, 0x3c, 0x56, 0xfd, 0xb3, 0x12, 0xc2,
-	0xc5, 0xeb, 0xc8, 0xdb, 0x00, 0x51, 0x19, 0x60, 0x3c, 0x7d, 0x2f, 0xbc, 0x24, 0xb4, 0x20, 0x5c,
-	0xc9, 0xe3, 0x6f, 0xfd, 0x53, 0x10, 0x35, 0x4b, 0x44, 0x87, 0xc2, 0x79, 0xdf, 0x54, 0xc6, 0x8b,
-	0x32, 0xa5, 0xf3, 0x7e, 0xe4, 0x67, 0xbd, 0x03, 0x05, 0xd7, 0xb3, 0xcf, 0x6c, 0xc7, 0x1a, 0xf0,
-	0x47, 0x6f, 0x6e, 0x76, 0xf2, 0x12, 0xf8, 0xd4, 0xf2, 0xcf, 0xf5, 0x7f, 0x49, 0x40, 0x09, 0x53,
-	0xf1, 0x98, 0x97, 0x10, 0x15, 0xaf, 0xbf, 0x5f, 0x4b, 0xfd, 0xff, 0xa0, 0xe8, 0x8e, 0xa8, 0x13,
-	0xcd, 0x3a, 0x7d, 0x00, 0x44, 0xd2, 0x64, 0x0a, 0x8b, 0x3c, 0x86, 0x12, 0xdb, 0x22, 0xda, 0x57,
-	0x46, 0x2e, 0xcf, 0x1d, 0x39, 0x83, 0xc7, 0xc6, 0xf2, 0xaa, 0x4c, 0x65, 0x6c, 0x6a, 0xfe, 0xd8,
-	0x69, 0x3c, 0xe6, 0x59, 0xf4, 0x6d, 0x7f, 0x34, 0xb0, 0xae, 0xb0, 0x96, 0x42, 0xd6, 0x91, 0xaa,
-	0x30, 0xfd, 0x82, 0x3f, 0x8b, 0x44, 0xe9, 0x3a, 0x76, 0x78, 0x6b, 0xe1, 0x1b, 0x94, 0xa8, 0xc1,
-	0xe2, 0xb5, 0x18, 0x77, 0xf8, 0xe1, 0xaf, 0xaa, 0x9f, 0x9d, 0x28, 0x10, 0x72, 0x17, 0x96, 0xec,
-	0x80, 0x0e, 0xd5, 0xea, 0x4c, 0x46, 0xfb, 0x80, 0x5e, 0x19, 0xd8, 0xa1, 0xb7, 0x21, 0x2d, 0x00,
-	0xea, 0xf3, 0x94, 0x7c, 0x5a, 0x10, 0x21, 0xc0, 0x3a, 0xa4, 0x94, 0x72, 0xda, 0xac, 0x21, 0x5a,
-	0x4a, 0x6c, 0x98, 0x54, 0x63, 0x43, 0xbd, 0x0b, 0xb7, 0x54, 0x45, 0xbf, 0x67, 0x05, 0xd6, 0x9b,
-	0xc8, 0xda, 0x7c, 0xa1, 0x41, 0x79, 0x96, 0xee, 0x1b, 0x50, 0x39, 0x5b, 0xb0, 0xd4, 0xb7, 0xc2,
-	0x52, 0x89, 0x9b, 0xd3, 0xc6, 0x0c, 0xe7, 0x41, 0x0c, 0xfd, 0x0f, 0x22, 0xe5, 0x21, 0x7b, 0xd8,
-	0x9e, 0x5a, 0xd2, 0xac, 0xca, 0x4d, 0x4a, 0x1a, 0x31, 0x18, 0x79, 0x10, 0x19, 0xba, 0x5a, 0xb8,
-	0x55, 0x49, 0x23, 0x0e, 0xd4, 0xff, 0x4c, 0x83, 0x5b, 0xa2, 0xc8, 0xfa, 0x8d, 0xbb, 0x05, 0xf3,
-	0xed, 0xcc, 0xf4, 0xc7, 0x09, 0x4b, 0xb3, 0x1f, 0x27, 0x1c, 0x40, 0x5e, 0x2e, 0x06, 0x5f, 0xd7,
-	0xbe, 0x0b, 0xa1, 0x65, 0x37, 0x43, 0xa5, 0xb9, 0xc8, 0x09, 0x28, 0xf6, 0x62, 0x6d, 0xfd, 0x3f,
-	0x34, 0x28, 0xcf, 0x72, 0x78, 0x8d, 0x2d, 0x6c, 0xa0, 0x5b, 0x2d, 0x6a, 0xff, 0xb8, 0xf3, 0xf1,
-	0x01, 0xba, 0xcf, 0x0b, 0x88, 0x86, 0x0b, 0x92, 0x55, 0x19, 0xe1, 0xe8, 0x4a, 0x33, 0x72, 0x47,
-	0x16, 0xc6, 0x57, 0xef, 0xc5, 0xe3, 0xab, 0x92, 0xca, 0x22, 0x93, 0x86, 0x1a, 0xa1, 0xfc, 0x83,
-	0x06, 0xab, 0x35, 0xcf, 0xf5, 0xfd, 0x4f, 0xc7, 0xd4, 0xbb, 0x92, 0xfb, 0xb6, 0xa8, 0x48, 0x3f,
-	0xe6, 0x90, 0x24, 0xa6, 0x1d, 0x92, 0x58, 0x76, 0x2c, 0xf9, 0x65, 0xd9, 0xb1, 0xa5, 0xd9, 0x02,
-	0xde, 0x0f, 0xa6, 0x6d, 0xfa, 0x9c, 0x3c, 0x46, 0x68, 0xd0, 0xf7, 0x81, 0xa8, 0x0b, 0x17, 0xdb,
-	0xf1, 0x7f, 0x15, 0x43, 0xac, 0xcd, 0xde, 0x8c, 0x39, 0x19, 0x31, 0x26, 0x51, 0x46, 0x07, 0x0b,
-	0x70, 0xb0, 0x1a, 0x88, 0x28, 0xde, 0x7f, 0x56, 0xf8, 0xfa, 0x5b, 0x50, 0x1a, 0xda, 0x8e, 0x49,
-	0x9d, 0xbe, 0xeb, 0xf9, 0xae, 0xa7, 0xa4, 0x3f, 0x8b, 0x43, 0xdb, 0xa9, 0x0b, 0x70, 0x73, 0x3c,
-	0xd4, 0x9f, 0x43, 0x01, 0xe9, 0x49, 0xd8, 0x2b, 0xbe, 0xbd, 0xbb, 0x05, 0xe9, 0xd1, 0xf8, 0xc4,
-	0x94, 0x11, 0x51, 0x16, 0x23, 0x22, 0x61, 0xfb, 0xce, 0x5d, 0x5f, 0x6a, 0x28, 0xfc, 0xad, 0x07,
-	0x62, 0x9d, 0xc8, 0x2f, 0xae, 0xf3, 0x43, 0x00, 0x5e, 0xf4, 0x88, 0x25, 0x53, 0xca, 0xa3, 0x65,
-	0x9c, 0x1f, 0x23, 0xdb, 0x0b, 0x59, 0x7b, 0x04, 0x59, 0xc9, 0x82, 0x3c, 0x89, 0xab, 0xe1, 0x08,
-	0xb9, 0x62, 0x23, 0xc2, 0xd1, 0x7f, 0xa5, 0xa9, 0xd3, 0xa2, 0xe9, 0x7d, 0x14, 0xed, 0x12, 0x9f,
-	0x73, 0x2d, 0xa4, 0xa0, 0x1e, 0xa2, 0x70, 0xa7, 0xc8, 0x8e, 0xb2, 0x27, 0xfc, 0x48, 0xae, 0x4f,
-	0x8f, 0x98, 0x71, 0x90, 0xde, 0x87, 0x65, 0x5e, 0x82, 0x9d, 0x5c, 0x54, 0x82, 0xcd, 0xfb, 0xf5,
-	0x36, 0x14, 0xe4, 0xe6, 0xd6, 0x2f, 0xa9, 0x13, 0xf0, 0x27, 0x65, 0x51, 0x04, 0xaa, 0xc9, 0x27,
-	0x65, 0x51, 0x09, 0x2b, 0xdf, 0xca, 0x13, 0xca, 0x5b, 0xf9, 0x1c, 0xa7, 0xe8, 0xe1, 0xdf, 0xa6,
-	0x60, 0x65, 0xea, 0x9b, 0x12, 0x92, 0x83, 0x74, 0xbb, 0x5b, 0xab, 0xd5, 0xdb, 0xed, 0xd2, 0x5b,
-	0xa4, 0x04, 0xf9, 0x6e, 0xf3, 0xa0, 0xd9, 0x7a, 0x61, 0xf2, 0xef, 0xb6, 0x34, 0x42, 0xa0, 0x58,
-	0x6b, 0x35, 0x9b, 0xf5, 0x5a, 0xc7, 0x34, 0xea, 0xfb, 0xdd, 0x76, 0xbd, 0x94, 0x20, 0xb7, 0x61,
-	0xad, 0xd9, 0xea, 0x98, 0xf5, 0x66, 0xab, 0xfb, 0xe4, 0xa9, 0xc9, 0x9c, 0x4d, 0x81, 0x9e, 0x24,
-	0x3a, 0xdc, 0x61, 0xed, 0xe7, 0xcf, 0xcc, 0xea, 0xa1, 0x51, 0xaf, 0xee, 0x7d, 0x66, 0x76, 0x9b,
-	0xb5, 0x56, 0x73, 0xbf, 0x61, 0x3c, 0x13, 0x38, 0x4b, 0xa4, 0x02, 0xeb, 0x02, 0x87, 0x51, 0xd9,
-	0x6f, 0x75, 0x9b, 0x7b, 0xa2, 0x6f, 0x99, 0xdc, 0x83, 0xcd, 0x46, 0xf3, 0xa8, 0xdb, 0x31, 0x5b,
-	0xdd, 0x0e, 0xfb, 0x83, 0xf3, 0x7c, 0xda, 0xad, 0x1e, 0x0a, 0x8c, 0x14, 0x59, 0x07, 0xd2, 0x39,
-	0x9e, 0x19, 0x99, 0x26, 0xab, 0x50, 0xe8, 0x1c, 0x9b, 0xed, 0xc6, 0x93, 0xa6, 0x00, 0x65, 0xc8,
-	0x2d, 0xb8, 0xb1, 0x7b, 0xd8, 0xaa, 0x1d, 0xd4, 0x9e, 0x56, 0x1b, 0x4d, 0x36, 0x84, 0x7f, 0x68,
-	0x96, 0x65, 0x4c, 0x3d, 0xaf, 0x1e, 0x36, 0xf6, 0xaa, 0x9d, 0xba, 0x40, 0x06, 0xb2, 0x01, 0xb7,
-	0x6a, 0xd5, 0x26, 0xa3, 0xdb, 0xfe, 0xac, 0x59, 0x33, 0x71, 0xa0, 0xe8, 0xcc, 0x31, 0x4a, 0x92,
-	0x0b, 0xb5, 0x23, 0x4f, 0xd6, 0x60, 0x55, 0xf0, 0x72, 0x74, 0x58, 0xfd, 0x4c, 0x80, 0x0b, 0xa4,
-	0x08, 0xf0, 0xa2, 0x7a, 0x28, 0xd1, 0x8a, 0xe4, 0x06, 0xac, 0x30, 0xca, 0x5c, 0x22, 0x1c, 0xb8,
-	0xc2, 0xc6, 0x0a, 0x62, 0x6c, 0x59, 0x02, 0x5c, 0x62, 0xe2, 0x31, 0x5a, 0xad, 0x8e, 0x39, 0xdb,
-	0xb7, 0x2a, 0x98, 0xdf, 0xeb, 0x1e, 0x1d, 0x36, 0x6a, 0xd1, 0xe2, 0x6f, 0xb0, 0x1d, 0x69, 0xd7,
-	0x8d, 0xe7, 0x8d, 0x5a, 0x5d, 0xec, 0x92, 0x94, 0xcb, 0x4d, 0x36, 0x4b, 0xe7, 0x78, 0xaf, 0xda,
-	0xa9, 0xaa, 0xb2, 0x59, 0x63, 0x3b, 0xcd, 0xc4, 0x75, 0x28, 0x69, 0xdc, 0x66, 0x02, 0xe8, 0x1c,
-	0x9b, 0xfb, 0xf5, 0xba, 0xa9, 0x6c, 0x2e, 0xef, 0xac, 0x30, 0x06, 0x70, 0x9f, 0x15, 0x1a, 0x9b,
-	0xe4, 0x26, 0x94, 0xf6, 0x8e, 0x5a, 0x6d, 0xf3, 0xd3, 0x6e, 0xdd, 0x90, 0x6c, 0xdd, 0x65, 0xb2,
-	0x32, 0x5e, 0xb4, 0xeb, 0x1d, 0xb3, 0xd1, 0x44, 0x21, 0x8b, 0x8e, 0xfb, 0xbc, 0xa3, 0x5a, 0x3b,
-	0x9c, 0xea, 0xd0, 0x49, 0x19, 0x6e, 0x3e, 0xa9, 0xb6, 0x67, 0xa7, 0x7d, 0x87, 0x6c, 0x42, 0xb9,
-	0x73, 0x6c, 0x3e, 0xaf, 0x1b, 0xed, 0x46, 0xab, 0x39, 0x35, 0xee, 0x01, 0xb9, 0x0f, 0x6f, 0xd7,
-	0x5a, 0xcf, 0x8e, 0x0e, 0x1b, 0xd5, 0x66, 0xad, 0x6e, 0xd6, 0x9e, 0xd6, 0x6b, 0x07, 0x48, 0xa4,
-	0x7a, 0x74, 0x64, 0xb4, 0x9e, 0xd7, 0xf7, 0x4a, 0xef, 0x32, 0x94, 0x6a, 0xad, 0xd6, 0xea, 0x36,
-	0x3b, 0x66, 0xad, 0xd5, 0xec, 0x18, 0xd5, 0x5a, 0xc7, 0x6c, 0x77, 0xaa, 0x9d, 0x6e, 0x5b, 0x50,
-	0x79, 0x8f, 0xc9, 0x8e, 0xcf, 0xd1, 0xd8, 0x67, 0x42, 0x65, 0x13, 0xf1, 0xae, 0xad, 0x87, 0x14,
-	0x56, 0x67, 0x3e, 0x19, 0x25, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd, 0x46, 0xb3, 0x5e, 0x7a,
-	0x4b, 0xfd, 0x80, 0x51, 0x63, 0x0d, 0x71, 0x4c, 0x4a, 0x09, 0x52, 0x80, 0xec, 0x7e, 0xd7, 0xe0,
-	0x14, 0x4b, 0x49, 0xd6, 0x0c, 0xaf, 0x42, 0x69, 0x89, 0x00, 0xa4, 0xf6, 0xab, 0x8d, 0xc3, 0xfa,
-	0x5e, 0x69, 0xf9, 0xe1, 0x01, 0x40, 0xf4, 0x55, 0x1e, 0xc9, 0xc0, 0x52, 0xb3, 0x85, 0xb4, 0x01,
-	0x52, 0x87, 0xf5, 0xbd, 0x27, 0x75, 0x76, 0x0f, 0xd9, 0xac, 0x9d, 0xe3, 0x56, 0xa3, 0xb9, 0xdf,
-	0x2a, 0x25, 0xd8, 0xf9, 0xe2, 0x9f, 0x50, 0x62, 0x3b, 0x49, 0xb2, 0xb0, 0x7c, 0x54, 0xaf, 0x1b,
-	0xed, 0xd2, 0xd2, 0xc3, 0x3f, 0x84, 0x62, 0x3c, 0x9d, 0x8a, 0x04, 0xbb, 0x87, 0x87, 0xa5, 0xb7,
-	0xd8, 0xb9, 0xc7, 0x0d, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7, 0xad, 0xc3, 0xbd, 0x92, 0xc6, 0x48,
-	0x21, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0xf8, 0xb2, 0xb1, 0x6d, 0x54, 0x3b, 0xf5, 0x52, 0x92, 0xcd,
-	0x8b, 0xcd, 0x76, 0x97, 0xad, 0xba, 0x00, 0xd9, 0x5a, 0xd5, 0x64, 0x47, 0xad, 0xce, 0x6e, 0x2b,
-	0x2a, 0x87, 0x67, 0xcf, 0xba, 0xcd, 0x46, 0xe7, 0x33, 0xf3, 0x79, 0xab, 0x53, 0x2f, 0xa5, 0x1e,
-	0x7e, 0x04, 0x79, 0x35, 0xa7, 0x44, 0xd2, 0x90, 0xac, 0x1d, 0x75, 0x39, 0x37, 0xcf, 0xea, 0xcf,
-	0x5a, 0xc6, 0x67, 0x25, 0x8d, 0x2d, 0x69, 0xaf, 0xd1, 0x3e, 0x28, 0x25, 0xd8, 0xaf, 0xe3, 0xfd,
-	0x7a, 0xbd, 0x94, 0xdc, 0xf9, 0xcd, 0x0d, 0x48, 0x1d, 0xa3, 0x4a, 0x27, 0x5d, 0x28, 0x45, 0x81,
-	0xec, 0xee, 0x15, 0x7e, 0x71, 0x50, 0x90, 0xfe, 0x32, 0x66, 0xd4, 0x2b, 0x53, 0x51, 0xa5, 0xae,
-	0xff, 0xe2, 0xdf, 0xfe, 0xeb, 0xcf, 0x13, 0x9b, 0xfa, 0xad, 0x47, 0x97, 0x1f, 0x3e, 0xf2, 0x71,
-	0xb0, 0x89, 0x1f, 0x4c, 0x9c, 0x5c, 0xe1, 0x57, 0x0c, 0x8f, 0xb5, 0x87, 0xe4, 0x07, 0x90, 0x3a,
-	0x72, 0xfd, 0xa0, 0x33, 0x21, 0xb1, 0x8f, 0x6e, 0x2b, 0x2b, 0xdc, 0x94, 0x86, 0x5f, 0x64, 0xea,
-	0xeb, 0x48, 0xac, 0xa4, 0xe7, 0x18, 0xb1, 0x91, 0xeb, 0x07, 0x66, 0x30, 0x61, 0x04, 0x76, 0x21,
-	0x83, 0x8a, 0xbd, 0x5a, 0x3b, 0xe4, 0xeb, 0x09, 0x93, 0xa0, 0x95, 0x78, 0x53, 0x2f, 0x23, 0x05,
-	0xa2, 0x17, 0x18, 0x85, 0x9f, 0xb2, 0x31, 0xa6, 0xd5, 0x1b, 0x30, 0x1a, 0x26, 0xac, 0x20, 0x0d,
-	0x25, 0xac, 0xb8, 0x19, 0x0f, 0x55, 0x78, 0xb0, 0x56, 0x99, 0x0b, 0xd5, 0xef, 0x21, 0xe1, 0x8a,
-	0xbe, 0x16, 0x11, 0x46, 0x36, 0x3d, 0x44, 0x62, 0x13, 0xfc, 0x0c, 0xd6, 0x70, 0x82, 0x19, 0xdf,
-	0x78, 0x63, 0xae, 0x2f, 0xcd, 0x8d, 0x59, 0x65, 0x73, 0x7e, 0xa7, 0x70, 0x26, 0xde, 0xc7, 0x59,
-	0xef, 0xeb, 0x9b, 0xd1, 0xac, 0x31, 0xbf, 0xd3, 0x64, 0x0e, 0x39, 0x9b, 0xfc, 0xe7, 0x70, 0x63,
-	0x4e, 0x66, 0x8b, 0xdc, 0xc1, 0xaf, 0x1c, 0x16, 0xe6, 0xd9, 0x2a, 0x77, 0x17, 0xf6, 0x8b, 0x05,
-	0x3c, 0xc0, 0x05, 0xdc, 0xd1, 0x6f, 0xb3, 0x05, 0x9c, 0xd1, 0x20, 0xfc, 0xea, 0x23, 0x74, 0x21,
-	0xd9, 0xec, 0x9f, 0x40, 0x1a, 0x59, 0x9f, 0xd9, 0xe1, 0x58, 0x4b, 0xbf, 0x85, 0xc4, 0x56, 0xf5,
-	0x7c, 0xc4, 0x0d, 0xdf, 0xdf, 0x26, 0xc0, 0x13, 0x1a, 0x88, 0x6f, 0x2a, 0xc9, 0xaa, 0xe2, 0xcb,
-	0x0a, 0x3a, 0xb3, 0x20, 0xbd, 0x82, 0xc4, 0x6e, 0xea, 0x2b, 0x72, 0x65, 0xe2, 0x23, 0x52, 0x46,
-	0xcf, 0x86, 0x52, 0x44, 0x4f, 0x7e, 0x75, 0xaa, 0x90, 0x88, 0x7d, 0xbd, 0x59, 0x59, 0xd8, 0xa3,
-	0xdf, 0xc7, 0x39, 0x36, 0xf4, 0xf5, 0xa9, 0x39, 0xcc, 0x3e, 0xd2, 0x64, 0x53, 0xfd, 0x08, 0xa7,
-	0xe2, 0x9f, 0x6a, 0x5e, 0x8f, 0x81, 0x19, 0xe2, 0xe2, 0xdb, 0x47, 0x85, 0x8f, 0xef, 0x41, 0x86,
-	0xf1, 0x81, 0x89, 0x94, 0x5c, 0xf8, 0xb1, 0x78, 0x63, 0xaf, 0x92, 0x0d, 0x1b, 0xf1, 0x13, 0x8f,
-	0x6b, 0x64, 0x60, 0x36, 0xda, 0xe0, 0x52, 0x60, 0xcd, 0xdd, 0x2b, 0x91, 0x24, 0x59, 0x09, 0x07,
-	0x72, 0x80, 0x4a, 0x29, 0x76, 0x95, 0x43, 0x4a, 0xec, 0x22, 0xf3, 0xc4, 0x0b, 0xdf, 0xa9, 0x1b,
-	0x92, 0x26, 0xfa, 0x33, 0x52, 0x37, 0xab, 0x65, 0xc5, 0x95, 0x58, 0x4b, 0xdf, 0x40, 0xb2, 0x6b,
-	0x7a, 0x29, 0x24, 0xdb, 0xe3, 0x21, 0x13, 0xa3, 0xd7, 0x80, 0x62, 0x8c, 0x9e, 0x20, 0x25, 0xbf,
-	0xb9, 0xae, 0x44, 0xeb, 0xe5, 0xdd, 0x92, 0x5d, 0xa2, 0x50, 0xe3, 0x45, 0xea, 0xa4, 0x0b, 0x2b,
-	0x4f, 0x68, 0xc0, 0x0b, 0x86, 0xd5, 0x65, 0x85, 0xb4, 0xd6, 0x67, 0x0b, 0x8a, 0x51, 0xeb, 0x6c,
-	0x22, 0xc9, 0x75, 0x7d, 0x55, 0x92, 0xf4, 0xaf, 0xfc, 0x68, 0x85, 0x67, 0x40, 0x9e, 0xd0, 0x60,
-	0xba, 0x24, 0xb8, 0x2c, 0xae, 0xed, 0x4c, 0xf1, 0x71, 0xe5, 0xc6, 0x4c, 0xcf, 0xd8, 0x9f, 0x15,
-	0x6d, 0x58, 0xfb, 0x1b, 0x4d, 0xf4, 0x3e, 0x64, 0x9f, 0xd0, 0xa0, 0x49, 0x83, 0xae, 0x71, 0x38,
-	0xb5, 0x72, 0x0c, 0x02, 0x79, 0x45, 0xaf, 0xfe, 0x16, 0x39, 0x00, 0x88, 0xb4, 0xf4, 0x97, 0xe9,
-	0xe7, 0x3b, 0x38, 0x73, 0x59, 0xbf, 0x31, 0xa5, 0x9f, 0x7d, 0xf3, 0x72, 0x87, 0xcd, 0xfa, 0x85,
-	0x06, 0x6b, 0x73, 0xf3, 0x98, 0x04, 0xbf, 0x38, 0x79, 0x55, 0xda, 0xb7, 0x72, 0xff, 0x15, 0x18,
-	0x42, 0x7f, 0xc4, 0x18, 0x1f, 0x79, 0x94, 0x4e, 0x68, 0xcf, 0x54, 0x96, 0xc1, 0x96, 0xf0, 0x04,
-	0x8a, 0xf1, 0xba, 0x43, 0x72, 0x5b, 0x16, 0x94, 0xcc, 0x14, 0x38, 0x56, 0x2a, 0xf3, 0xba, 0x84,
-	0x93, 0xff, 0x1c, 0x6e, 0xcc, 0xa9, 0xcf, 0xe3, 0x4a, 0x70, 0x71, 0xcd, 0x21, 0x57, 0x82, 0xaf,
-	0x2a, 0xec, 0x6b, 0xf3, 0x4a, 0x99, 0x78, 0x05, 0x1c, 0x79, 0x3b, 0x36, 0x6c, 0xba, 0x18, 0xaf,
-	0x72, 0x67, 0x51, 0xb7, 0x20, 0xfa, 0x43, 0x58, 0x99, 0x2a, 0x28, 0x23, 0x21, 0x6f, 0xb3, 0x55,
-	0x71, 0x95, 0x8d, 0xb9, 0x7d, 0x82, 0xd6, 0x33, 0x5e, 0xdf, 0xa8, 0x56, 0x5e, 0x91, 0xd8, 0x80,
-	0xa9, 0xca, 0x31, 0x6e, 0x75, 0x16, 0x16, 0x6b, 0x09, 0x72, 0x6a, 0x81, 0x53, 0x44, 0x6e, 0x4e,
-	0x85, 0x55, 0x44, 0x6e, 0x6e, 0x4d, 0xd4, 0x77, 0x63, 0x55, 0x40, 0x6b, 0x53, 0xc5, 0x42, 0x82,
-	0xc4, 0xfa, 0x34, 0x58, 0x0c, 0xb6, 0x50, 0x41, 0x08, 0xfb, 0xb4, 0x7b, 0x55, 0x3d, 0xe0, 0x04,
-	0x66, 0x9e, 0xc4, 0x38, 0x81, 0xd9, 0xe7, 0xab, 0xb8, 0xe1, 0x56, 0x2d, 0xd8, 0xc9, 0x95, 0x69,
-	0xa1, 0x9e, 0xbc, 0xe4, 0xb6, 0x73, 0x2a, 0x79, 0xc2, 0x39, 0x5e, 0x90, 0x89, 0xe2, 0x1c, 0x2f,
-	0xca, 0xb7, 0xcc, 0xb1, 0x9a, 0x1c, 0x33, 0x6e, 0x35, 0x9b, 0x90, 0x16, 0x97, 0x87, 0xcc, 0x7d,
-	0x6c, 0xa8, 0xac, 0x4d, 0x41, 0x05, 0xf5, 0xb8, 0x97, 0xc4, 0xef, 0xd4, 0x63, 0xed, 0xe1, 0x49,
-	0x0a, 0xff, 0x53, 0xa0, 0x6f, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x49, 0x52, 0x71,
-	0x58, 0x48, 0x00, 0x00,
+	// 9206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4b, 0x8c, 0x1b, 0x49,
+	0x96, 0xd8, 0x24, 0x59, 0xc5, 0xcf, 0xe3, 0xa7, 0x58, 0x29, 0x55, 0x89, 0x62, 0x49, 0x2d, 0x75,
+	0x76, 0xef, 0xb4, 0x5a, 0xbd, 0xad, 0xee, 0xd1, 0xcc, 0xec, 0xcc, 0xf6, 0x6c, 0xcf, 0x2c, 0x8b,
+	0x45, 0x49, 0xb5, 0x2a, 0xb1, 0xaa, 0x93, 0x2c, 0x49, 0xbd, 0xb3, 0xd8, 0x9c, 0x2c, 0x32, 0xaa,
+	0x2a, 0xa7, 0xc8, 0x4c, 0x4e, 0x66, 0xb2, 0xc4, 0x9a, 0x19, 0xd8, 0x3d, 0x6b, 0x1b, 0x86, 0xf7,
+	0x60, 0xc0, 0x1e, 0xc3, 0x30, 0x60, 0xf8, 0x03, 0xc3, 0x07, 0xc3, 0xc0, 0xfa, 0xb0, 0x5e, 0xc0,
+	0x07, 0x03, 0x86, 0xbd, 0xb0, 0x0d, 0xfb, 0xe2, 0xcb, 0xda, 0x07, 0x0f, 0x7c, 0x5b, 0xc3, 0x86,
+	0x7d, 0xf3, 0xc1, 0x27, 0x5f, 0x8c, 0xf7, 0x22, 0x22, 0x33, 0x32, 0x49, 0x4a, 0xaa, 0x69, 0x76,
+	0xaf, 0x0f, 0xbe, 0x54, 0x65, 0xbc, 0x17, 0xf1, 0x22, 0xe2, 0x45, 0xc4, 0x8b, 0xf7, 0x5e, 0xbc,
+	0x08, 0x42, 0x79, 0xda, 0x3f, 0xb5, 0x1d, 0xf7, 0xde, 0xd8, 0xf7, 0x42, 0x4f, 0xcf, 0x8c, 0x8f,
+	0x1a, 0x37, 0x4e, 0x3c, 0xef, 0x64, 0xc8, 0x3e, 0xb0, 0xc7, 0xce, 0x07, 0xb6, 0xeb, 0x7a, 0xa1,
+	0x1d, 0x3a, 0x9e, 0x1b, 0xf0, 0x1c, 0x8d, 0x1a, 0x65, 0x67, 0x83, 0xa3, 0xe3, 0x90, 0x43, 0x8c,
+	0x63, 0xc8, 0x3d, 0x62, 0xf6, 0x80, 0xf9, 0xfa, 0x55, 0x58, 0x1d, 0x7a, 0x27, 0xce, 0xa0, 0xae,
+	0xdd, 0xd6, 0xee, 0x14, 0x4d, 0x9e, 0xd0, 0xb7, 0xa0, 0x78, 0xec, 0x7b, 0x23, 0xcb, 0xf5, 0x06,
+	0xac, 0x9e, 0x21, 0x4c, 0x01, 0x01, 0x1d, 0x6f, 0xc0, 0xf4, 0x77, 0x61, 0x95, 0xf9, 0xbe, 0xe7,
+	0xd7, 0xb3, 0xb7, 0xb5, 0x3b, 0xd5, 0xfb, 0x57, 0xee, 0x8d, 0x8f, 0xee, 0x3d, 0x6f, 0x61, 0x15,
+	0x6d, 0x04, 0xb7, 0xdd, 0xc9, 0xc8, 0xe4, 0x39, 0x8c, 0x63, 0xa8, 0xf4, 0xa6, 0x3b, 0x76, 0x68,
+	0x37, 0xfb, 0x7d, 0x6f, 0xe2, 0x86, 0x7a, 0x1d, 0xf2, 0xf6, 0x60, 0xe0, 0xb3, 0x20, 0x10, 0x15,
+	0xca, 0xa4, 0xbe, 0x09, 0x39, 0x7b, 0x84, 0x79, 0x44, 0x7d, 0x22, 0xa5, 0xbf, 0x05, 0x95, 0x63,
+	0xdf, 0xfb, 0x31, 0x73, 0xad, 0x53, 0xe6, 0x9c, 0x9c, 0x86, 0x54, 0x6b, 0xd6, 0x2c, 0x73, 0xe0,
+	0x23, 0x82, 0x19, 0x7f, 0x9a, 0x81, 0x1c, 0xaf, 0x48, 0x37, 0x20, 0x77, 0x4a, 0x5d, 0xab, 0x57,
+	0x6e, 0x6b, 0x77, 0x4a, 0xf7, 0x01, 0x9b, 0xc7, 0x3b, 0x6b, 0x0a, 0x8c, 0xae, 0xc3, 0x4a, 0x38,
+	0x15, 0x7d, 0x2e, 0x9b, 0xf4, 0x8d, 0xf5, 0x1f, 0xf5, 0x5d, 0x7b, 0x24, 0xfb, 0x2b, 0x52, 0x11,
+	0x2b, 0xb0, 0x9d, 0xf5, 0x6c, 0xcc, 0x8a, 0xe6, 0x60, 0xe0, 0xeb, 0xb7, 0xa0, 0x44, 0xc8, 0xf1,
+	0xe4, 0xe8, 0x8c, 0x5d, 0xd4, 0x57, 0x08, 0x0d, 0x08, 0x3a, 0x20, 0x48, 0x94, 0x21, 0xe8, 0xfb,
+	0x98, 0x61, 0x35, 0xce, 0xd0, 0x25, 0x08, 0x92, 0x9f, 0x04, 0xcc, 0xb7, 0x02, 0xe7, 0xc4, 0xad,
+	0x57, 0xa9, 0x3d, 0x05, 0x04, 0x74, 0x9d, 0x13, 0x57, 0x7f, 0x0f, 0xf2, 0x36, 0x67, 0x5c, 0x3d,
+	0x77, 0x3b, 0x7b, 0xa7, 0x74, 0x7f, 0x1d, 0x3b, 0x93, 0xe0, 0xa8, 0x29, 0x73, 0xe0, 0x48, 0xba,
+	0x9e, 0xdb, 0x67, 0xf5, 0x02, 0x1f, 0x49, 0x4a, 0xe8, 0x37, 0xa0, 0x18, 0x3a, 0x23, 0x16, 0x84,
+	0xf6, 0x68, 0x5c, 0x2f, 0x12, 0xeb, 0x62, 0x00, 0x32, 0x62, 0xc0, 0x82, 0x7e, 0xbd, 0xcc, 0x19,
+	0x81, 0xdf, 0x38, 0x44, 0xe7, 0xcc, 0x0f, 0x1c, 0xcf, 0xad, 0xaf, 0xdd, 0xd6, 0xee, 0xac, 0x9a,
+	0x32, 0x69, 0xfc, 0x5b, 0x0d, 0x0a, 0xbd, 0x69, 0x37, 0xb4, 0xc3, 0x49, 0xa0, 0xf0, 0x59, 0x5b,
+	0xc8, 0xe7, 0x45, 0x3c, 0x95, 0xfc, 0xcf, 0x2a, 0xfc, 0x7f, 0x1f, 0x72, 0x01, 0x51, 0x26, 0x2e,
+	0x56, 0xef, 0x6f, 0x50, 0x57, 0x7d, 0xdb, 0x0d, 0xec, 0x3e, 0x4e, 0x66, 0x5e, 0xad, 0x29, 0x32,
+	0xe9, 0x0d, 0x28, 0x0c, 0x9c, 0x20, 0xb4, 0xb1, 0xc3, 0xab, 0xd4, 0xad, 0x28, 0xad, 0xdf, 0x82,
+	0x4c, 0x38, 0xad, 0xe7, 0xa9, 0x59, 0x6b, 0x29, 0x32, 0x66, 0x26, 0x9c, 0x1a, 0x1d, 0x28, 0x6c,
+	0xdb, 0x61, 0xff, 0xb4, 0x37, 0x7d, 0xbd, 0x7e, 0xbc, 0x01, 0xd9, 0xde, 0x34, 0xa8, 0x67, 0x68,
+	0x0c, 0xca, 0x7c, 0x0c, 0x44, 0x7b, 0x10, 0x61, 0x1c, 0x41, 0x55, 0xd0, 0x33, 0xd9, 0x8f, 0x26,
+	0x2c, 0x08, 0x3f, 0x17, 0x77, 0xae, 0xc2, 0x2a, 0x72, 0x24, 0xa8, 0x67, 0x6f, 0x67, 0xef, 0x94,
+	0x4d, 0x9e, 0x30, 0xfe, 0x9e, 0x06, 0x95, 0x67, 0xb6, 0x13, 0x2e, 0xa7, 0x8e, 0x79, 0x23, 0xf0,
+	0x36, 0x54, 0xfa, 0x9e, 0x7b, 0xec, 0xf8, 0x23, 0x2e, 0x3d, 0x68, 0x20, 0xb2, 0x66, 0x12, 0x88,
+	0xd3, 0x03, 0xe7, 0x8f, 0x37, 0x09, 0x05, 0xdf, 0x65, 0xd2, 0xf8, 0xef, 0x1a, 0x54, 0x65, 0x0b,
+	0x83, 0xb1, 0xe7, 0x06, 0xec, 0xcf, 0x7a, 0x92, 0xd4, 0x21, 0x7f, 0x34, 0xf4, 0xfa, 0x67, 0xce,
+	0x80, 0xda, 0x5a, 0x36, 0x65, 0x12, 0x2b, 0x15, 0xe2, 0x24, 0x47, 0x9d, 0x10, 0xa9, 0x59, 0x1e,
+	0xe4, 0xe7, 0xf0, 0xc0, 0xf8, 0x01, 0x54, 0x7b, 0xd3, 0x03, 0xdf, 0xf3, 0x8e, 0xbf, 0xa0, 0xb1,
+	0x30, 0xfe, 0x44, 0x83, 0xb5, 0xa8, 0x8a, 0x2f, 0x88, 0x99, 0xdf, 0x80, 0x32, 0xb1, 0xc3, 0x12,
+	0x54, 0x57, 0x6e, 0x6b, 0x52, 0xc4, 0xec, 0xba, 0x21, 0xf3, 0x5d, 0x7b, 0xb8, 0x8d, 0x78, 0xb3,
+	0x44, 0xd9, 0xc4, 0x86, 0x71, 0x1d, 0x0a, 0xe1, 0xd4, 0x72, 0xdc, 0x01, 0x9b, 0x46, 0x13, 0x60,
+	0xba, 0x8b, 0x49, 0x14, 0x76, 0x23, 0xe6, 0x9f, 0x0d, 0x99, 0x35, 0xb6, 0xc3, 0x53, 0x12, 0x59,
+	0x65, 0x13, 0x38, 0xe8, 0xc0, 0x0e, 0x4f, 0x8d, 0xff, 0xad, 0xc1, 0x2a, 0x91, 0xfc, 0x5c, 0x7d,
+	0x51, 0x46, 0x35, 0x9b, 0x1c, 0xd5, 0x7b, 0xa9, 0xe9, 0xb1, 0x89, 0x54, 0xa9, 0xc2, 0x7b, 0x6d,
+	0xfa, 0x97, 0x9a, 0x1f, 0xef, 0xc0, 0x2a, 0x15, 0xad, 0xaf, 0x2e, 0xea, 0x3a, 0xc7, 0x1b, 0x1f,
+	0x43, 0x59, 0x25, 0xa0, 0x17, 0x61, 0xb5, 0x6d, 0x9a, 0xfb, 0x66, 0xed, 0x2b, 0xf8, 0xd9, 0x33,
+	0x0f, 0x3b, 0x8f, 0x6b, 0x9a, 0x0e, 0x90, 0xdb, 0x36, 0x9b, 0x9d, 0xd6, 0xa3, 0x5a, 0x46, 0x2f,
+	0x41, 0xbe, 0xb3, 0xdf, 0x7e, 0xbe, 0xdb, 0xed, 0xd5, 0xb2, 0xc6, 0xef, 0x69, 0x90, 0xa7, 0xe2,
+	0xbb, 0x3b, 0x4a, 0xcf, 0x57, 0x5e, 0xa3, 0xe7, 0xda, 0xa2, 0x9e, 0x67, 0x92, 0x3d, 0x7f, 0x13,
+	0xca, 0x2e, 0x63, 0x03, 0xab, 0xef, 0xb9, 0x21, 0x73, 0xf9, 0x26, 0x59, 0x30, 0x4b, 0x08, 0x6b,
+	0x71, 0x90, 0x61, 0x43, 0x69, 0x9b, 0x8f, 0x23, 0xcd, 0xf4, 0xb8, 0x1d, 0xd9, 0x4b, 0xb7, 0x23,
+	0x5e, 0x3d, 0x19, 0x75, 0xf5, 0x18, 0x3f, 0xd7, 0xe0, 0xca, 0x76, 0x3c, 0x57, 0x82, 0x65, 0xac,
+	0x8e, 0x37, 0xa1, 0x1c, 0x84, 0xb6, 0x1f, 0x26, 0xb7, 0xff, 0x12, 0xc1, 0x44, 0x57, 0x6e, 0x02,
+	0x30, 0x77, 0x20, 0x33, 0x70, 0xa9, 0x55, 0x64, 0xee, 0x80, 0xa3, 0x8d, 0x7f, 0xba, 0x12, 0xf5,
+	0x9c, 0x6a, 0x52, 0x36, 0x38, 0x2d, 0xb1, 0xc1, 0xc5, 0x5b, 0x68, 0x86, 0xe0, 0x3c, 0xf1, 0x92,
+	0xf9, 0x76, 0x1d, 0x0a, 0x63, 0x9f, 0x59, 0xa7, 0x76, 0x70, 0x4a, 0xd5, 0x96, 0xcd, 0xfc, 0xd8,
+	0x67, 0x8f, 0xec, 0xe0, 0x14, 0xf7, 0xa7, 0xb1, 0xef, 0x8d, 0xbd, 0x80, 0xf9, 0x42, 0xf6, 0x44,
+	0x69, 0x5c, 0x8c, 0xb4, 0xdd, 0xe7, 0xf8, 0x62, 0xc4, 0x6f, 0xec, 0xbe, 0x50, 0x22, 0xf2, 0x04,
+	0x15, 0x29, 0x65, 0x4d, 0xf9, 0x9e, 0x17, 0xd2, 0xde, 0x1e, 0xad, 0x29, 0xd3, 0xf3, 0x42, 0x65,
+	0x2c, 0x8a, 0x09, 0x49, 0x96, 0xd8, 0xf8, 0x21, 0xbd, 0xf1, 0xf3, 0x55, 0xcc, 0x55, 0x8b, 0x32,
+	0x67, 0x42, 0x38, 0x6d, 0x61, 0x12, 0x51, 0xfd, 0x89, 0x6f, 0x85, 0xcc, 0x1f, 0xd5, 0x6b, 0x7c,
+	0x81, 0xf7, 0x27, 0x7e, 0x8f, 0xf9, 0x23, 0xdd, 0x80, 0x0a, 0xa2, 0xb8, 0xd4, 0x70, 0x27, 0xa3,
+	0xfa, 0x3a, 0x1f, 0x8c, 0xfe, 0xc4, 0x27, 0x06, 0x77, 0x26, 0x23, 0xfd, 0x63, 0x80, 0x63, 0xdb,
+	0x19, 0xb2, 0x81, 0x15, 0x4e, 0x83, 0xba, 0x4e, 0x5b, 0xe6, 0x1b, 0xd1, 0x3a, 0xe4, 0x43, 0x70,
+	0xef, 0x01, 0xe5, 0xe8, 0x4d, 0x83, 0xb6, 0x1b, 0xfa, 0x17, 0x66, 0xf1, 0x58, 0xa6, 0xb1, 0xbf,
+	0xa1, 0xed, 0x9f, 0xb0, 0xd0, 0x3a, 0x72, 0xc2, 0xa0, 0x7e, 0x85, 0xda, 0x06, 0x1c, 0xb4, 0xed,
+	0x84, 0x81, 0x7e, 0x07, 0xf2, 0x3f, 0x9c, 0x04, 0xa1, 0x73, 0x7c, 0x51, 0xbf, 0x4a, 0x93, 0xa9,
+	0x8a, 0xc4, 0x3f, 0x99, 0x78, 0xfe, 0x64, 0xd4, 0x62, 0x7e, 0x68, 0x4a, 0x74, 0xe3, 0x37, 0xa0,
+	0x9a, 0xac, 0x47, 0xaf, 0x41, 0x16, 0x39, 0xcc, 0x27, 0x33, 0x7e, 0xe2, 0x88, 0x9f, 0xdb, 0xc3,
+	0x89, 0x9c, 0x74, 0x3c, 0xf1, 0x51, 0xe6, 0xdb, 0x9a, 0x31, 0x81, 0xb2, 0x3a, 0x95, 0x3f, 0xd7,
+	0x1c, 0x7e, 0x17, 0xf2, 0x3c, 0x07, 0xdf, 0xd3, 0x85, 0x56, 0xa2, 0x90, 0x37, 0x25, 0xde, 0xf8,
+	0x5b, 0x19, 0xd8, 0xc0, 0x15, 0xeb, 0xdb, 0xfd, 0xb0, 0x7d, 0xce, 0xdc, 0x70, 0x29, 0x8b, 0xa8,
+	0x01, 0x85, 0xbe, 0x20, 0x2a, 0x75, 0x58, 0x99, 0xa6, 0xd5, 0x83, 0x15, 0x59, 0x54, 0x8e, 0xab,
+	0xb0, 0x45, 0x82, 0x74, 0xb0, 0xe8, 0x0d, 0x28, 0x3a, 0xae, 0x13, 0x3a, 0x76, 0xe8, 0xf9, 0x42,
+	0x7f, 0x8d, 0x01, 0x33, 0xab, 0x33, 0xf7, 0xaa, 0xd5, 0x99, 0x4f, 0xad, 0x4e, 0x5a, 0x0c, 0x67,
+	0xce, 0x98, 0x66, 0x76, 0xd6, 0xa4, 0x6f, 0x32, 0x4a, 0x9c, 0x91, 0x23, 0xa7, 0x34, 0x4f, 0xa0,
+	0x14, 0x5d, 0x4f, 0xb0, 0x66, 0xd7, 0x3d, 0xf6, 0x94, 0xf9, 0xaf, 0x25, 0xe6, 0xff, 0x62, 0x59,
+	0x39, 0x6f, 0x2f, 0x7c, 0x07, 0x56, 0xa9, 0xcb, 0xea, 0x26, 0x98, 0xa8, 0xcb, 0xe4, 0x78, 0xe3,
+	0xbf, 0x68, 0xb0, 0x99, 0x1e, 0x9f, 0x25, 0xec, 0xcf, 0xef, 0x43, 0x8e, 0xe8, 0xcb, 0x09, 0xb2,
+	0x31, 0xd3, 0x00, 0xec, 0xac, 0x29, 0x32, 0xe1, 0x1a, 0x3d, 0xb5, 0x03, 0x6b, 0xe4, 0xf9, 0x7c,
+	0xc4, 0x0a, 0x66, 0xfe, 0xd4, 0x0e, 0x9e, 0x78, 0x3e, 0x2a, 0xbf, 0x25, 0x97, 0x4d, 0xa3, 0x01,
+	0xe1, 0x5b, 0x34, 0x20, 0x48, 0x30, 0x7c, 0x0b, 0x8a, 0x94, 0x81, 0xb8, 0xce, 0xc7, 0xab, 0x80,
+	0x80, 0xee, 0x99, 0x33, 0x36, 0xfe, 0x48, 0x83, 0x75, 0xbe, 0xf3, 0xd9, 0xee, 0x09, 0xfb, 0x7f,
+	0x42, 0x7e, 0xcf, 0xec, 0x6d, 0xab, 0xb3, 0x7b, 0xdb, 0xd7, 0xa0, 0xd4, 0xf2, 0x46, 0x23, 0xcf,
+	0x35, 0xd9, 0x78, 0x78, 0xf1, 0x3a, 0xed, 0x35, 0x2c, 0x28, 0xf0, 0x22, 0xbb, 0xee, 0x6b, 0xf5,
+	0xef, 0x03, 0x28, 0x9d, 0x3b, 0xec, 0x85, 0xe5, 0x8d, 0x51, 0x07, 0xa4, 0x4e, 0x56, 0xb9, 0xec,
+	0x79, 0xea, 0xb0, 0x17, 0xfb, 0x04, 0x35, 0xe1, 0x3c, 0xfa, 0x36, 0x7e, 0x08, 0xa5, 0x9e, 0x77,
+	0xc6, 0xdc, 0x1d, 0x16, 0xda, 0xce, 0xf0, 0xa5, 0x7b, 0xba, 0x3d, 0xb4, 0xe5, 0xae, 0x53, 0x34,
+	0x65, 0xf2, 0x32, 0x76, 0xf6, 0x18, 0x2a, 0x4d, 0x6e, 0x47, 0x5f, 0xc2, 0x3a, 0x53, 0x6c, 0xf1,
+	0x4c, 0xd2, 0x16, 0x7f, 0x13, 0xb2, 0x47, 0xfd, 0x84, 0xac, 0x52, 0x7a, 0x62, 0x22, 0xce, 0xd8,
+	0x85, 0x75, 0x82, 0x3d, 0x20, 0x33, 0x5c, 0xf4, 0x51, 0xe9, 0x8b, 0x96, 0xec, 0x4b, 0x03, 0x0a,
+	0x4e, 0xc0, 0xf3, 0x52, 0x65, 0x05, 0x33, 0x4a, 0x1b, 0x9f, 0x69, 0xa0, 0xcf, 0xd0, 0x0a, 0x16,
+	0x32, 0xec, 0x1d, 0xc8, 0x86, 0xc7, 0x83, 0x7a, 0x26, 0x5e, 0x27, 0x33, 0x85, 0x4d, 0xcc, 0x71,
+	0x19, 0xfe, 0x7d, 0xa6, 0xc1, 0x55, 0xc1, 0xc0, 0x6d, 0xde, 0xe2, 0xa5, 0xf0, 0xf1, 0x2e, 0xac,
+	0x84, 0xc7, 0x03, 0xc9, 0xc8, 0xcd, 0xb9, 0x6d, 0x0d, 0x4c, 0xca, 0x63, 0x30, 0xc1, 0x84, 0xee,
+	0x64, 0x3c, 0x1e, 0x5e, 0x2c, 0x63, 0xe5, 0x5d, 0x81, 0xd5, 0xd0, 0x1b, 0x5b, 0xae, 0x58, 0x72,
+	0x2b, 0xa1, 0x37, 0xee, 0x18, 0x9f, 0x8a, 0x59, 0xf9, 0xc8, 0x1b, 0xa6, 0xda, 0x9e, 0xf2, 0xc7,
+	0x2c, 0x9e, 0x97, 0x9b, 0x90, 0xe3, 0xce, 0x17, 0xb1, 0x95, 0x88, 0x94, 0xf1, 0x57, 0x33, 0x70,
+	0x25, 0xd1, 0x85, 0x25, 0xc8, 0xc5, 0x58, 0xba, 0x67, 0x13, 0xd2, 0x1d, 0x6d, 0x64, 0x2f, 0xb4,
+	0x87, 0x62, 0xbf, 0xe2, 0x09, 0xa5, 0x65, 0xab, 0x6a, 0xcb, 0xf4, 0xdb, 0x50, 0xea, 0x3b, 0x7e,
+	0x7f, 0x32, 0xb4, 0x43, 0xc7, 0x3d, 0x21, 0xa1, 0x57, 0x34, 0x55, 0x10, 0xca, 0x98, 0x53, 0xe2,
+	0x88, 0xd0, 0x89, 0xf8, 0x36, 0x55, 0xe2, 0x30, 0xae, 0x17, 0x7d, 0x08, 0x25, 0x64, 0x27, 0x07,
+	0x05, 0xf5, 0x42, 0x6a, 0x71, 0x70, 0x86, 0x9a, 0x10, 0x7a, 0x63, 0xfe, 0x19, 0x18, 0x7f, 0x47,
+	0x83, 0x7c, 0x6f, 0xba, 0xeb, 0x8e, 0x27, 0xa4, 0x55, 0xf9, 0xec, 0xd8, 0x52, 0xdc, 0x4e, 0x79,
+	0x9f, 0x1d, 0xf7, 0x70, 0xef, 0xb9, 0x09, 0x80, 0x28, 0xef, 0xf8, 0x38, 0x60, 0xa1, 0x50, 0x3d,
+	0x8b, 0x3e, 0x3b, 0xde, 0x27, 0x40, 0xd2, 0x01, 0x25, 0x54, 0xc9, 0xc8, 0x01, 0x15, 0x7b, 0xcd,
+	0xb8, 0x32, 0xb9, 0xd0, 0x6b, 0x96, 0x9f, 0xe3, 0x35, 0xfb, 0x01, 0xba, 0x73, 0xf6, 0x27, 0x21,
+	0xb6, 0x2f, 0x26, 0xa4, 0x25, 0x08, 0x5d, 0x83, 0x7c, 0xe8, 0xf1, 0xba, 0xf9, 0x36, 0x9a, 0x0b,
+	0x3d, 0xaa, 0x79, 0xa6, 0x86, 0x95, 0x39, 0x35, 0xec, 0x43, 0xf5, 0xf9, 0x64, 0xcc, 0xbd, 0x59,
+	0x76, 0x38, 0xe1, 0xdb, 0xd3, 0x78, 0x72, 0x34, 0x74, 0xfa, 0xd6, 0x19, 0xbb, 0xc0, 0x49, 0x47,
+	0x36, 0x22, 0x07, 0x3d, 0x66, 0x17, 0x01, 0xea, 0x1b, 0x81, 0xcc, 0x2d, 0xaa, 0x8c, 0x01, 0xc6,
+	0x7f, 0xc8, 0x41, 0x49, 0xb1, 0xf7, 0xe7, 0x7a, 0xf2, 0x16, 0xef, 0xfc, 0x77, 0xa0, 0x48, 0xb6,
+	0xeb, 0x78, 0x12, 0x6d, 0xb4, 0x25, 0xee, 0xcd, 0xa1, 0x41, 0x32, 0x0b, 0x21, 0xff, 0x08, 0xf4,
+	0xf7, 0x00, 0xc2, 0xa9, 0xe5, 0x11, 0x6f, 0xd0, 0x9a, 0x54, 0x1c, 0x3f, 0x9c, 0x61, 0x66, 0x31,
+	0x14, 0x5f, 0x41, 0xe4, 0x45, 0xcb, 0x29, 0x5e, 0x34, 0xd2, 0xb8, 0x1c, 0xf7, 0xc8, 0x0e, 0x18,
+	0xf1, 0xbe, 0x60, 0x46, 0xe9, 0x5f, 0xca, 0x53, 0xa7, 0x18, 0x2d, 0x90, 0x34, 0x5a, 0x70, 0x09,
+	0x4f, 0x42, 0xef, 0x84, 0xb9, 0xf5, 0x12, 0x57, 0x05, 0x44, 0x52, 0xbf, 0x0f, 0x95, 0xa8, 0xbb,
+	0x16, 0x9b, 0x86, 0xf5, 0x6b, 0xd4, 0x8f, 0xaa, 0xd2, 0xe5, 0xf6, 0x34, 0x34, 0x4b, 0xb2, 0xd7,
+	0xed, 0x69, 0xa8, 0x7f, 0x13, 0xaa, 0x71, 0xc7, 0xa9, 0x50, 0x5d, 0x99, 0xe8, 0xa2, 0xcb, 0x58,
+	0xaa, 0x1c, 0xf5, 0x1f, 0x8b, 0x7d, 0x17, 0xd6, 0xa5, 0x42, 0x69, 0xf9, 0x5c, 0x76, 0x05, 0xf5,
+	0xeb, 0xb1, 0xcf, 0x72, 0xd7, 0x3d, 0xf7, 0xce, 0xa4, 0x3e, 0x61, 0xd6, 0x64, 0x5e, 0x01, 0x08,
+	0x92, 0x5a, 0x66, 0x63, 0x8e, 0x96, 0x69, 0x4f, 0xc2, 0x53, 0xa2, 0xec, 0xf8, 0xac, 0xbe, 0x75,
+	0x3b, 0x8b, 0x0b, 0x18, 0x61, 0x26, 0x07, 0xe9, 0x1f, 0xc1, 0x5a, 0x94, 0x9f, 0x9c, 0xa9, 0x41,
+	0xfd, 0x46, 0x5c, 0x7d, 0x34, 0xff, 0x48, 0x8b, 0xaa, 0x46, 0x39, 0x11, 0x1e, 0xe8, 0xdf, 0x03,
+	0x5d, 0x25, 0x2f, 0x8a, 0xdf, 0x5c, 0x54, 0xbc, 0xa6, 0xd4, 0xcb, 0x09, 0xbc, 0x0f, 0xba, 0xcf,
+	0xfa, 0xcc, 0x39, 0x47, 0xab, 0x27, 0x1a, 0xc3, 0x37, 0x68, 0x0c, 0xd7, 0x25, 0xa6, 0x17, 0x8d,
+	0xe5, 0xd7, 0x00, 0xa6, 0xb8, 0x2a, 0xa8, 0xa2, 0xfa, 0x2d, 0x12, 0x8a, 0x3a, 0xed, 0x4e, 0x89,
+	0xb5, 0x62, 0x16, 0xa7, 0x32, 0xad, 0xdf, 0x87, 0xf2, 0xc8, 0x1b, 0x38, 0xc7, 0x17, 0xdc, 0xf8,
+	0xaa, 0xdf, 0x8e, 0x9d, 0x9b, 0x4f, 0x08, 0x2e, 0x3c, 0x35, 0xa3, 0x38, 0xa1, 0xbf, 0x05, 0xf9,
+	0x47, 0x3b, 0x96, 0xe3, 0x1e, 0x7b, 0xf5, 0x37, 0x15, 0xc1, 0xbb, 0xc3, 0x35, 0x49, 0xfe, 0xdf,
+	0x08, 0x00, 0xf6, 0xd8, 0xe0, 0x84, 0xf9, 0x4f, 0x58, 0x68, 0x23, 0xa3, 0xd1, 0xcc, 0xb4, 0xe4,
+	0xfa, 0xe1, 0xcb, 0xaa, 0x84, 0xb0, 0x6d, 0x0e, 0x22, 0x03, 0xcd, 0x19, 0x5b, 0xc9, 0x15, 0x06,
+	0xa1, 0x33, 0xde, 0x8e, 0x5d, 0x11, 0xa1, 0x3f, 0x71, 0xcf, 0x52, 0x0a, 0x1f, 0xc1, 0x84, 0x58,
+	0xf8, 0xfd, 0x55, 0x28, 0x1c, 0x86, 0x53, 0x8f, 0xea, 0xfc, 0x15, 0xa8, 0x0e, 0xed, 0x90, 0x05,
+	0xe9, 0x5a, 0x2b, 0x1c, 0x2a, 0xc9, 0x1a, 0x50, 0xc1, 0x2f, 0x14, 0x1b, 0xd6, 0xd0, 0x09, 0x42,
+	0x52, 0x00, 0x8a, 0x66, 0x09, 0x81, 0x8f, 0xd9, 0xc5, 0x9e, 0x13, 0x90, 0x22, 0x39, 0x09, 0xa7,
+	0x9e, 0xc5, 0xb7, 0x06, 0xbe, 0x3b, 0x15, 0x11, 0xd2, 0x43, 0x00, 0xae, 0x49, 0xfb, 0xfc, 0x64,
+	0x87, 0x0d, 0xed, 0x0b, 0x21, 0xad, 0xa2, 0xb4, 0xfe, 0xab, 0xb0, 0x3e, 0x71, 0x85, 0x97, 0xaf,
+	0x37, 0x6d, 0x72, 0x51, 0xc8, 0x95, 0xe7, 0x59, 0x84, 0xfe, 0x36, 0x54, 0x47, 0xf6, 0x54, 0x18,
+	0xc2, 0x81, 0xf3, 0x63, 0x26, 0x14, 0xe9, 0xf2, 0xc8, 0x9e, 0x72, 0x3f, 0x91, 0xf3, 0x63, 0xa6,
+	0xff, 0x26, 0x4e, 0x8b, 0x80, 0xf9, 0xe7, 0x42, 0x79, 0xc5, 0x19, 0x8f, 0x1e, 0xc5, 0x05, 0xab,
+	0x62, 0x5d, 0x66, 0x96, 0x7a, 0x7f, 0x80, 0x14, 0x8e, 0x3d, 0xff, 0xc8, 0x19, 0x0c, 0x98, 0x1b,
+	0x91, 0x20, 0xb1, 0x31, 0x9f, 0x42, 0x94, 0x59, 0x92, 0xd0, 0x3f, 0x86, 0x2d, 0x97, 0xbd, 0xb0,
+	0xc4, 0x21, 0x81, 0xe5, 0xb3, 0xc0, 0x9b, 0xf8, 0x7d, 0x66, 0x09, 0x61, 0xcf, 0xe5, 0x4c, 0xdd,
+	0x65, 0x2f, 0xe4, 0x79, 0x82, 0xc8, 0x20, 0x3a, 0xfa, 0x6d, 0xb8, 0xe6, 0xf8, 0x3e, 0x23, 0x59,
+	0x73, 0x34, 0x64, 0x8a, 0x03, 0x49, 0xf8, 0x14, 0x16, 0xa1, 0xd3, 0x25, 0xbb, 0x43, 0x67, 0xc0,
+	0x9e, 0x39, 0xee, 0xc0, 0x7b, 0x51, 0x2f, 0xcd, 0x96, 0x54, 0xd0, 0xfa, 0x1d, 0x28, 0x9c, 0xd8,
+	0xc1, 0x81, 0xef, 0xf4, 0x19, 0xf9, 0x26, 0x84, 0xe4, 0x7d, 0x28, 0x60, 0x66, 0x84, 0xd5, 0x5b,
+	0x70, 0xf5, 0xc4, 0xf7, 0x26, 0x63, 0x8b, 0x0e, 0xb8, 0x62, 0x06, 0x55, 0x16, 0x31, 0x48, 0xa7,
+	0xec, 0xa4, 0x03, 0x4a, 0x0e, 0x19, 0x3f, 0x86, 0x82, 0x24, 0x4d, 0xbe, 0x8f, 0xf1, 0xc4, 0xf2,
+	0xed, 0x90, 0x09, 0x73, 0x32, 0xdf, 0x1f, 0x4f, 0x4c, 0x3b, 0x24, 0xd4, 0x88, 0x8d, 0x38, 0x8a,
+	0x7b, 0xbd, 0xf2, 0x23, 0x36, 0x22, 0xd4, 0x16, 0x14, 0x07, 0x4e, 0x70, 0xc6, 0x71, 0xd9, 0xe8,
+	0x30, 0xe2, 0x4c, 0x22, 0xa7, 0xc7, 0x8c, 0x71, 0xa4, 0x98, 0x75, 0x08, 0x40, 0xa4, 0xf1, 0xaf,
+	0x56, 0xa1, 0x92, 0x70, 0x38, 0xfe, 0x7f, 0xe7, 0xd4, 0x68, 0xac, 0x7f, 0x1d, 0x25, 0x48, 0xb4,
+	0xc7, 0x07, 0xf5, 0x92, 0xb2, 0x03, 0xc5, 0x70, 0x33, 0x91, 0xe9, 0x65, 0x1e, 0xad, 0xb8, 0x99,
+	0xa1, 0xcf, 0x58, 0xbd, 0xa2, 0xfa, 0xa5, 0x7b, 0x3e, 0x23, 0x26, 0x0a, 0x17, 0x57, 0xda, 0xe3,
+	0x75, 0x1b, 0x54, 0xe7, 0xd6, 0x3c, 0x7f, 0xd7, 0xf7, 0xe6, 0xf8, 0xbb, 0x6e, 0xcf, 0x38, 0x92,
+	0x5f, 0xe2, 0xf1, 0x7a, 0x03, 0x14, 0xf7, 0xd6, 0x7c, 0x87, 0xd7, 0x6f, 0xbd, 0xdc, 0xe1, 0x25,
+	0xd0, 0xc8, 0x02, 0xc7, 0xb5, 0x48, 0xd0, 0xd2, 0x51, 0x62, 0xc1, 0xcc, 0x3b, 0x6e, 0x0f, 0x93,
+	0x91, 0xd1, 0x4f, 0xb3, 0x61, 0x8d, 0x0f, 0x39, 0x02, 0x70, 0x3a, 0x7c, 0x4e, 0x47, 0xd9, 0x9f,
+	0x6a, 0x50, 0xd8, 0x6e, 0x2d, 0xe1, 0x54, 0xd0, 0x80, 0x95, 0x11, 0x0b, 0xed, 0x7a, 0x36, 0xee,
+	0x65, 0xbc, 0x35, 0x99, 0x84, 0x8b, 0x3d, 0xf6, 0x2b, 0x2f, 0xf7, 0xd8, 0xa3, 0x10, 0x99, 0x88,
+	0x1d, 0xa6, 0xbe, 0x1a, 0x0b, 0x11, 0xb9, 0xeb, 0x98, 0x11, 0x16, 0x8f, 0x7c, 0x8e, 0x7c, 0xdb,
+	0xed, 0x9f, 0x8a, 0x9d, 0x86, 0xce, 0x2d, 0x8a, 0x66, 0x12, 0x68, 0x74, 0xa1, 0xb4, 0xdd, 0xea,
+	0x39, 0xe3, 0x4b, 0xf4, 0xf3, 0x36, 0x94, 0x9d, 0x80, 0x0f, 0x87, 0x15, 0x3a, 0x63, 0x61, 0xf7,
+	0x82, 0x13, 0xd0, 0x90, 0xf4, 0x9c, 0x31, 0x11, 0x45, 0xfa, 0x24, 0x90, 0x5e, 0x97, 0x28, 0x3f,
+	0x8d, 0x21, 0x89, 0x17, 0xc8, 0x4d, 0x50, 0x01, 0x19, 0x9f, 0x65, 0x20, 0xd7, 0x1d, 0x33, 0x36,
+	0x08, 0xf4, 0x6f, 0x41, 0xb1, 0x3b, 0x19, 0xf1, 0x04, 0xa9, 0xda, 0xa5, 0xfb, 0xd7, 0x49, 0x9f,
+	0x21, 0xc8, 0xbd, 0x08, 0x27, 0xe6, 0x64, 0x94, 0xd6, 0xbf, 0x01, 0x85, 0xed, 0xbe, 0x28, 0xc7,
+	0x0d, 0xed, 0xba, 0x52, 0x6e, 0xbb, 0xaf, 0x16, 0x8b, 0x72, 0xe2, 0x3c, 0x4a, 0x92, 0x7c, 0xd5,
+	0x3c, 0xd2, 0x94, 0x79, 0xd4, 0xd8, 0x85, 0xca, 0x76, 0xff, 0xe5, 0x85, 0x0d, 0xb5, 0xb0, 0x18,
+	0xd1, 0xed, 0x16, 0x2f, 0xa3, 0x4e, 0xc9, 0x9f, 0x40, 0x41, 0x82, 0xf5, 0xaf, 0x43, 0x5e, 0x90,
+	0x55, 0x39, 0xb0, 0xdd, 0x4a, 0xf6, 0x85, 0x77, 0x45, 0xe6, 0x6c, 0x7c, 0x04, 0x65, 0x15, 0x71,
+	0x99, 0x7e, 0x18, 0x7f, 0x5f, 0x83, 0x4a, 0xf7, 0x22, 0x08, 0xd9, 0xe8, 0x32, 0xce, 0x98, 0xf7,
+	0x00, 0x8e, 0xfa, 0x81, 0x25, 0x8e, 0xaf, 0x94, 0x93, 0x66, 0xb9, 0xb4, 0xcc, 0xe2, 0x51, 0x5f,
+	0x21, 0x18, 0xf0, 0xc1, 0x51, 0xce, 0x6e, 0x04, 0x1b, 0x04, 0x86, 0x64, 0x3c, 0x63, 0xfe, 0xa1,
+	0x3f, 0xe4, 0xf6, 0x4b, 0xd1, 0x8c, 0xd2, 0xc6, 0x4f, 0x61, 0x9d, 0xa6, 0x1c, 0x92, 0x5b, 0x8a,
+	0x7f, 0x79, 0x13, 0x72, 0x2f, 0xf8, 0xde, 0x2e, 0xcc, 0x74, 0x9e, 0x8a, 0x5d, 0x10, 0x2b, 0x8a,
+	0x0b, 0x02, 0xfd, 0x3d, 0xfc, 0x34, 0x0c, 0x17, 0xee, 0xb9, 0x3d, 0xa4, 0x66, 0x20, 0x8f, 0x47,
+	0x8e, 0x2b, 0xb6, 0x5d, 0xfc, 0x24, 0x88, 0x3d, 0x15, 0xbb, 0x2d, 0x7e, 0x22, 0xc4, 0x3e, 0x3f,
+	0xa1, 0x4a, 0x34, 0x13, 0x3f, 0x11, 0x32, 0xfe, 0xe6, 0x87, 0x82, 0x3e, 0x7e, 0x12, 0xe4, 0xd7,
+	0x3f, 0x14, 0xba, 0x1b, 0x7e, 0x72, 0xc8, 0xaf, 0x0b, 0x15, 0x0d, 0x3f, 0x8d, 0xdf, 0x01, 0x5d,
+	0xee, 0xff, 0x5c, 0x41, 0xc0, 0x26, 0xa0, 0x49, 0x1b, 0x19, 0x31, 0x8a, 0xe3, 0xa9, 0x2c, 0x81,
+	0x1d, 0xe1, 0xcf, 0x74, 0xa8, 0x88, 0xd8, 0x6b, 0x78, 0xeb, 0x4a, 0x1c, 0x46, 0xfb, 0x8d, 0xf1,
+	0x07, 0x59, 0xd0, 0x55, 0xfe, 0x2e, 0xc1, 0x0f, 0xf2, 0xf9, 0xbd, 0xa8, 0xb7, 0x84, 0xe0, 0x10,
+	0xcd, 0x16, 0x7e, 0x61, 0x02, 0x45, 0xe7, 0x3e, 0xd1, 0x06, 0x9a, 0x93, 0x07, 0xbb, 0x1c, 0x55,
+	0x83, 0x6c, 0x38, 0xe6, 0x67, 0xe1, 0x9a, 0x89, 0x9f, 0xfa, 0xc7, 0x50, 0xe5, 0xd4, 0x1c, 0x31,
+	0x86, 0x42, 0x29, 0x8d, 0x4f, 0x5c, 0x13, 0x83, 0x6b, 0x56, 0x8e, 0x54, 0x18, 0xea, 0xcf, 0xf6,
+	0xf9, 0x89, 0xaa, 0x3f, 0x17, 0x89, 0x76, 0xd9, 0x3e, 0x3f, 0x89, 0xf5, 0xe7, 0x77, 0x61, 0x1d,
+	0x73, 0x85, 0xd3, 0xc0, 0x42, 0x6b, 0x89, 0x72, 0x93, 0xb6, 0xa0, 0x99, 0x58, 0xbc, 0x37, 0x0d,
+	0x0e, 0x18, 0xdf, 0x88, 0xf5, 0xef, 0x40, 0x05, 0x27, 0x5a, 0xac, 0x65, 0x97, 0x62, 0x97, 0xdb,
+	0xec, 0x48, 0x9b, 0xe5, 0xd0, 0x1b, 0x4b, 0x70, 0x60, 0xf8, 0xa0, 0x27, 0x16, 0xec, 0x6b, 0x3b,
+	0x91, 0xf5, 0x6f, 0x43, 0x35, 0xe0, 0x25, 0xe3, 0x95, 0x1b, 0xed, 0x4b, 0x49, 0x9a, 0x95, 0x40,
+	0x4d, 0x1a, 0x26, 0x5c, 0x6d, 0xe1, 0xac, 0x70, 0x83, 0x09, 0x81, 0x96, 0xb0, 0x0a, 0x8d, 0x3f,
+	0xd6, 0x60, 0x2d, 0x41, 0xf4, 0xf5, 0x1d, 0x98, 0x52, 0xe7, 0x14, 0xae, 0x3e, 0x91, 0x44, 0xdb,
+	0xac, 0x2f, 0x09, 0xf2, 0x25, 0xc1, 0x8d, 0xaa, 0x4a, 0x04, 0xed, 0xcc, 0x9b, 0x9d, 0xdc, 0x29,
+	0x97, 0x98, 0x9d, 0xef, 0xc0, 0xda, 0xb9, 0x3d, 0x74, 0x06, 0x68, 0x75, 0x07, 0xdc, 0x28, 0xe5,
+	0x3e, 0xba, 0x6a, 0x0c, 0x26, 0x83, 0x74, 0x07, 0x72, 0xa6, 0xfd, 0xe2, 0xd0, 0x1f, 0xbe, 0x2e,
+	0x2b, 0x7c, 0xca, 0x2d, 0x59, 0xc1, 0x53, 0xc6, 0xef, 0x6b, 0xb0, 0x82, 0x7b, 0xfd, 0x42, 0xbf,
+	0xd6, 0x26, 0x08, 0x47, 0x56, 0xca, 0xad, 0xd5, 0x80, 0x42, 0xe8, 0xf1, 0xe0, 0x2d, 0xa1, 0x50,
+	0x47, 0x69, 0xe4, 0x93, 0xf0, 0xd9, 0x49, 0x85, 0x5a, 0x24, 0x51, 0x9f, 0x8d, 0x1c, 0x76, 0xf5,
+	0xd5, 0x94, 0x07, 0xcf, 0xf8, 0x4f, 0x1a, 0x14, 0xb1, 0x31, 0xdc, 0x13, 0xf8, 0x39, 0x43, 0x1f,
+	0xa4, 0xbb, 0x36, 0x9b, 0x74, 0xd7, 0xde, 0x80, 0x22, 0x77, 0xa2, 0xc5, 0x71, 0x68, 0x31, 0x00,
+	0xb1, 0x64, 0x13, 0x77, 0x70, 0x1b, 0x14, 0x87, 0x78, 0x11, 0x00, 0xfb, 0x2c, 0x43, 0xce, 0x84,
+	0x82, 0x1f, 0xa5, 0x11, 0xe7, 0x32, 0x36, 0xd8, 0xc3, 0xa5, 0x57, 0xe0, 0x7e, 0x2c, 0x99, 0x36,
+	0xfe, 0x61, 0x06, 0xd6, 0xb0, 0x5f, 0x98, 0x30, 0xd9, 0x90, 0xd9, 0x01, 0xfb, 0x33, 0xe9, 0x9d,
+	0xda, 0xfe, 0xd5, 0x54, 0xfb, 0xdf, 0xe6, 0x7a, 0x20, 0xba, 0x07, 0x44, 0x0c, 0x5d, 0x41, 0xea,
+	0x81, 0x66, 0x84, 0x49, 0xda, 0x23, 0xf9, 0xb4, 0x3d, 0xf2, 0x31, 0xcc, 0xb8, 0x7c, 0x84, 0xfb,
+	0xf7, 0x75, 0xbc, 0x43, 0xc6, 0x4f, 0x01, 0xb0, 0x3a, 0xe1, 0x68, 0x7d, 0x1d, 0x06, 0xa9, 0x8d,
+	0xce, 0x2c, 0x6c, 0xf4, 0xdb, 0x28, 0xf3, 0x42, 0x7b, 0xd8, 0x65, 0x43, 0xd6, 0x0f, 0xd9, 0x40,
+	0xae, 0xcd, 0x04, 0xd0, 0xf8, 0x07, 0x1a, 0x54, 0x3b, 0x76, 0xe8, 0x9c, 0xb3, 0x96, 0x37, 0x60,
+	0x3b, 0xe8, 0x9b, 0xd4, 0x61, 0x45, 0xd9, 0xde, 0x56, 0x24, 0xef, 0x17, 0xc8, 0x80, 0x4d, 0xc8,
+	0x0d, 0x9c, 0x13, 0x16, 0x84, 0x62, 0x3d, 0x88, 0x14, 0x6a, 0xa2, 0x63, 0x9f, 0x9d, 0x3f, 0x15,
+	0xa5, 0xc4, 0x9a, 0x57, 0x40, 0xfa, 0x1d, 0x58, 0x23, 0x0f, 0x56, 0x73, 0xec, 0xc8, 0x5c, 0x7c,
+	0x6d, 0xa4, 0xc1, 0xd8, 0xc8, 0xf2, 0x33, 0x3b, 0x18, 0x45, 0x4d, 0xc4, 0xa5, 0x36, 0x71, 0x43,
+	0x27, 0x6a, 0xa5, 0x4c, 0x72, 0xc7, 0xea, 0x68, 0xec, 0x0c, 0x99, 0x2f, 0x23, 0x53, 0x65, 0x7a,
+	0x61, 0x53, 0x6f, 0x41, 0xe9, 0x7c, 0x64, 0x45, 0xc5, 0x78, 0x53, 0xe1, 0x7c, 0xd4, 0x92, 0x05,
+	0xd5, 0x9d, 0x3f, 0xbc, 0x18, 0xb3, 0xfa, 0x6a, 0x72, 0xe7, 0xef, 0x5d, 0x8c, 0x99, 0x31, 0x84,
+	0x5a, 0xcc, 0x48, 0x21, 0x5e, 0xbf, 0x2a, 0x5c, 0xbf, 0x5a, 0xec, 0xc4, 0x4b, 0x32, 0x5b, 0xb8,
+	0x83, 0x37, 0xa3, 0xc8, 0x24, 0x6e, 0xbd, 0x2b, 0x11, 0x6a, 0xa7, 0xcc, 0x1e, 0x86, 0xa7, 0x17,
+	0x22, 0x64, 0x47, 0x26, 0x8d, 0x2e, 0x6c, 0xec, 0x8c, 0xbd, 0xa0, 0x65, 0xbb, 0x03, 0x14, 0x8f,
+	0x6c, 0x19, 0x7a, 0x9a, 0x31, 0x80, 0xcd, 0x34, 0xd1, 0x4b, 0x28, 0x27, 0x5f, 0x85, 0x6a, 0x3f,
+	0x2a, 0x89, 0x93, 0x5d, 0x98, 0x1f, 0x29, 0xa8, 0xe1, 0x43, 0x03, 0x6b, 0xe9, 0x78, 0x23, 0xc7,
+	0xb5, 0x43, 0x66, 0xb2, 0xbe, 0xe7, 0x0f, 0x96, 0xa2, 0x67, 0x2e, 0x94, 0x10, 0xc6, 0x0e, 0xd4,
+	0xd4, 0x3a, 0xb1, 0x1d, 0xb8, 0xaa, 0xa3, 0x96, 0x89, 0x69, 0x14, 0x03, 0xa2, 0xa3, 0x03, 0x5e,
+	0x03, 0x7d, 0x1b, 0x3f, 0xd3, 0x60, 0x6b, 0x6e, 0xd3, 0x2f, 0xc1, 0xa5, 0xef, 0xc2, 0x9a, 0x9b,
+	0x2c, 0x2e, 0xd6, 0xf0, 0x55, 0xcc, 0x9c, 0x6e, 0xa4, 0x99, 0xce, 0x6c, 0xfc, 0x08, 0xae, 0x47,
+	0x99, 0xd8, 0x97, 0xc3, 0xbc, 0x1e, 0x34, 0xe6, 0x55, 0x79, 0x89, 0x4e, 0xcf, 0x63, 0xa6, 0xcb,
+	0x27, 0xdb, 0x53, 0xef, 0x4b, 0x9a, 0x02, 0xdf, 0x05, 0x38, 0x8f, 0xea, 0xfa, 0x25, 0x06, 0xff,
+	0x05, 0x5c, 0x9b, 0x69, 0xef, 0x25, 0x58, 0xf0, 0x6d, 0x58, 0xc3, 0xea, 0x51, 0x1f, 0x48, 0x8e,
+	0x3b, 0x79, 0x32, 0xe2, 0x96, 0x99, 0xe9, 0x6c, 0x86, 0x17, 0x57, 0x3c, 0xf8, 0x52, 0x38, 0xf5,
+	0x2d, 0x28, 0x9d, 0xc7, 0x95, 0x91, 0x2d, 0xeb, 0x85, 0xa2, 0x8e, 0xa2, 0xc9, 0x13, 0x73, 0x59,
+	0xf4, 0x13, 0xa8, 0xcf, 0xb6, 0xf4, 0x12, 0x3c, 0xfa, 0x0e, 0xd4, 0xa8, 0xe2, 0x59, 0x26, 0xad,
+	0x49, 0x26, 0x09, 0xb8, 0x39, 0x93, 0xd1, 0x70, 0x38, 0x9b, 0x5a, 0xa7, 0x0c, 0xd5, 0x8d, 0x60,
+	0x32, 0x5c, 0x8e, 0xed, 0x8a, 0xfd, 0x44, 0xcf, 0x9f, 0x3c, 0x25, 0x67, 0xfe, 0xc8, 0x08, 0xa1,
+	0x3e, 0x5b, 0xd5, 0x25, 0x97, 0x03, 0xd2, 0xcc, 0xc4, 0x34, 0xc9, 0x95, 0x18, 0xd3, 0xa3, 0xe3,
+	0xc7, 0xa2, 0xa9, 0x82, 0x8c, 0x7d, 0x58, 0xc7, 0x5a, 0xa5, 0x11, 0xf2, 0xf9, 0xc5, 0xfd, 0x0f,
+	0x40, 0x57, 0x09, 0x5e, 0x4a, 0xd4, 0xe7, 0x12, 0x06, 0x4d, 0x55, 0xca, 0xae, 0x64, 0x04, 0xad,
+	0xf1, 0x77, 0x35, 0x80, 0x18, 0x1c, 0xf5, 0x5b, 0x53, 0xfa, 0xbd, 0x05, 0xc5, 0x38, 0x60, 0x90,
+	0x33, 0xa4, 0x70, 0x24, 0xbd, 0xa7, 0xaa, 0x27, 0x5a, 0x04, 0xa6, 0xc9, 0x34, 0x5a, 0x15, 0xf2,
+	0x9b, 0xca, 0x72, 0x93, 0xb6, 0x24, 0x61, 0x9d, 0xc9, 0x0c, 0x4f, 0x57, 0x67, 0x79, 0xda, 0x85,
+	0x8d, 0xe7, 0x63, 0xcf, 0x7e, 0x1a, 0x19, 0x19, 0xcb, 0xe0, 0x6b, 0x13, 0x2a, 0x09, 0xa2, 0x2f,
+	0xbf, 0xd6, 0xe2, 0xb2, 0x70, 0x12, 0x1b, 0x28, 0x3c, 0x65, 0xfc, 0x7b, 0x0d, 0x36, 0xd3, 0x0d,
+	0x5b, 0x82, 0x9f, 0xe0, 0x6b, 0x00, 0xb1, 0x3d, 0x55, 0xcf, 0xc6, 0x4a, 0x6a, 0xa2, 0x2e, 0x53,
+	0xc9, 0x84, 0x67, 0x4c, 0x63, 0xe6, 0x0e, 0x1c, 0xf7, 0xc4, 0x52, 0x8a, 0xae, 0x2c, 0x2a, 0xba,
+	0x2e, 0x32, 0xc7, 0x0d, 0xc7, 0x79, 0x8b, 0x79, 0x96, 0x37, 0x6f, 0xff, 0x20, 0x03, 0xba, 0x4a,
+	0x71, 0x09, 0x8c, 0x79, 0x4d, 0x4b, 0x56, 0x51, 0x83, 0xf9, 0x74, 0x93, 0xc9, 0x19, 0x1b, 0x77,
+	0x75, 0xd6, 0x03, 0x23, 0x67, 0x7f, 0x4e, 0x99, 0xfd, 0x57, 0x61, 0x15, 0x37, 0x55, 0x9f, 0xac,
+	0x8a, 0xa2, 0xc9, 0x13, 0xe8, 0xf3, 0x57, 0x78, 0x5d, 0xa0, 0x69, 0xab, 0x8e, 0xc9, 0xfb, 0x73,
+	0xc7, 0xa4, 0x48, 0xf9, 0xe6, 0x0c, 0xc0, 0x8f, 0xe0, 0xfa, 0x43, 0xef, 0x9c, 0xf9, 0x2e, 0x45,
+	0xa2, 0x88, 0x08, 0xa6, 0x65, 0x6d, 0x21, 0xe2, 0x02, 0x92, 0xdc, 0x42, 0x78, 0xd2, 0xf8, 0x45,
+	0x06, 0x1a, 0xf3, 0xea, 0x5c, 0xc2, 0x50, 0x2d, 0xac, 0x14, 0xd5, 0x74, 0x32, 0x6e, 0x2c, 0x19,
+	0x99, 0xc4, 0x35, 0xf9, 0x32, 0x01, 0x45, 0x13, 0xf4, 0xef, 0xc3, 0x1a, 0x4a, 0x17, 0x36, 0x90,
+	0xb9, 0x02, 0x92, 0x0b, 0xa5, 0xfb, 0xf7, 0xe9, 0x14, 0x71, 0x61, 0x9b, 0xef, 0xed, 0x51, 0x29,
+	0x01, 0x15, 0xce, 0xed, 0xea, 0x30, 0x01, 0xa4, 0xc3, 0x6d, 0x6a, 0x41, 0x40, 0x31, 0x4e, 0x32,
+	0x94, 0x88, 0x60, 0x3c, 0xec, 0xa9, 0xd1, 0x84, 0x2b, 0x73, 0x28, 0x5d, 0xea, 0x48, 0x25, 0x80,
+	0xab, 0x07, 0x24, 0xe5, 0xec, 0xe1, 0x27, 0x13, 0xe6, 0x2f, 0x25, 0x1a, 0x0c, 0x03, 0x6f, 0x04,
+	0x4d, 0xcb, 0x91, 0xb6, 0x22, 0x48, 0xd0, 0xee, 0xc0, 0xf8, 0x2b, 0x1a, 0xac, 0xc9, 0x5a, 0x7b,
+	0xbe, 0x73, 0x72, 0xc2, 0x89, 0xcd, 0x0d, 0xae, 0xdd, 0x84, 0xdc, 0xc8, 0x1b, 0x4c, 0x86, 0x51,
+	0x25, 0x3c, 0xf5, 0xd2, 0x38, 0x63, 0x2c, 0xc3, 0xc2, 0x53, 0x6f, 0x20, 0x46, 0x4d, 0xa4, 0x70,
+	0xd5, 0xd8, 0xfe, 0x49, 0x20, 0x4c, 0x2e, 0xfa, 0x36, 0x7e, 0x13, 0xca, 0xb2, 0x29, 0x7b, 0xe2,
+	0x54, 0x54, 0x4e, 0x09, 0x2d, 0x39, 0x25, 0x16, 0x5c, 0x1b, 0x34, 0xfe, 0x67, 0x26, 0x26, 0x41,
+	0xc6, 0x40, 0xaa, 0xff, 0x5a, 0xba, 0xff, 0x89, 0xad, 0x28, 0x93, 0xda, 0x8a, 0x62, 0xf3, 0x8d,
+	0xf7, 0x4a, 0xa4, 0xc8, 0xb0, 0xf4, 0xc2, 0xe8, 0x34, 0x5d, 0x1a, 0x96, 0x5e, 0x28, 0xcf, 0xcf,
+	0xef, 0x40, 0x2d, 0x40, 0xc7, 0x24, 0xe5, 0x4a, 0x48, 0x8e, 0x2a, 0xc2, 0x51, 0x91, 0x12, 0xc2,
+	0xe3, 0x0e, 0xd4, 0x46, 0x8e, 0xcb, 0x33, 0x8e, 0x99, 0xdf, 0x67, 0xc2, 0x0d, 0x5b, 0x34, 0xab,
+	0x23, 0xc7, 0xc5, 0x8c, 0x07, 0x1c, 0x8a, 0xa2, 0x8c, 0x72, 0x85, 0xa7, 0x3e, 0x0b, 0x30, 0x1c,
+	0x4d, 0xc8, 0x96, 0x0a, 0x42, 0x7b, 0x12, 0xa8, 0xbf, 0x0f, 0xf9, 0x90, 0x0f, 0xa3, 0xf0, 0xcd,
+	0x52, 0x00, 0x64, 0x6a, 0x84, 0x4d, 0x99, 0x47, 0xff, 0x2a, 0x5e, 0x04, 0xed, 0x9f, 0x71, 0x29,
+	0x53, 0xba, 0x5f, 0x53, 0x33, 0x93, 0x83, 0x87, 0xa3, 0xa3, 0xe1, 0x02, 0x65, 0xb8, 0x7e, 0xa6,
+	0xc1, 0x46, 0x6a, 0xc2, 0x2e, 0x41, 0x0e, 0xfc, 0xaa, 0x1c, 0x10, 0x11, 0xcb, 0x91, 0x6a, 0x14,
+	0x59, 0x4f, 0x51, 0x0e, 0xe3, 0x5f, 0x68, 0x50, 0x13, 0x87, 0xfd, 0x07, 0xad, 0x65, 0x2c, 0x98,
+	0xf7, 0x31, 0x62, 0x4f, 0x44, 0x32, 0x65, 0x17, 0xc5, 0x6c, 0x44, 0x59, 0x92, 0x11, 0x4c, 0x2b,
+	0xaf, 0x8a, 0x60, 0x5a, 0x9d, 0x89, 0x60, 0x32, 0xfe, 0x3c, 0xac, 0x2b, 0xed, 0x5f, 0x02, 0xff,
+	0xee, 0x61, 0x07, 0x38, 0x9d, 0x7a, 0x36, 0xf6, 0x4f, 0xc8, 0x0e, 0x70, 0x8c, 0x19, 0xe5, 0x31,
+	0xfe, 0x28, 0x03, 0x15, 0x89, 0xe4, 0xec, 0xc3, 0x83, 0x73, 0x5a, 0xd8, 0xea, 0x71, 0x08, 0x70,
+	0x10, 0x6d, 0x97, 0x33, 0x27, 0x26, 0x99, 0x39, 0x27, 0x26, 0x48, 0x85, 0x96, 0xba, 0xba, 0xef,
+	0x02, 0x07, 0x51, 0x86, 0x0f, 0xc4, 0x94, 0xe2, 0x3a, 0xc7, 0xd6, 0x0c, 0x97, 0xef, 0x35, 0xfd,
+	0x13, 0x21, 0x8f, 0x29, 0x23, 0x06, 0x7b, 0x45, 0x81, 0x2c, 0x74, 0x37, 0x40, 0x8a, 0x78, 0x1a,
+	0x21, 0x19, 0xc2, 0xb2, 0x87, 0x18, 0xb3, 0xea, 0xab, 0xc9, 0x20, 0x15, 0x31, 0x19, 0x09, 0x8c,
+	0xc6, 0xb7, 0xa0, 0x18, 0x55, 0xf3, 0x2a, 0x61, 0x5d, 0x56, 0x85, 0xf5, 0x2f, 0x32, 0x50, 0x4d,
+	0xf2, 0x14, 0xb5, 0x67, 0x11, 0x64, 0xa8, 0xcd, 0x8d, 0xb8, 0x13, 0x58, 0xbc, 0x17, 0x22, 0x43,
+	0x0c, 0x33, 0xf3, 0xa3, 0xec, 0x24, 0x1e, 0xa5, 0x93, 0x32, 0x98, 0x18, 0xc0, 0x10, 0xa5, 0xf1,
+	0xe4, 0xe6, 0xc4, 0x0e, 0xac, 0x49, 0xc0, 0x06, 0x52, 0x6b, 0x39, 0xb1, 0x83, 0xc3, 0x80, 0x0d,
+	0x12, 0x93, 0x78, 0xf5, 0xd5, 0x93, 0xf8, 0x3e, 0x14, 0x25, 0xd5, 0xa0, 0x9e, 0x8b, 0xbd, 0x16,
+	0xad, 0x28, 0x5e, 0x8f, 0x23, 0xcd, 0x38, 0x1b, 0x9e, 0x5c, 0x4e, 0xa4, 0x73, 0x5b, 0x46, 0x37,
+	0x25, 0xa2, 0x2a, 0x15, 0xb4, 0x7e, 0x0f, 0x4a, 0x93, 0xc8, 0x17, 0x2a, 0xbd, 0xa8, 0xc9, 0xc0,
+	0x4a, 0x35, 0x83, 0x31, 0x06, 0x88, 0xf9, 0x46, 0x33, 0x7d, 0xd2, 0x3f, 0x63, 0x61, 0x14, 0x12,
+	0x4e, 0x29, 0x39, 0x5c, 0x7c, 0x68, 0xf0, 0x33, 0x11, 0x6e, 0x9b, 0x7d, 0x59, 0xb8, 0xed, 0x4a,
+	0xda, 0x59, 0xff, 0x04, 0x4a, 0xca, 0x00, 0x5c, 0xa2, 0xca, 0x68, 0x86, 0x64, 0x95, 0x19, 0x82,
+	0xa6, 0x42, 0xc2, 0x3f, 0x8c, 0x72, 0xe2, 0x40, 0x46, 0xbb, 0x0a, 0x9a, 0x31, 0x00, 0xa5, 0x2b,
+	0x66, 0x17, 0x74, 0xe9, 0xdb, 0xf8, 0x3e, 0xac, 0x1d, 0x30, 0x7f, 0xe4, 0x04, 0xa8, 0x87, 0x3e,
+	0xf1, 0x06, 0x6c, 0x88, 0x6e, 0x47, 0x1f, 0x77, 0x5f, 0x8d, 0x22, 0xdb, 0x69, 0x59, 0xc7, 0x59,
+	0xcc, 0xc9, 0x90, 0x99, 0x84, 0x47, 0xfb, 0xc8, 0xee, 0xf7, 0xd9, 0x38, 0x7c, 0xaa, 0x9c, 0x55,
+	0xab, 0x20, 0xe3, 0x3a, 0xac, 0x36, 0xcf, 0xba, 0xbc, 0x43, 0xf6, 0x19, 0x9f, 0xb0, 0x45, 0x13,
+	0x3f, 0x8d, 0xbf, 0xa9, 0x41, 0x8e, 0x70, 0x18, 0x83, 0xb2, 0x12, 0xb0, 0x68, 0x3a, 0xd3, 0x94,
+	0xe0, 0x98, 0x7b, 0xf8, 0x47, 0x2c, 0x4d, 0xcc, 0x81, 0x9a, 0x2d, 0x9b, 0x8e, 0xd1, 0xf4, 0x89,
+	0x5d, 0xc9, 0x0a, 0xa4, 0xb1, 0x0d, 0xc5, 0xa8, 0xc8, 0x9c, 0x65, 0x76, 0x2b, 0x79, 0xc2, 0x5f,
+	0x8c, 0x6a, 0x52, 0x57, 0xdc, 0x1f, 0x6b, 0x90, 0x6d, 0xf6, 0x87, 0xfa, 0x5b, 0x90, 0x19, 0x8f,
+	0xea, 0x9a, 0xb2, 0xb9, 0x25, 0xd9, 0x64, 0x66, 0xc6, 0x23, 0xfd, 0x1b, 0x50, 0xb4, 0xcf, 0x82,
+	0x67, 0xf2, 0xba, 0x62, 0x74, 0x2a, 0xd8, 0xec, 0x0f, 0xef, 0x35, 0x25, 0x82, 0xf7, 0x20, 0xce,
+	0x88, 0x72, 0xd7, 0xa6, 0x0e, 0xaa, 0x27, 0xec, 0xbc, 0xcb, 0xa6, 0xc0, 0x60, 0xb8, 0x43, 0x92,
+	0xc0, 0xa5, 0xc2, 0x04, 0xfe, 0x9b, 0x06, 0xc5, 0x66, 0x7f, 0xb8, 0x84, 0xb8, 0x19, 0x3e, 0xc8,
+	0x28, 0xc4, 0x3a, 0xb1, 0x7c, 0x55, 0x41, 0xba, 0x01, 0x09, 0x89, 0x2c, 0xd5, 0x66, 0x15, 0x86,
+	0x03, 0x17, 0x8b, 0x64, 0xf9, 0x50, 0x41, 0x0c, 0x21, 0x7f, 0x1a, 0x8f, 0x82, 0x64, 0x03, 0x12,
+	0x9d, 0x05, 0x33, 0x06, 0xe8, 0xd7, 0x21, 0x6b, 0xf7, 0x87, 0xe2, 0xce, 0x7d, 0x5e, 0xf0, 0xd7,
+	0x44, 0x98, 0xf1, 0x17, 0x35, 0x28, 0xef, 0x0e, 0x98, 0x1b, 0x3a, 0xe1, 0x45, 0x73, 0x12, 0x9e,
+	0x46, 0x11, 0x66, 0xda, 0xdc, 0x08, 0xb3, 0x4c, 0x22, 0xc2, 0x0c, 0xb5, 0x8d, 0xf8, 0xe1, 0x05,
+	0xfa, 0xa6, 0xbc, 0x8c, 0xf9, 0xbb, 0x3b, 0x52, 0x91, 0xe4, 0xa9, 0xe4, 0x21, 0x8e, 0x3c, 0xe4,
+	0x92, 0x00, 0xe3, 0x9b, 0x50, 0x51, 0x5b, 0x11, 0xe8, 0x6f, 0xc3, 0x0a, 0x6e, 0xbf, 0x62, 0x4e,
+	0x93, 0x6a, 0xa1, 0x66, 0x30, 0x09, 0x6b, 0x3c, 0x86, 0x4a, 0x62, 0x3f, 0xc1, 0x62, 0x74, 0x42,
+	0xc0, 0x97, 0x5e, 0x4d, 0xdd, 0x70, 0xf0, 0x94, 0xc0, 0x24, 0x6c, 0x7c, 0x83, 0x2d, 0xa3, 0xde,
+	0x60, 0x73, 0x60, 0xbd, 0xf9, 0xf8, 0x7e, 0x14, 0x69, 0xf9, 0x45, 0xba, 0xf8, 0x7e, 0x08, 0xba,
+	0x5a, 0xd5, 0xb2, 0xcd, 0xb2, 0xac, 0x6a, 0x0b, 0xfe, 0x3c, 0x0b, 0x15, 0x51, 0x53, 0xeb, 0x14,
+	0xef, 0x8d, 0xe9, 0xef, 0x26, 0x98, 0xb4, 0xc1, 0xe7, 0x83, 0x92, 0x41, 0xe1, 0x94, 0xea, 0x66,
+	0x9c, 0xbd, 0xe6, 0x9f, 0x7d, 0x9d, 0x6b, 0xfe, 0xb1, 0x95, 0xb2, 0xb2, 0x38, 0xca, 0x70, 0x35,
+	0x7d, 0xaa, 0x97, 0x50, 0xd8, 0x72, 0xaf, 0x52, 0xd8, 0xf2, 0xb3, 0x21, 0xe7, 0x33, 0xca, 0x4f,
+	0xe1, 0xd5, 0xca, 0x4f, 0x71, 0x66, 0x5d, 0x89, 0x95, 0x03, 0xb3, 0x2b, 0x07, 0x3d, 0x67, 0xf6,
+	0x60, 0xc0, 0x06, 0x96, 0x7d, 0xc6, 0x03, 0x1a, 0x8a, 0x66, 0x81, 0x00, 0xcd, 0x33, 0x32, 0x3d,
+	0x7c, 0x36, 0xf2, 0xce, 0x05, 0xba, 0x4c, 0x68, 0x10, 0xa0, 0xe6, 0x59, 0x60, 0xfc, 0x6d, 0x0d,
+	0x36, 0x04, 0xd3, 0x1f, 0x39, 0x41, 0xe8, 0x2d, 0xc7, 0x8c, 0x5c, 0x6c, 0x9c, 0x6f, 0x42, 0x4e,
+	0xd9, 0x53, 0xb3, 0xa6, 0x48, 0xc5, 0x4b, 0x61, 0x55, 0x5d, 0x0a, 0xff, 0x51, 0x83, 0xcd, 0x74,
+	0xeb, 0xbe, 0x50, 0xdf, 0xc1, 0x7b, 0x90, 0xef, 0xd3, 0xdc, 0x4b, 0xf8, 0xb6, 0x12, 0xb3, 0xd2,
+	0x94, 0x39, 0x12, 0xf7, 0x2b, 0x57, 0x93, 0xf7, 0x2b, 0x71, 0x52, 0xf9, 0x13, 0xb7, 0x6f, 0x87,
+	0xb1, 0x1c, 0x8c, 0x00, 0x78, 0xf4, 0xf5, 0x90, 0x85, 0x92, 0xaa, 0x8c, 0x30, 0xf9, 0x62, 0x5d,
+	0x31, 0x9f, 0x69, 0xb0, 0x35, 0xb7, 0xd2, 0x4b, 0xf0, 0xf3, 0x63, 0x88, 0xee, 0x64, 0xa4, 0x82,
+	0xd0, 0x74, 0x55, 0xff, 0x13, 0x0b, 0x6f, 0x2d, 0xca, 0xcb, 0x01, 0xc6, 0x1f, 0x6a, 0x50, 0x4d,
+	0xe6, 0x79, 0xbd, 0x60, 0xaa, 0x39, 0x67, 0x0c, 0xd1, 0x6d, 0x9a, 0xac, 0x72, 0x9b, 0x66, 0x0b,
+	0x8a, 0x4e, 0x60, 0x1d, 0xd9, 0xae, 0x2b, 0x54, 0x5c, 0xba, 0x3f, 0xb8, 0x4d, 0xe9, 0x57, 0x2c,
+	0x73, 0xe5, 0x24, 0x39, 0x97, 0x38, 0x49, 0x36, 0xfe, 0x5a, 0x06, 0x6e, 0x1c, 0xf8, 0xac, 0x3d,
+	0x65, 0xfd, 0x67, 0x4e, 0x78, 0xca, 0x4f, 0xcc, 0x0f, 0x7b, 0xcf, 0xf7, 0xbf, 0x50, 0xc9, 0x8c,
+	0xdb, 0x35, 0xb9, 0x8b, 0x9a, 0xb1, 0xcf, 0x20, 0x6b, 0xaa, 0x20, 0x54, 0xda, 0x71, 0x53, 0xa4,
+	0x13, 0xd6, 0x9c, 0x12, 0x4f, 0x94, 0x88, 0x33, 0x88, 0xb2, 0x24, 0x42, 0x34, 0xf2, 0xc9, 0x10,
+	0x0d, 0xfd, 0x1e, 0x86, 0xac, 0x50, 0x6f, 0x44, 0x14, 0xec, 0x55, 0x45, 0xfd, 0x8f, 0xec, 0x64,
+	0x53, 0x66, 0x32, 0xfe, 0xb9, 0x06, 0x37, 0x17, 0xf0, 0xe4, 0xcb, 0xb7, 0x48, 0xf5, 0x7b, 0xdc,
+	0xb4, 0xe0, 0xda, 0xb8, 0x08, 0xf9, 0xad, 0xca, 0x48, 0x08, 0x0e, 0x35, 0x95, 0x1c, 0xc6, 0x18,
+	0xd6, 0xbb, 0xce, 0x08, 0xef, 0xf4, 0xb1, 0xe5, 0x3c, 0x93, 0xc3, 0x5f, 0x12, 0xca, 0x2e, 0x7e,
+	0x49, 0xe8, 0xc7, 0x50, 0x11, 0x6e, 0x3e, 0xb1, 0xf3, 0xfd, 0x32, 0x17, 0x2a, 0xe9, 0xca, 0xf6,
+	0x8b, 0xc8, 0xa9, 0x29, 0x0c, 0x64, 0x97, 0xbd, 0x10, 0xa4, 0x51, 0x84, 0x0e, 0xd8, 0x30, 0xb4,
+	0xe5, 0x6d, 0x47, 0x4a, 0xa0, 0xc3, 0xae, 0xf8, 0x98, 0x5d, 0x88, 0x8a, 0x5f, 0xdf, 0x50, 0xd9,
+	0x82, 0xa2, 0x37, 0x1c, 0x58, 0xaa, 0xb1, 0x52, 0xf0, 0x86, 0x03, 0xb2, 0x07, 0x74, 0x0a, 0x14,
+	0x7f, 0x21, 0x90, 0x3c, 0xca, 0xa9, 0xe0, 0xb2, 0x17, 0x1c, 0x59, 0x87, 0xfc, 0x80, 0x0d, 0x59,
+	0x28, 0x82, 0x88, 0x0a, 0xa6, 0x4c, 0x1a, 0xff, 0x2e, 0x03, 0xba, 0xca, 0xfa, 0x25, 0x4c, 0x96,
+	0x8f, 0x60, 0x4d, 0x70, 0xc4, 0x92, 0x82, 0x5b, 0x71, 0xc3, 0x24, 0xb8, 0x6e, 0x56, 0x8f, 0xd4,
+	0x24, 0x99, 0x99, 0x78, 0x4f, 0x28, 0x29, 0xf0, 0x2b, 0x58, 0x2e, 0x62, 0x98, 0x09, 0x67, 0xf2,
+	0x13, 0x0d, 0x71, 0x79, 0xfd, 0x5e, 0x31, 0x92, 0x93, 0xf7, 0xff, 0x45, 0x86, 0x5f, 0xca, 0x44,
+	0x56, 0x0d, 0xf4, 0x7c, 0xd2, 0x40, 0xaf, 0x41, 0xf6, 0x98, 0x49, 0xd5, 0x01, 0x3f, 0x8d, 0xbf,
+	0x90, 0x85, 0x2b, 0xed, 0x20, 0x74, 0x46, 0x76, 0xc8, 0x1e, 0xb0, 0xe5, 0xb8, 0xd4, 0xdf, 0x85,
+	0x22, 0x36, 0x60, 0x4c, 0x77, 0x6a, 0xb2, 0x2f, 0xbd, 0x53, 0xf3, 0x6b, 0x50, 0x89, 0x7c, 0x2b,
+	0xc2, 0xa3, 0xb0, 0xc0, 0xb3, 0x52, 0x96, 0xf9, 0xa8, 0x23, 0xd7, 0x00, 0xaf, 0xca, 0x58, 0xd8,
+	0x19, 0x2e, 0x83, 0x73, 0xfd, 0xf1, 0xe4, 0x01, 0x63, 0x88, 0xc0, 0x8b, 0x33, 0x88, 0x10, 0x6f,
+	0x2d, 0x8d, 0xd8, 0x08, 0x11, 0xd7, 0xe9, 0x09, 0xaf, 0x33, 0xc2, 0x08, 0xae, 0x60, 0x5a, 0xa0,
+	0xe8, 0xd2, 0x8c, 0x64, 0x4d, 0xd6, 0xcc, 0x63, 0x1a, 0x51, 0x5b, 0x22, 0x94, 0x8d, 0x70, 0xfc,
+	0x56, 0x49, 0x81, 0x00, 0x88, 0x7c, 0x0b, 0x2a, 0x81, 0x7d, 0xcc, 0xc2, 0x0b, 0x6b, 0x64, 0xfb,
+	0x27, 0x8e, 0x2b, 0xee, 0x96, 0x94, 0x39, 0xf0, 0x09, 0xc1, 0x28, 0xd3, 0xe4, 0x04, 0x03, 0x83,
+	0xd8, 0x80, 0xa8, 0x94, 0x44, 0x26, 0x09, 0x7c, 0xc0, 0x98, 0xf1, 0x1c, 0x6a, 0xe9, 0x11, 0x55,
+	0xbc, 0xc0, 0x5a, 0x3a, 0x88, 0x67, 0xc4, 0x82, 0xc0, 0x3e, 0x89, 0x56, 0xb6, 0x48, 0xe2, 0x5e,
+	0x76, 0xe4, 0x0d, 0x64, 0x24, 0x21, 0x7d, 0x1b, 0xff, 0x58, 0x83, 0x92, 0x72, 0x67, 0x0f, 0xdd,
+	0xb9, 0xec, 0xf8, 0x98, 0xf5, 0x31, 0x6a, 0x28, 0xbe, 0x1f, 0x5c, 0x34, 0x2b, 0x11, 0xb4, 0x27,
+	0xde, 0xa7, 0x1b, 0xd9, 0xfe, 0x19, 0x1b, 0x88, 0x7b, 0x04, 0x22, 0xa5, 0xbf, 0x0b, 0xb5, 0xb8,
+	0x78, 0x22, 0x3a, 0x78, 0x2d, 0x82, 0xc7, 0x11, 0xc2, 0xf1, 0xdd, 0xdb, 0x64, 0x18, 0x9d, 0xf0,
+	0x3d, 0x04, 0x32, 0x84, 0xae, 0xc8, 0xed, 0x32, 0xe3, 0x13, 0x10, 0x17, 0x05, 0xf1, 0xfe, 0xdd,
+	0xe9, 0xc0, 0x52, 0xca, 0x8b, 0xbb, 0x81, 0xa7, 0x83, 0xd8, 0x7b, 0xf1, 0x16, 0x54, 0x3c, 0xdf,
+	0x39, 0x71, 0x5c, 0x7b, 0xc8, 0x6f, 0x9a, 0x70, 0xa9, 0x53, 0x96, 0x40, 0xbc, 0x6d, 0x62, 0xfc,
+	0xeb, 0x0c, 0xd4, 0x28, 0x92, 0x8d, 0x8e, 0xf5, 0xc5, 0xcb, 0x01, 0x5f, 0xac, 0xfd, 0xfb, 0x6b,
+	0x50, 0xf5, 0xc6, 0xcc, 0x8d, 0x6b, 0x4d, 0xef, 0x25, 0x1c, 0x6a, 0xa6, 0x72, 0xe9, 0x1f, 0x41,
+	0x8d, 0x9f, 0xff, 0x28, 0x25, 0x57, 0xe7, 0x96, 0x9c, 0xc9, 0x87, 0x65, 0xf9, 0x55, 0x68, 0xa5,
+	0x6c, 0x6e, 0x7e, 0xd9, 0x74, 0x3e, 0xb4, 0xd7, 0x07, 0x4e, 0x30, 0x1e, 0xda, 0x17, 0x2d, 0xe5,
+	0x36, 0x7a, 0x02, 0x66, 0x9c, 0x01, 0x28, 0x25, 0x6e, 0x00, 0xdd, 0x73, 0x6c, 0x29, 0x47, 0x24,
+	0x31, 0x00, 0x6d, 0x7b, 0x4c, 0x34, 0xd5, 0x83, 0x12, 0x05, 0xa2, 0xdf, 0x82, 0x15, 0x27, 0x64,
+	0x23, 0xf5, 0x4a, 0x34, 0xd2, 0x7e, 0xcc, 0x2e, 0x4c, 0x42, 0x18, 0x5d, 0xc8, 0x0b, 0x80, 0x1a,
+	0x04, 0x2b, 0x23, 0xf3, 0x78, 0x52, 0x31, 0x00, 0xc4, 0xf8, 0xf0, 0x94, 0xe2, 0x71, 0xcd, 0x26,
+	0x8e, 0x68, 0x7e, 0x96, 0x81, 0xe2, 0x01, 0x3f, 0xcb, 0xec, 0x4d, 0xe7, 0xde, 0xe4, 0x4e, 0x98,
+	0x68, 0x99, 0xb4, 0x89, 0xc6, 0x3d, 0x12, 0x22, 0xde, 0x9b, 0x9b, 0xa4, 0x31, 0x60, 0xc1, 0x9d,
+	0xdc, 0x95, 0x45, 0x77, 0x72, 0xd1, 0xfd, 0x75, 0x22, 0x45, 0x17, 0x7e, 0xea, 0x77, 0x60, 0x15,
+	0xd7, 0x37, 0x97, 0x5a, 0x91, 0x93, 0x4d, 0x34, 0x17, 0x95, 0x5c, 0x66, 0xf2, 0x0c, 0xc8, 0x92,
+	0xb1, 0xed, 0xd3, 0xf6, 0x91, 0x27, 0xd7, 0xac, 0x4c, 0x0a, 0xfd, 0xa1, 0xb0, 0x58, 0x7f, 0xf8,
+	0x85, 0x06, 0xeb, 0x11, 0xd1, 0xa5, 0xd8, 0x09, 0x09, 0x9e, 0x65, 0xd3, 0x3c, 0x53, 0x0f, 0xe8,
+	0x56, 0x66, 0x0f, 0xe8, 0xbc, 0x38, 0x82, 0x79, 0x8e, 0x01, 0x97, 0x53, 0x0c, 0xb8, 0x99, 0x57,
+	0x59, 0xf2, 0xb3, 0xaf, 0xb2, 0xfc, 0x23, 0x0d, 0x74, 0xb5, 0x73, 0x4b, 0xd8, 0xc8, 0xb6, 0xa0,
+	0x38, 0xf6, 0xbc, 0x21, 0xbf, 0x33, 0x20, 0xae, 0x5a, 0x22, 0x80, 0xee, 0x0b, 0xa0, 0x1c, 0xb6,
+	0xc3, 0xfe, 0x69, 0xec, 0x06, 0x17, 0x49, 0xfd, 0x16, 0x64, 0xc3, 0xa9, 0xdc, 0xdc, 0x2b, 0x89,
+	0x91, 0x34, 0x11, 0x63, 0xfc, 0x25, 0x0d, 0x6a, 0x31, 0xe8, 0x0b, 0x7a, 0x60, 0x31, 0xcd, 0xb2,
+	0x95, 0x59, 0x96, 0xb9, 0xca, 0x74, 0x58, 0x0a, 0xc3, 0x6e, 0x2a, 0x1a, 0x6c, 0xaa, 0xe3, 0x38,
+	0xff, 0xfe, 0xa5, 0x06, 0xd7, 0xc4, 0xc3, 0x27, 0xbd, 0xe9, 0x92, 0xdd, 0x04, 0xf3, 0xcd, 0x9f,
+	0x4d, 0xc8, 0xf5, 0x27, 0x7e, 0x20, 0x0e, 0xc9, 0xca, 0xa6, 0x48, 0xcd, 0x77, 0x13, 0xcc, 0xb0,
+	0x2c, 0x37, 0xcb, 0xb2, 0xbf, 0x81, 0x8e, 0x54, 0xd9, 0x85, 0x4b, 0x3e, 0x08, 0xb1, 0xe8, 0x79,
+	0x91, 0xdb, 0xb0, 0xea, 0x7b, 0x43, 0xa1, 0x39, 0x56, 0x79, 0xcf, 0x7b, 0x53, 0xd3, 0x1b, 0x32,
+	0x93, 0x23, 0xc4, 0xca, 0x5e, 0x5d, 0xbc, 0xb2, 0xff, 0x50, 0x83, 0xfa, 0x2c, 0x67, 0x97, 0xe4,
+	0xe2, 0x98, 0xcf, 0x5a, 0x31, 0xcb, 0x15, 0x6d, 0x37, 0x6a, 0x00, 0xcd, 0xf2, 0xe8, 0x6d, 0x28,
+	0x31, 0x00, 0x3c, 0x56, 0x9e, 0xde, 0x86, 0x6a, 0x11, 0xc4, 0x38, 0x84, 0x6b, 0xaa, 0x19, 0x8f,
+	0xef, 0xcc, 0x2e, 0x23, 0x9e, 0xe7, 0x33, 0x0d, 0xea, 0xb3, 0x74, 0x97, 0xc0, 0x8b, 0x3b, 0xb0,
+	0x32, 0xb0, 0xa3, 0x2b, 0xa3, 0x57, 0xd3, 0xae, 0x0a, 0xaa, 0x87, 0x72, 0x18, 0xbf, 0x03, 0xb5,
+	0x34, 0x06, 0xb7, 0x59, 0x5b, 0x3a, 0x4d, 0xe4, 0xbe, 0x99, 0x35, 0x13, 0x30, 0xf1, 0x20, 0x28,
+	0x95, 0x6b, 0x29, 0xf7, 0xbd, 0x92, 0x40, 0x7a, 0xf8, 0x30, 0xbe, 0x66, 0x74, 0xec, 0x2d, 0x63,
+	0x09, 0xcd, 0x38, 0x50, 0xb2, 0x73, 0x1c, 0x28, 0x64, 0xae, 0xd1, 0xfa, 0x18, 0xc8, 0x97, 0xc0,
+	0x0a, 0x7c, 0x71, 0x0c, 0x98, 0xf1, 0x97, 0xb3, 0x74, 0xc9, 0x48, 0x69, 0xd5, 0x12, 0x58, 0xfe,
+	0x5a, 0xcd, 0x8a, 0xe3, 0x43, 0x56, 0x12, 0xf1, 0x21, 0x8a, 0x33, 0x66, 0x75, 0x71, 0x58, 0x7f,
+	0x2e, 0x15, 0xd6, 0x9f, 0x0a, 0xdf, 0xcf, 0xcf, 0x84, 0xef, 0x6f, 0xa1, 0x26, 0x30, 0x10, 0x77,
+	0xdd, 0xf9, 0x2d, 0xf4, 0x02, 0x02, 0xe8, 0xb2, 0xbb, 0xe2, 0x38, 0x2b, 0x26, 0x5d, 0x82, 0xb7,
+	0xa0, 0x34, 0x60, 0xe3, 0xa1, 0x77, 0xc1, 0xd5, 0x6e, 0xe0, 0xcb, 0x81, 0x83, 0x7a, 0x42, 0x60,
+	0x4f, 0xc6, 0x27, 0xbe, 0x3d, 0x10, 0x8a, 0x79, 0x89, 0xab, 0xbc, 0x02, 0x46, 0x59, 0x50, 0x6f,
+	0x38, 0x72, 0xc4, 0x03, 0xca, 0xf8, 0x89, 0x12, 0x88, 0x46, 0xa3, 0xc2, 0x25, 0x10, 0x7e, 0x1b,
+	0xff, 0x47, 0x83, 0x6b, 0x78, 0x67, 0x43, 0x8e, 0x06, 0xbe, 0x74, 0xf3, 0xa5, 0xcd, 0x11, 0x3c,
+	0x2f, 0xf1, 0xd9, 0xb1, 0x33, 0x95, 0x12, 0x97, 0xa7, 0x14, 0x49, 0xbc, 0x3a, 0x5f, 0x12, 0xa7,
+	0xf7, 0xfb, 0x49, 0xc0, 0xac, 0xc0, 0xb5, 0xc7, 0xc1, 0xa9, 0x17, 0xed, 0xf7, 0x93, 0x80, 0x75,
+	0x05, 0x48, 0x91, 0xa6, 0x85, 0xc4, 0xb3, 0xa0, 0xdf, 0x00, 0x88, 0x3a, 0xfe, 0x54, 0x3d, 0x24,
+	0x2b, 0xbf, 0xe4, 0x6c, 0x1d, 0x03, 0x3a, 0xea, 0xb3, 0x3c, 0xfb, 0xb2, 0x66, 0xf0, 0x6d, 0xc8,
+	0x9e, 0x9d, 0x4b, 0x59, 0x5a, 0x55, 0x05, 0xcb, 0xe3, 0xa7, 0x26, 0xa2, 0x5e, 0x2d, 0x4c, 0xff,
+	0x89, 0x06, 0x57, 0x49, 0x4f, 0x6c, 0x8a, 0x00, 0xc1, 0x25, 0xdd, 0xb4, 0x15, 0x6e, 0x9e, 0xec,
+	0x3c, 0x37, 0xcf, 0x4a, 0xcc, 0xd5, 0x78, 0x34, 0x56, 0x17, 0x3d, 0x8c, 0x98, 0x4b, 0xec, 0x86,
+	0xc6, 0xbf, 0xd1, 0x60, 0x23, 0xd5, 0xe0, 0xe5, 0x3c, 0xe1, 0xf5, 0x9a, 0x2d, 0x8e, 0xe6, 0xc1,
+	0xaa, 0x32, 0x0f, 0x16, 0xb7, 0x57, 0xe9, 0x61, 0x3e, 0x31, 0xdf, 0xfe, 0x7a, 0xac, 0xd4, 0x2c,
+	0xdd, 0x05, 0x3f, 0x7f, 0xe7, 0x7d, 0x0d, 0xcd, 0xee, 0x31, 0x94, 0x65, 0x63, 0xe8, 0xf6, 0xd6,
+	0x77, 0x20, 0xf2, 0xa2, 0x5b, 0x91, 0x57, 0x61, 0x91, 0xc3, 0xbd, 0xda, 0x4f, 0xa4, 0x8d, 0xff,
+	0x1c, 0x2b, 0x17, 0xbf, 0x9c, 0xbf, 0x7f, 0x57, 0xb5, 0x9d, 0xb8, 0xa3, 0xff, 0x3d, 0x45, 0x61,
+	0x98, 0x21, 0x1a, 0x35, 0x48, 0xbe, 0x15, 0x10, 0x95, 0x6e, 0x74, 0xa0, 0x9a, 0x44, 0xce, 0x39,
+	0x06, 0xff, 0x6a, 0xf2, 0x58, 0xbf, 0xa6, 0x76, 0x11, 0xb9, 0xa1, 0x1e, 0x8c, 0xff, 0x33, 0x7c,
+	0xe6, 0xd3, 0xf7, 0x82, 0x20, 0x11, 0xfa, 0xb8, 0xe8, 0x35, 0xc0, 0x84, 0xf3, 0x3f, 0xf3, 0xd2,
+	0x33, 0xbe, 0xec, 0xab, 0xce, 0xf8, 0x56, 0x66, 0xcf, 0xf8, 0xde, 0x4b, 0xfb, 0xcf, 0xe7, 0x84,
+	0xcf, 0xc8, 0x1c, 0xc6, 0x03, 0xd0, 0xd5, 0x86, 0x8b, 0xe1, 0xf8, 0x50, 0x71, 0x7a, 0x6b, 0xb3,
+	0x7a, 0xca, 0x9c, 0x40, 0x2c, 0xe4, 0x28, 0xd2, 0xa1, 0x3b, 0xe4, 0xf4, 0x46, 0x85, 0xae, 0x9c,
+	0xa7, 0x16, 0xc5, 0xc1, 0xa9, 0x08, 0x18, 0x64, 0xee, 0xc0, 0x43, 0x71, 0xa3, 0x84, 0xd7, 0x63,
+	0xc0, 0x60, 0x5b, 0x80, 0x3b, 0x93, 0x91, 0xf1, 0x14, 0x2a, 0x44, 0x4f, 0xc2, 0x5e, 0xe2, 0xa4,
+	0xbe, 0x06, 0xf9, 0xf1, 0xe4, 0xc8, 0x92, 0x1e, 0xe3, 0x22, 0x1d, 0xc4, 0x0b, 0xe7, 0xd0, 0xa9,
+	0x17, 0xc8, 0x35, 0x4c, 0xdf, 0x46, 0x08, 0xd5, 0xb8, 0xbf, 0xd4, 0xce, 0xaf, 0x01, 0xf0, 0xa7,
+	0x78, 0xe8, 0x21, 0x0f, 0xe5, 0x52, 0x5c, 0xb2, 0x3f, 0x66, 0xb1, 0x1f, 0x75, 0xed, 0x03, 0x28,
+	0xca, 0x2e, 0xc8, 0x99, 0xb8, 0x1e, 0x95, 0x90, 0x2d, 0x36, 0xe3, 0x3c, 0x78, 0xe5, 0x40, 0xa9,
+	0x96, 0x7c, 0x53, 0x1f, 0xc4, 0xa3, 0xc4, 0xeb, 0xdc, 0x88, 0x28, 0xa8, 0x93, 0x28, 0x1a, 0x29,
+	0xfd, 0xbe, 0x32, 0x26, 0x99, 0xf8, 0xe2, 0xfa, 0xec, 0xe8, 0xc5, 0xa3, 0x82, 0x4f, 0x8f, 0xf0,
+	0x87, 0xc1, 0xb2, 0x8b, 0xae, 0x7e, 0x72, 0xbc, 0xd1, 0x85, 0x4a, 0xc2, 0x75, 0x9c, 0x30, 0xba,
+	0xb5, 0x94, 0xd1, 0x2d, 0xef, 0x62, 0x66, 0x94, 0xbb, 0x98, 0x73, 0xbc, 0x86, 0x77, 0xff, 0x47,
+	0x0e, 0xd6, 0x52, 0x8f, 0x57, 0xe2, 0x1b, 0xe3, 0xdd, 0xc3, 0x56, 0xab, 0xdd, 0xed, 0xd6, 0xbe,
+	0xa2, 0xd7, 0xa0, 0x7c, 0xd8, 0x79, 0xdc, 0xd9, 0x7f, 0x66, 0xf1, 0x97, 0xc9, 0x35, 0x5d, 0x87,
+	0x6a, 0x6b, 0xbf, 0xd3, 0x69, 0xb7, 0x7a, 0x96, 0xd9, 0x7e, 0x70, 0xd8, 0x6d, 0xd7, 0x32, 0xfa,
+	0x75, 0xd8, 0xe8, 0xec, 0xf7, 0xac, 0x76, 0x67, 0xff, 0xf0, 0xe1, 0x23, 0x0b, 0x0f, 0x76, 0x44,
+	0xf6, 0xac, 0x6e, 0xc0, 0x1b, 0x98, 0x7e, 0xfa, 0xc4, 0x6a, 0xee, 0x99, 0xed, 0xe6, 0xce, 0xa7,
+	0xd6, 0x61, 0xa7, 0xb5, 0xdf, 0x79, 0xb0, 0x6b, 0x3e, 0x11, 0x79, 0x56, 0xf4, 0x06, 0x6c, 0x8a,
+	0x3c, 0x48, 0xe5, 0xc1, 0xfe, 0x61, 0x67, 0x47, 0xe0, 0x56, 0xf5, 0xdb, 0x70, 0x63, 0xb7, 0x73,
+	0x70, 0xd8, 0xb3, 0xf6, 0x0f, 0x7b, 0xf8, 0x8f, 0xea, 0xf9, 0xe4, 0xb0, 0xb9, 0x27, 0x72, 0xe4,
+	0xf4, 0x4d, 0xd0, 0x7b, 0xcf, 0x67, 0x4a, 0xe6, 0xf5, 0x75, 0xa8, 0xf4, 0x9e, 0x5b, 0xdd, 0xdd,
+	0x87, 0x1d, 0x01, 0x2a, 0xe8, 0xd7, 0xe0, 0xca, 0xf6, 0xde, 0x7e, 0xeb, 0x71, 0xeb, 0x51, 0x73,
+	0xb7, 0x83, 0x45, 0xf8, 0x53, 0xea, 0x45, 0xec, 0xd4, 0xd3, 0xe6, 0xde, 0xee, 0x4e, 0xb3, 0xd7,
+	0x16, 0x99, 0x41, 0xdf, 0x82, 0x6b, 0xad, 0x66, 0x07, 0xe9, 0x76, 0x3f, 0xed, 0xb4, 0x2c, 0x2a,
+	0x28, 0x90, 0x25, 0xa4, 0x24, 0x7b, 0xa1, 0x22, 0xca, 0xfa, 0x06, 0xac, 0x8b, 0xbe, 0x1c, 0xec,
+	0x35, 0x3f, 0x15, 0xe0, 0x8a, 0x5e, 0x05, 0x78, 0xd6, 0xdc, 0x93, 0xd9, 0xaa, 0xfa, 0x15, 0x58,
+	0x43, 0xca, 0x9c, 0x23, 0x1c, 0xb8, 0x86, 0x65, 0x05, 0x31, 0x6c, 0x96, 0x00, 0xd7, 0x90, 0x3d,
+	0xe6, 0xfe, 0x7e, 0xcf, 0x9a, 0xc5, 0xad, 0x8b, 0xce, 0xef, 0x1c, 0x1e, 0xec, 0xed, 0xb6, 0xe2,
+	0xc6, 0x5f, 0xc1, 0x11, 0xe9, 0xb6, 0xcd, 0xa7, 0xbb, 0xad, 0xb6, 0x18, 0x25, 0xc9, 0x97, 0xab,
+	0x58, 0x4b, 0xef, 0xf9, 0x4e, 0xb3, 0xd7, 0x54, 0x79, 0xb3, 0x81, 0x23, 0x8d, 0xec, 0xda, 0x93,
+	0x34, 0xae, 0x23, 0x03, 0x7a, 0xcf, 0xad, 0x07, 0xed, 0xb6, 0xa5, 0x0c, 0x2e, 0x47, 0x36, 0xb0,
+	0x03, 0x34, 0xce, 0x0a, 0x8d, 0x1b, 0xfa, 0x55, 0xa8, 0xed, 0x1c, 0xec, 0x77, 0xad, 0x4f, 0x0e,
+	0xdb, 0xa6, 0xec, 0xd6, 0x2d, 0xe4, 0x95, 0xf9, 0xac, 0xdb, 0xee, 0x59, 0xbb, 0x1d, 0x62, 0xb2,
+	0x40, 0xbc, 0xc9, 0x11, 0xcd, 0xd6, 0x5e, 0x0a, 0x61, 0xe8, 0x75, 0xb8, 0xfa, 0xb0, 0xd9, 0x9d,
+	0xad, 0xf6, 0x2d, 0xfd, 0x06, 0xd4, 0x7b, 0xcf, 0xad, 0xa7, 0x6d, 0xb3, 0xbb, 0xbb, 0xdf, 0x49,
+	0x95, 0x7b, 0x5b, 0x7f, 0x13, 0x6e, 0xb6, 0xf6, 0x9f, 0x1c, 0xec, 0xed, 0x36, 0x3b, 0xad, 0xb6,
+	0xd5, 0x7a, 0xd4, 0x6e, 0x3d, 0x26, 0x22, 0xcd, 0x83, 0x03, 0x73, 0xff, 0x69, 0x7b, 0xa7, 0xf6,
+	0x2b, 0x98, 0xa5, 0xd9, 0x6a, 0xed, 0x1f, 0x76, 0x7a, 0x56, 0x6b, 0xbf, 0xd3, 0x33, 0x9b, 0xad,
+	0x9e, 0xd5, 0xed, 0x35, 0x7b, 0x87, 0x5d, 0x41, 0xe5, 0xab, 0xc8, 0x3b, 0x5e, 0xc7, 0xee, 0x03,
+	0x64, 0x2a, 0x56, 0xc4, 0x51, 0x77, 0xb0, 0xfa, 0x03, 0x73, 0xff, 0x60, 0xbf, 0xdb, 0xdc, 0x9b,
+	0x99, 0x71, 0xef, 0x22, 0x36, 0xa2, 0x99, 0xc6, 0xde, 0xbd, 0xcb, 0x60, 0x7d, 0x26, 0xd8, 0x44,
+	0x2f, 0x43, 0xe1, 0xb0, 0xb3, 0xd3, 0x7e, 0xb0, 0xdb, 0x69, 0xd7, 0xbe, 0xa2, 0x3e, 0xef, 0xaf,
+	0x61, 0x42, 0x4c, 0xb1, 0x5a, 0x46, 0xaf, 0x40, 0xf1, 0xc1, 0xa1, 0xc9, 0x5b, 0x53, 0xcb, 0x62,
+	0x32, 0x5a, 0x46, 0xb5, 0x15, 0xfc, 0x89, 0x80, 0x07, 0xcd, 0xdd, 0xbd, 0xf6, 0x4e, 0x6d, 0xf5,
+	0xee, 0x63, 0x80, 0xf8, 0xe9, 0x60, 0xbd, 0x00, 0x2b, 0x9d, 0x7d, 0xa2, 0x0d, 0x90, 0xdb, 0x6b,
+	0xef, 0x3c, 0x6c, 0xe3, 0x1a, 0xc6, 0x5a, 0x7b, 0xcf, 0xf7, 0x77, 0x3b, 0x0f, 0xf6, 0x6b, 0x19,
+	0x9c, 0x9b, 0xfc, 0x07, 0x06, 0x28, 0x9d, 0xc5, 0xdf, 0x1e, 0x38, 0x68, 0xb7, 0xcd, 0x6e, 0x6d,
+	0xe5, 0xee, 0x9f, 0x83, 0x6a, 0x32, 0x02, 0x90, 0x08, 0x1e, 0xee, 0xed, 0xd5, 0xbe, 0x82, 0x6b,
+	0x86, 0x06, 0xbf, 0xf7, 0xc8, 0x6c, 0x77, 0x1f, 0xed, 0xef, 0xed, 0xd4, 0x34, 0x24, 0x45, 0xb0,
+	0xe6, 0xe3, 0x6e, 0xbb, 0xc7, 0x9b, 0x4d, 0x69, 0xb3, 0xd9, 0x6b, 0xd7, 0xb2, 0x58, 0x2f, 0x25,
+	0xbb, 0x87, 0xd8, 0xea, 0x0a, 0x14, 0x5b, 0x4d, 0x0b, 0xa7, 0x69, 0x1b, 0x57, 0x3a, 0x09, 0x96,
+	0x27, 0x4f, 0x0e, 0x3b, 0xbb, 0xbd, 0x4f, 0xad, 0xa7, 0xfb, 0xbd, 0x76, 0x2d, 0x77, 0xf7, 0x5b,
+	0x50, 0x56, 0xc3, 0xa0, 0xf4, 0x3c, 0x64, 0x5b, 0x07, 0x87, 0xbc, 0x37, 0x4f, 0xda, 0x4f, 0xf6,
+	0xcd, 0x4f, 0x6b, 0x1a, 0x36, 0x69, 0x67, 0xb7, 0xfb, 0xb8, 0x96, 0xc1, 0xaf, 0xe7, 0x0f, 0xda,
+	0xed, 0x5a, 0xf6, 0xee, 0x09, 0xac, 0xcf, 0x84, 0x06, 0xe1, 0x84, 0x8e, 0xc6, 0xfe, 0x51, 0xb3,
+	0xf3, 0xb0, 0x6d, 0x29, 0xbc, 0xdf, 0x04, 0x3d, 0x85, 0x6c, 0xb6, 0xf6, 0x6a, 0x9a, 0x7e, 0x13,
+	0xae, 0xa7, 0xe0, 0x4f, 0xda, 0xbd, 0x47, 0xfb, 0x3b, 0x84, 0xce, 0xdc, 0x3d, 0x81, 0x1c, 0x77,
+	0xe1, 0xa0, 0xbc, 0x31, 0xf7, 0xf7, 0x12, 0x34, 0x75, 0xa8, 0x12, 0x68, 0xb7, 0xb3, 0xdb, 0xdb,
+	0x6d, 0xf6, 0x48, 0x7e, 0x56, 0x01, 0x04, 0xec, 0xe0, 0x10, 0x59, 0xb4, 0x06, 0x25, 0x4a, 0x73,
+	0xf9, 0x56, 0xcb, 0xe2, 0xfa, 0x24, 0x40, 0xf3, 0xb0, 0xf7, 0xc8, 0x32, 0xdb, 0x9f, 0x1c, 0xee,
+	0x9a, 0xed, 0xda, 0xca, 0xdd, 0x63, 0xa8, 0x46, 0x4e, 0x36, 0x52, 0xa7, 0x71, 0xb5, 0x1d, 0xb4,
+	0x3b, 0x3b, 0xbb, 0x9d, 0x87, 0x6a, 0x9d, 0xeb, 0x50, 0x91, 0x50, 0x92, 0x2e, 0x35, 0x0d, 0xd7,
+	0x99, 0x04, 0x3d, 0x6b, 0xee, 0xf6, 0xac, 0x83, 0xa6, 0xd9, 0xee, 0x60, 0xdd, 0x3a, 0x54, 0x25,
+	0x62, 0xdf, 0x3c, 0x78, 0xd4, 0xec, 0xd4, 0xb2, 0xf7, 0xff, 0xd7, 0xdb, 0x90, 0x7b, 0x4e, 0x1b,
+	0xa9, 0x7e, 0x08, 0xb5, 0xf8, 0xa8, 0x7e, 0xfb, 0x82, 0xbc, 0xa1, 0x15, 0xe9, 0xc6, 0xa7, 0xf0,
+	0xd9, 0x46, 0xea, 0xdc, 0xdc, 0x30, 0x7e, 0xef, 0x4f, 0xfe, 0xeb, 0xcf, 0x33, 0x37, 0x8c, 0x6b,
+	0x1f, 0x9c, 0x7f, 0xed, 0x83, 0x80, 0x0a, 0x5b, 0xf4, 0x78, 0xe2, 0xd1, 0x05, 0x79, 0x57, 0x3f,
+	0xd2, 0xee, 0xea, 0xdf, 0x83, 0xdc, 0x81, 0x17, 0x84, 0xbd, 0xa9, 0x9e, 0xf8, 0xd1, 0x9b, 0xc6,
+	0x1a, 0x57, 0x60, 0xa2, 0x07, 0xb7, 0x8d, 0x4d, 0x22, 0x56, 0x33, 0x4a, 0x48, 0x6c, 0xec, 0x05,
+	0xa1, 0x15, 0x4e, 0x91, 0xc0, 0x43, 0xc8, 0xf1, 0x5f, 0x84, 0xd1, 0x69, 0xbb, 0x4c, 0xfc, 0x7e,
+	0x4d, 0x43, 0x57, 0x41, 0x42, 0xf5, 0x49, 0x10, 0x7a, 0x61, 0x3b, 0x92, 0xd0, 0x36, 0x14, 0x68,
+	0x5f, 0x6e, 0xb6, 0xf6, 0x78, 0xc7, 0xa2, 0xd0, 0xc9, 0x46, 0x32, 0x69, 0xd4, 0x89, 0x82, 0x6e,
+	0x54, 0x90, 0xc2, 0x8f, 0xb0, 0x8c, 0x65, 0xf7, 0x87, 0x48, 0xc3, 0x82, 0x35, 0xa2, 0xa1, 0x1c,
+	0x9b, 0x5c, 0x4d, 0x1e, 0xc5, 0xf0, 0xc3, 0xa8, 0xc6, 0x5c, 0xa8, 0x71, 0x9b, 0x08, 0x37, 0x8c,
+	0x8d, 0x98, 0x30, 0xf1, 0xcb, 0xa7, 0x4c, 0x58, 0xc1, 0x0b, 0xb8, 0x82, 0x71, 0x38, 0x29, 0xa7,
+	0x9f, 0xbe, 0x95, 0xf0, 0xc4, 0x25, 0x9d, 0xac, 0x8d, 0x1b, 0xf3, 0x91, 0x82, 0x1d, 0xbf, 0x42,
+	0x75, 0xde, 0x32, 0x1a, 0x58, 0x27, 0xfe, 0x28, 0x82, 0xd0, 0xd3, 0xac, 0x70, 0x6a, 0x9d, 0xf2,
+	0xbc, 0x58, 0xf1, 0x4f, 0x60, 0x83, 0x7a, 0x36, 0xe3, 0xe1, 0xda, 0x9a, 0xeb, 0x11, 0x53, 0xab,
+	0x5e, 0xe4, 0x96, 0x33, 0xde, 0xa1, 0xaa, 0xdf, 0x34, 0x6e, 0xc4, 0xdd, 0x4d, 0xd8, 0x2b, 0x16,
+	0xba, 0xd5, 0xb0, 0xf2, 0x31, 0xd4, 0xd2, 0x66, 0x3a, 0xaf, 0x77, 0x81, 0xc3, 0xa3, 0x71, 0x63,
+	0x3e, 0x52, 0xd4, 0xfb, 0x26, 0xd5, 0xbb, 0x65, 0x6c, 0x62, 0xbd, 0xf8, 0xd0, 0x67, 0x5c, 0x2d,
+	0xbe, 0x18, 0x8c, 0x35, 0x9e, 0x41, 0xed, 0x21, 0x0b, 0x13, 0x96, 0xaa, 0xce, 0xdf, 0x27, 0x9b,
+	0x63, 0x6d, 0x37, 0xae, 0xcf, 0xc1, 0x88, 0xba, 0x12, 0x6b, 0xe0, 0x84, 0xf1, 0x8e, 0x31, 0xcb,
+	0x96, 0x97, 0xfc, 0xb0, 0xb2, 0x9f, 0xf2, 0x41, 0x4d, 0x05, 0x57, 0xe9, 0xf4, 0x93, 0x16, 0x8b,
+	0x43, 0xbd, 0x1a, 0xb7, 0x16, 0xe2, 0x45, 0xdd, 0x6f, 0x53, 0xdd, 0x6f, 0x18, 0xd7, 0xa3, 0xa1,
+	0xe5, 0x39, 0xa3, 0xee, 0x52, 0x57, 0x8f, 0x61, 0xed, 0x21, 0x0b, 0x55, 0x27, 0x9e, 0x7e, 0x2d,
+	0xf9, 0xa6, 0x51, 0xe4, 0x6c, 0x6c, 0xd4, 0x67, 0x11, 0xa2, 0xae, 0xc4, 0xd4, 0x3d, 0x61, 0x71,
+	0x1d, 0xf4, 0x10, 0x0f, 0xd6, 0xf3, 0x5d, 0xc8, 0xd3, 0x0c, 0x9a, 0x59, 0xea, 0x89, 0x94, 0x71,
+	0x8d, 0x08, 0xad, 0x1b, 0xe5, 0x78, 0x52, 0xf0, 0xf5, 0x69, 0x02, 0x3c, 0x64, 0xa1, 0xf8, 0xc5,
+	0x22, 0x5d, 0xe7, 0x85, 0xd4, 0x5f, 0x48, 0x6a, 0x5c, 0x49, 0xc0, 0x44, 0xc3, 0xb6, 0x88, 0xde,
+	0x86, 0x51, 0x93, 0x0d, 0x0b, 0xa7, 0xd6, 0x18, 0x73, 0x20, 0xcd, 0x7d, 0x28, 0xd3, 0xaf, 0x6a,
+	0xc9, 0x86, 0xe9, 0x3c, 0xee, 0x43, 0xfd, 0x9d, 0xad, 0x46, 0x59, 0x81, 0x05, 0xc6, 0x4d, 0x22,
+	0x77, 0xcd, 0xd0, 0x91, 0xdc, 0x11, 0x42, 0x2d, 0xb5, 0x91, 0x3f, 0x80, 0x2a, 0x4e, 0xbb, 0xf8,
+	0x48, 0x4a, 0xdf, 0x48, 0x9c, 0x88, 0x44, 0x83, 0xb7, 0x99, 0x06, 0x8b, 0xe6, 0xde, 0x22, 0xfa,
+	0xd7, 0x8d, 0xab, 0xd1, 0xdc, 0x94, 0xb7, 0x34, 0xc3, 0x29, 0x0d, 0xd7, 0x6f, 0x43, 0xf9, 0x21,
+	0x8b, 0x2b, 0xe0, 0xf2, 0x25, 0x7d, 0xae, 0xd4, 0xd8, 0x48, 0x41, 0x05, 0xf5, 0x44, 0xeb, 0x4f,
+	0x98, 0x4a, 0x1c, 0x69, 0x77, 0x88, 0xc5, 0x32, 0x24, 0x68, 0x5d, 0x91, 0x1b, 0x62, 0xa8, 0x66,
+	0x41, 0x46, 0x83, 0x48, 0x5e, 0x35, 0xd6, 0x24, 0x49, 0x11, 0x27, 0x83, 0xf4, 0x1c, 0xa8, 0xc5,
+	0xf4, 0xe4, 0x03, 0xfe, 0x0a, 0x89, 0xc4, 0x43, 0xf8, 0x8d, 0x85, 0x98, 0xe4, 0x82, 0x55, 0xea,
+	0xb0, 0x06, 0x44, 0x13, 0xab, 0xfa, 0x3e, 0x55, 0xc5, 0x5f, 0xbd, 0xbf, 0x5c, 0x07, 0x66, 0x88,
+	0x8b, 0x37, 0xc7, 0x95, 0x7e, 0x1c, 0x41, 0x15, 0xa7, 0x5e, 0xfc, 0xf0, 0xbc, 0x1e, 0x3f, 0xb4,
+	0x9f, 0x78, 0x4c, 0xbf, 0x71, 0x6d, 0x06, 0x3e, 0x6f, 0x5c, 0x69, 0x1a, 0x62, 0x26, 0x71, 0xc7,
+	0x13, 0xeb, 0xf8, 0x0d, 0x28, 0x20, 0xaf, 0x28, 0x5e, 0xa3, 0x14, 0x3f, 0x71, 0xb6, 0xd3, 0x28,
+	0x46, 0x89, 0xe4, 0xc6, 0x43, 0x7c, 0x40, 0x30, 0x5f, 0x1c, 0x35, 0x59, 0x7a, 0xfb, 0x42, 0xc8,
+	0x2b, 0xf5, 0x17, 0x60, 0x10, 0xa0, 0x52, 0x9a, 0x11, 0x4b, 0x44, 0x09, 0x37, 0xe6, 0x58, 0x2c,
+	0xfd, 0x76, 0x4c, 0x33, 0xd8, 0xbe, 0xa0, 0x5f, 0xeb, 0xe0, 0xb3, 0x79, 0xe6, 0xd7, 0x3b, 0x5e,
+	0x4d, 0x39, 0x40, 0xd2, 0x3e, 0x16, 0xf9, 0x48, 0xbb, 0xfb, 0xa1, 0xa6, 0xff, 0x2e, 0x09, 0x9d,
+	0xc4, 0xaf, 0xdf, 0x5c, 0x4b, 0xfd, 0x60, 0x4d, 0xb4, 0x54, 0x6a, 0x69, 0xc4, 0xac, 0xb0, 0x51,
+	0x7f, 0x64, 0x8c, 0x56, 0x09, 0x03, 0xbd, 0x1b, 0xfa, 0xcc, 0x1e, 0xbd, 0x5e, 0x15, 0xe9, 0x1f,
+	0xcb, 0x31, 0xde, 0xa2, 0x1a, 0x6e, 0x1a, 0x75, 0xac, 0x21, 0x20, 0x4a, 0x33, 0x95, 0x7c, 0xa8,
+	0xe9, 0x3e, 0x5c, 0x49, 0xec, 0x8a, 0x6d, 0xf1, 0xdb, 0x29, 0x33, 0xb1, 0x5d, 0x51, 0x4d, 0x8d,
+	0x79, 0xa8, 0x79, 0xf2, 0x3a, 0xb5, 0x1f, 0xf2, 0xd0, 0x30, 0xbe, 0x48, 0xaf, 0x48, 0xd6, 0x45,
+	0x2f, 0x00, 0xa2, 0xf1, 0xa0, 0xbe, 0xe4, 0xd8, 0x48, 0xa4, 0x66, 0x65, 0xe0, 0x51, 0x9f, 0xfb,
+	0x03, 0x91, 0xde, 0x2e, 0x54, 0x13, 0xf4, 0x04, 0x29, 0xf9, 0xcb, 0x25, 0x0a, 0x6f, 0x38, 0x5a,
+	0xce, 0x42, 0x5d, 0xa1, 0xc6, 0xdf, 0x05, 0xd5, 0x0f, 0x69, 0x54, 0xf9, 0xa3, 0x74, 0x6a, 0xb3,
+	0x22, 0x5a, 0x9b, 0xb3, 0x8f, 0xd6, 0x91, 0x72, 0x77, 0x83, 0x48, 0x6e, 0x1a, 0xeb, 0xd1, 0x2e,
+	0x79, 0x11, 0xc4, 0x2d, 0xfc, 0x5d, 0xa8, 0xe0, 0x0e, 0x25, 0x3b, 0x2b, 0x64, 0xea, 0xcc, 0xf3,
+	0x92, 0x8d, 0xcd, 0x34, 0x58, 0xf0, 0xf5, 0x0d, 0xa2, 0x5e, 0x37, 0xae, 0x44, 0x7b, 0x13, 0xe6,
+	0xa1, 0x9d, 0x98, 0xe8, 0x9f, 0x80, 0xce, 0x77, 0xc0, 0xc4, 0xb3, 0x76, 0x72, 0xaf, 0x9b, 0x79,
+	0x40, 0xaf, 0x71, 0x65, 0x06, 0x33, 0x09, 0x66, 0xe7, 0x7d, 0x74, 0xeb, 0x3f, 0xee, 0xc8, 0x3b,
+	0x50, 0x7c, 0xc8, 0xc2, 0x0e, 0x0b, 0x0f, 0xcd, 0xbd, 0x14, 0x67, 0xc8, 0x83, 0xca, 0x5f, 0xa5,
+	0x33, 0xbe, 0xa2, 0x3f, 0x06, 0x88, 0x95, 0xed, 0x57, 0xa9, 0xd9, 0x89, 0xee, 0x29, 0x6a, 0x76,
+	0x60, 0x9d, 0xdf, 0xc7, 0x5a, 0x3f, 0x85, 0x35, 0xf1, 0x74, 0x1a, 0x52, 0xa3, 0x98, 0xdd, 0x2b,
+	0x92, 0x84, 0xf2, 0xae, 0xda, 0xac, 0xca, 0x9d, 0x58, 0x66, 0x3e, 0xcf, 0xc5, 0x15, 0x52, 0x29,
+	0x76, 0x3e, 0xa3, 0x5b, 0xb9, 0x73, 0x62, 0x79, 0xf5, 0xdb, 0xfc, 0x1e, 0xed, 0xe2, 0xd0, 0xe7,
+	0xc6, 0x9b, 0x2f, 0xc9, 0x31, 0x4f, 0x79, 0x1a, 0xfb, 0x8c, 0x4d, 0x59, 0xdf, 0x52, 0x7a, 0x88,
+	0x4d, 0x78, 0x06, 0x10, 0x47, 0x85, 0xf2, 0x99, 0x31, 0x13, 0xa0, 0xdb, 0xd8, 0x4c, 0x83, 0x45,
+	0x05, 0x89, 0xcd, 0x2b, 0x10, 0x78, 0xb1, 0x19, 0x7e, 0x0a, 0x25, 0x25, 0x46, 0x52, 0x9f, 0x1b,
+	0xd5, 0xcc, 0xe5, 0xfd, 0x9c, 0x50, 0xca, 0xe4, 0x92, 0x63, 0x22, 0x03, 0x86, 0x02, 0x72, 0x9b,
+	0xa5, 0x9a, 0x7c, 0x23, 0x8b, 0x4b, 0x8c, 0xb9, 0x8f, 0x71, 0x35, 0x1a, 0xf3, 0x50, 0xbc, 0x16,
+	0xfd, 0x29, 0x5c, 0x99, 0xf3, 0x96, 0x14, 0xd7, 0x1c, 0x17, 0xbf, 0x8f, 0xd5, 0xb8, 0xb5, 0x10,
+	0x2f, 0xe8, 0x76, 0x41, 0x8f, 0xd0, 0xd1, 0x6b, 0x4d, 0xfa, 0xcd, 0x44, 0xb1, 0xf4, 0xc3, 0x51,
+	0x8d, 0x37, 0x16, 0xa1, 0x05, 0xd1, 0xdf, 0x82, 0xb5, 0xd4, 0xe3, 0x47, 0x7a, 0xd4, 0xb7, 0xd9,
+	0x17, 0x9c, 0x1a, 0x5b, 0x73, 0x71, 0x82, 0xd6, 0x13, 0xa8, 0x49, 0x94, 0x7c, 0xbc, 0x47, 0x4f,
+	0x14, 0x48, 0xbd, 0x72, 0xd4, 0xb8, 0x31, 0x1f, 0x99, 0x24, 0xa7, 0x3e, 0xc6, 0x13, 0x93, 0x9b,
+	0xf3, 0x1a, 0x50, 0xe3, 0xc6, 0x7c, 0xa4, 0x20, 0xf7, 0x9d, 0xc4, 0x8b, 0x35, 0x1b, 0xa9, 0x87,
+	0x6d, 0xd4, 0x39, 0x39, 0xe7, 0xed, 0x9c, 0x87, 0x50, 0x4d, 0xbe, 0xda, 0xc2, 0x27, 0xc7, 0xdc,
+	0x27, 0x66, 0x1a, 0x8d, 0x79, 0xa8, 0xb8, 0x15, 0xf1, 0x0b, 0x27, 0xbc, 0x15, 0x33, 0x6f, 0xa8,
+	0x34, 0x36, 0xd3, 0x60, 0x51, 0x78, 0x0a, 0xfa, 0xec, 0x3b, 0x16, 0x7c, 0x06, 0x2c, 0x7c, 0x07,
+	0xa4, 0xf1, 0xc6, 0x22, 0xb4, 0x58, 0x14, 0x89, 0x5d, 0xf5, 0x84, 0xf2, 0x09, 0x3d, 0x48, 0x51,
+	0xb6, 0xfa, 0x50, 0x49, 0x5c, 0xf4, 0xe7, 0x82, 0x78, 0xde, 0x63, 0x15, 0x8d, 0xeb, 0x73, 0x30,
+	0xf3, 0x34, 0xdd, 0xe8, 0x55, 0x06, 0xda, 0x54, 0xb1, 0x12, 0x1b, 0xaa, 0xb1, 0xe5, 0xb4, 0x7d,
+	0xd1, 0x7c, 0xcc, 0xf9, 0x33, 0x73, 0x75, 0xae, 0xb1, 0x99, 0x06, 0x2f, 0xb2, 0x77, 0xa4, 0x6d,
+	0x75, 0x74, 0x61, 0xd9, 0x24, 0x1b, 0x5d, 0x58, 0x8f, 0xab, 0x90, 0x86, 0xfa, 0x75, 0xe5, 0x46,
+	0x50, 0xca, 0x4c, 0x6f, 0xcc, 0x43, 0x2d, 0xb2, 0x22, 0x65, 0x6d, 0x8a, 0x85, 0x7e, 0xae, 0xba,
+	0x06, 0x62, 0x2b, 0x72, 0x6b, 0xfe, 0x99, 0xdb, 0xac, 0x6b, 0xe0, 0x75, 0xec, 0x47, 0x9e, 0x33,
+	0x69, 0x3f, 0x76, 0x20, 0x2f, 0xa4, 0xf8, 0x02, 0x19, 0xb9, 0x91, 0x82, 0xce, 0xf3, 0xc3, 0x08,
+	0xe1, 0xfe, 0x91, 0x76, 0xf7, 0x28, 0x47, 0xbf, 0x1f, 0xfe, 0xf5, 0xff, 0x3b, 0x00, 0x38, 0x31,
+	0xfe, 0x23, 0x83, 0x7c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6945,13 +11527,38 @@ type XchainClient interface {
 	SelectUTXOBySize(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error)
 	// PostTx post Transaction to a node
 	PostTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*CommonReply, error)
+	// WaitTx wait until the Transaction is included in a trunk block with
+	// enough confirmations, or until the timeout expires
+	WaitTx(ctx context.Context, in *WaitTxRequest, opts ...grpc.CallOption) (*WaitTxResponse, error)
 	QueryACL(ctx context.Context, in *AclStatus, opts ...grpc.CallOption) (*AclStatus, error)
 	QueryUtxoRecord(ctx context.Context, in *UtxoRecordDetail, opts ...grpc.CallOption) (*UtxoRecordDetail, error)
+	// GetAddressTxHistory query transactions related to an address or contract
+	// account from the tx index, newest first, requires enableTxIndex
+	GetAddressTxHistory(ctx context.Context, in *AddressTxHistoryRequest, opts ...grpc.CallOption) (*AddressTxHistoryResponse, error)
 	QueryContractStatData(ctx context.Context, in *ContractStatDataRequest, opts ...grpc.CallOption) (*ContractStatDataResponse, error)
+	// ListContractKeys list the state keys of a contract by prefix with pagination,
+	// optionally read at a historical trunk height
+	ListContractKeys(ctx context.Context, in *ListContractKeysRequest, opts ...grpc.CallOption) (*ListContractKeysResponse, error)
+	// GetStateAtHeight query the value of a bucket key as of a trunk block,
+	// the block is specified by blockid or height
+	GetStateAtHeight(ctx context.Context, in *StateAtHeightRequest, opts ...grpc.CallOption) (*StateAtHeightResponse, error)
 	GetAccountContracts(ctx context.Context, in *GetAccountContractsRequest, opts ...grpc.CallOption) (*GetAccountContractsResponse, error)
+	// GetContractInfo 查询已部署合约的类型、代码摘要、部署账户和部署升级交易，可选返回合约代码
+	GetContractInfo(ctx context.Context, in *ContractInfoRequest, opts ...grpc.CallOption) (*ContractInfoResponse, error)
 	// QueryTx query Transaction by TxStatus,
 	// Bcname and Txid are required for this
 	QueryTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*TxStatus, error)
+	// GetTxProof get merkle proof of a confirmed transaction,
+	// the proof can be verified offline with the returned block header
+	GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProofResponse, error)
+	// BatchQueryTx query Transactions by a list of txid of one chain,
+	// one TxStatus is returned for each txid with its own error code
+	BatchQueryTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxs, error)
+	// ListPendingTxs list unconfirmed transactions in mempool in the order they
+	// would be packed, filtered by initiator or contract
+	ListPendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// GetPendingTx get an unconfirmed transaction in mempool, including orphans
+	GetPendingTx(ctx context.Context, in *PendingTxRequest, opts ...grpc.CallOption) (*PendingTxResponse, error)
 	// GetBalance get balance of an address,
 	// Address is required for this
 	GetBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error)
@@ -6963,24 +11570,44 @@ type XchainClient interface {
 	// GetFrozenBalance get balance that still be frozen of an address,
 	// Address is required for this
 	GetFrozenBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error)
+	// GetTokenSupply 查询原生代币的发行总量、冻结量和持有量最多的地址
+	GetTokenSupply(ctx context.Context, in *TokenSupplyRequest, opts ...grpc.CallOption) (*TokenSupplyResponse, error)
 	// GetBlock get block by blockid and return if the block in trunk or in branch
 	GetBlock(ctx context.Context, in *BlockID, opts ...grpc.CallOption) (*Block, error)
 	// GetBlockByHeight get block by height and return if the block in trunk or in
 	// branch
 	GetBlockByHeight(ctx context.Context, in *BlockHeight, opts ...grpc.CallOption) (*Block, error)
+	// GetBlocksByRange stream trunk blocks from start height to end height
+	GetBlocksByRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (Xchain_GetBlocksByRangeClient, error)
+	// GetBlockHeaders get trunk block headers from start height to end height,
+	// at most 500 headers are returned once
+	GetBlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (*BlockHeaders, error)
+	// StreamBlockHeaders stream trunk block headers from start height to end height
+	StreamBlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (Xchain_StreamBlockHeadersClient, error)
+	// QueryContractEvents 分页查询主干区块高度区间内的合约事件
+	QueryContractEvents(ctx context.Context, in *ContractEventsRequest, opts ...grpc.CallOption) (*ContractEventsResponse, error)
 	GetBlockChainStatus(ctx context.Context, in *BCStatus, opts ...grpc.CallOption) (*BCStatus, error)
 	// Get blockchains query blockchains
 	GetBlockChains(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*BlockChains, error)
 	// GetSystemStatus query system status
 	GetSystemStatus(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*SystemsStatusReply, error)
+	// GetChainStats 统计最近区块窗口内的吞吐量、出块间隔和合约调用情况
+	GetChainStats(ctx context.Context, in *ChainStatsRequest, opts ...grpc.CallOption) (*ChainStatsResponse, error)
 	// GetConsensusChains query consensus status
 	GetConsensusStatus(ctx context.Context, in *ConsensusStatRequest, opts ...grpc.CallOption) (*ConsensusStatus, error)
 	// GetNetURL return net url
 	GetNetURL(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*RawUrl, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error)
+	// ReleaseUTXOLock 提前释放SelectUTXO/SelectUTXOBySize临时锁定的utxo
+	ReleaseUTXOLock(ctx context.Context, in *UtxoLockRelease, opts ...grpc.CallOption) (*CommonReply, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
 	PreExecWithSelectUTXO(ctx context.Context, in *PreExecWithSelectUTXORequest, opts ...grpc.CallOption) (*PreExecWithSelectUTXOResponse, error)
+	// SimulateTx preExec an assembled transaction and return the state diff it
+	// would make, the transaction does not need to be signed
+	SimulateTx(ctx context.Context, in *SimulateTxRequest, opts ...grpc.CallOption) (*SimulateTxResponse, error)
+	// EstimateFee preExec and estimate the fee of each resource
+	EstimateFee(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 	//  DposCandidates get all candidates of the tdpos consensus
 	DposCandidates(ctx context.Context, in *DposCandidatesRequest, opts ...grpc.CallOption) (*DposCandidatesResponse, error)
 	//  DposNominateRecords get all records nominated by an user
@@ -6995,8 +11622,18 @@ type XchainClient interface {
 	DposCheckResults(ctx context.Context, in *DposCheckResultsRequest, opts ...grpc.CallOption) (*DposCheckResultsResponse, error)
 	// DposStatus get dpos status
 	DposStatus(ctx context.Context, in *DposStatusRequest, opts ...grpc.CallOption) (*DposStatusResponse, error)
+	// XpoaValidators get current and pending validators of the xpoa consensus
+	XpoaValidators(ctx context.Context, in *XpoaValidatorsRequest, opts ...grpc.CallOption) (*XpoaValidatorsResponse, error)
+	// XpoaStatus get xpoa status
+	XpoaStatus(ctx context.Context, in *XpoaStatusRequest, opts ...grpc.CallOption) (*XpoaStatusResponse, error)
+	// GovernTokenBalance get govern token balance of an account
+	GovernTokenBalance(ctx context.Context, in *GovernTokenBalanceRequest, opts ...grpc.CallOption) (*GovernTokenBalanceResponse, error)
+	// ProposalQuery get status, vote tally and trigger of a proposal
+	ProposalQuery(ctx context.Context, in *ProposalQueryRequest, opts ...grpc.CallOption) (*ProposalQueryResponse, error)
 	// GetAccountByAK get account sets contain a specific address
 	GetAccountByAK(ctx context.Context, in *AK2AccountRequest, opts ...grpc.CallOption) (*AK2AccountResponse, error)
+	// GetAccountHistory 查询合约账户ACL、AK和合约方法ACL的变更历史
+	GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error)
 	// GetAddressContracts get contracts of accounts contain a specific address
 	GetAddressContracts(ctx context.Context, in *AddressContractsRequest, opts ...grpc.CallOption) (*AddressContractsResponse, error)
 	//预执行合约
@@ -7029,6 +11666,15 @@ func (c *xchainClient) PostTx(ctx context.Context, in *TxStatus, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *xchainClient) WaitTx(ctx context.Context, in *WaitTxRequest, opts ...grpc.CallOption) (*WaitTxResponse, error) {
+	out := new(WaitTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/WaitTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) QueryACL(ctx context.Context, in *AclStatus, opts ...grpc.CallOption) (*AclStatus, error) {
 	out := new(AclStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryACL", in, out, opts...)
@@ -7047,6 +11693,15 @@ func (c *xchainClient) QueryUtxoRecord(ctx context.Context, in *UtxoRecordDetail
 	return out, nil
 }
 
+func (c *xchainClient) GetAddressTxHistory(ctx context.Context, in *AddressTxHistoryRequest, opts ...grpc.CallOption) (*AddressTxHistoryResponse, error) {
+	out := new(AddressTxHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAddressTxHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) QueryContractStatData(ctx context.Context, in *ContractStatDataRequest, opts ...grpc.CallOption) (*ContractStatDataResponse, error) {
 	out := new(ContractStatDataResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryContractStatData", in, out, opts...)
@@ -7056,6 +11711,24 @@ func (c *xchainClient) QueryContractStatData(ctx context.Context, in *ContractSt
 	return out, nil
 }
 
+func (c *xchainClient) ListContractKeys(ctx context.Context, in *ListContractKeysRequest, opts ...grpc.CallOption) (*ListContractKeysResponse, error) {
+	out := new(ListContractKeysResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ListContractKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetStateAtHeight(ctx context.Context, in *StateAtHeightRequest, opts ...grpc.CallOption) (*StateAtHeightResponse, error) {
+	out := new(StateAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetStateAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) GetAccountContracts(ctx context.Context, in *GetAccountContractsRequest, opts ...grpc.CallOption) (*GetAccountContractsResponse, error) {
 	out := new(GetAccountContractsResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAccountContracts", in, out, opts...)
@@ -7065,6 +11738,15 @@ func (c *xchainClient) GetAccountContracts(ctx context.Context, in *GetAccountCo
 	return out, nil
 }
 
+func (c *xchainClient) GetContractInfo(ctx context.Context, in *ContractInfoRequest, opts ...grpc.CallOption) (*ContractInfoResponse, error) {
+	out := new(ContractInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetContractInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) QueryTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*TxStatus, error) {
 	out := new(TxStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryTx", in, out, opts...)
@@ -7074,45 +11756,172 @@ func (c *xchainClient) QueryTx(ctx context.Context, in *TxStatus, opts ...grpc.C
 	return out, nil
 }
 
-func (c *xchainClient) GetBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error) {
-	out := new(AddressStatus)
-	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBalance", in, out, opts...)
+func (c *xchainClient) GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProofResponse, error) {
+	out := new(TxProofResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) BatchQueryTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxs, error) {
+	out := new(BatchTxs)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/BatchQueryTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) ListPendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ListPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetPendingTx(ctx context.Context, in *PendingTxRequest, opts ...grpc.CallOption) (*PendingTxResponse, error) {
+	out := new(PendingTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetPendingTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error) {
+	out := new(AddressStatus)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBalanceDetail(ctx context.Context, in *AddressBalanceStatus, opts ...grpc.CallOption) (*AddressBalanceStatus, error) {
+	out := new(AddressBalanceStatus)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBalanceDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetFrozenBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error) {
+	out := new(AddressStatus)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetFrozenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetTokenSupply(ctx context.Context, in *TokenSupplyRequest, opts ...grpc.CallOption) (*TokenSupplyResponse, error) {
+	out := new(TokenSupplyResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTokenSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBlock(ctx context.Context, in *BlockID, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBlockByHeight(ctx context.Context, in *BlockHeight, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlockByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBlocksByRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (Xchain_GetBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Xchain_serviceDesc.Streams[0], "/pb.Xchain/GetBlocksByRange", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &xchainGetBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *xchainClient) GetBalanceDetail(ctx context.Context, in *AddressBalanceStatus, opts ...grpc.CallOption) (*AddressBalanceStatus, error) {
-	out := new(AddressBalanceStatus)
-	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBalanceDetail", in, out, opts...)
-	if err != nil {
+type Xchain_GetBlocksByRangeClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type xchainGetBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *xchainGetBlocksByRangeClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *xchainClient) GetFrozenBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error) {
-	out := new(AddressStatus)
-	err := c.cc.Invoke(ctx, "/pb.Xchain/GetFrozenBalance", in, out, opts...)
+func (c *xchainClient) GetBlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (*BlockHeaders, error) {
+	out := new(BlockHeaders)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlockHeaders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *xchainClient) GetBlock(ctx context.Context, in *BlockID, opts ...grpc.CallOption) (*Block, error) {
-	out := new(Block)
-	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlock", in, out, opts...)
+func (c *xchainClient) StreamBlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (Xchain_StreamBlockHeadersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Xchain_serviceDesc.Streams[1], "/pb.Xchain/StreamBlockHeaders", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &xchainStreamBlockHeadersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *xchainClient) GetBlockByHeight(ctx context.Context, in *BlockHeight, opts ...grpc.CallOption) (*Block, error) {
-	out := new(Block)
-	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlockByHeight", in, out, opts...)
+type Xchain_StreamBlockHeadersClient interface {
+	Recv() (*BlockHeader, error)
+	grpc.ClientStream
+}
+
+type xchainStreamBlockHeadersClient struct {
+	grpc.ClientStream
+}
+
+func (x *xchainStreamBlockHeadersClient) Recv() (*BlockHeader, error) {
+	m := new(BlockHeader)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *xchainClient) QueryContractEvents(ctx context.Context, in *ContractEventsRequest, opts ...grpc.CallOption) (*ContractEventsResponse, error) {
+	out := new(ContractEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryContractEvents", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7146,6 +11955,15 @@ func (c *xchainClient) GetSystemStatus(ctx context.Context, in *CommonIn, opts .
 	return out, nil
 }
 
+func (c *xchainClient) GetChainStats(ctx context.Context, in *ChainStatsRequest, opts ...grpc.CallOption) (*ChainStatsResponse, error) {
+	out := new(ChainStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetChainStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) GetConsensusStatus(ctx context.Context, in *ConsensusStatRequest, opts ...grpc.CallOption) (*ConsensusStatus, error) {
 	out := new(ConsensusStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetConsensusStatus", in, out, opts...)
@@ -7173,6 +11991,15 @@ func (c *xchainClient) SelectUTXO(ctx context.Context, in *UtxoInput, opts ...gr
 	return out, nil
 }
 
+func (c *xchainClient) ReleaseUTXOLock(ctx context.Context, in *UtxoLockRelease, opts ...grpc.CallOption) (*CommonReply, error) {
+	out := new(CommonReply)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ReleaseUTXOLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) PreExecWithSelectUTXO(ctx context.Context, in *PreExecWithSelectUTXORequest, opts ...grpc.CallOption) (*PreExecWithSelectUTXOResponse, error) {
 	out := new(PreExecWithSelectUTXOResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/PreExecWithSelectUTXO", in, out, opts...)
@@ -7182,6 +12009,24 @@ func (c *xchainClient) PreExecWithSelectUTXO(ctx context.Context, in *PreExecWit
 	return out, nil
 }
 
+func (c *xchainClient) SimulateTx(ctx context.Context, in *SimulateTxRequest, opts ...grpc.CallOption) (*SimulateTxResponse, error) {
+	out := new(SimulateTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/SimulateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) EstimateFee(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) DposCandidates(ctx context.Context, in *DposCandidatesRequest, opts ...grpc.CallOption) (*DposCandidatesResponse, error) {
 	out := new(DposCandidatesResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/DposCandidates", in, out, opts...)
@@ -7245,6 +12090,42 @@ func (c *xchainClient) DposStatus(ctx context.Context, in *DposStatusRequest, op
 	return out, nil
 }
 
+func (c *xchainClient) XpoaValidators(ctx context.Context, in *XpoaValidatorsRequest, opts ...grpc.CallOption) (*XpoaValidatorsResponse, error) {
+	out := new(XpoaValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/XpoaValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) XpoaStatus(ctx context.Context, in *XpoaStatusRequest, opts ...grpc.CallOption) (*XpoaStatusResponse, error) {
+	out := new(XpoaStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/XpoaStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GovernTokenBalance(ctx context.Context, in *GovernTokenBalanceRequest, opts ...grpc.CallOption) (*GovernTokenBalanceResponse, error) {
+	out := new(GovernTokenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GovernTokenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) ProposalQuery(ctx context.Context, in *ProposalQueryRequest, opts ...grpc.CallOption) (*ProposalQueryResponse, error) {
+	out := new(ProposalQueryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ProposalQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) GetAccountByAK(ctx context.Context, in *AK2AccountRequest, opts ...grpc.CallOption) (*AK2AccountResponse, error) {
 	out := new(AK2AccountResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAccountByAK", in, out, opts...)
@@ -7254,6 +12135,15 @@ func (c *xchainClient) GetAccountByAK(ctx context.Context, in *AK2AccountRequest
 	return out, nil
 }
 
+func (c *xchainClient) GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error) {
+	out := new(AccountHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAccountHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) GetAddressContracts(ctx context.Context, in *AddressContractsRequest, opts ...grpc.CallOption) (*AddressContractsResponse, error) {
 	out := new(AddressContractsResponse)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAddressContracts", in, out, opts...)
@@ -7278,13 +12168,38 @@ type XchainServer interface {
 	SelectUTXOBySize(context.Context, *UtxoInput) (*UtxoOutput, error)
 	// PostTx post Transaction to a node
 	PostTx(context.Context, *TxStatus) (*CommonReply, error)
+	// WaitTx wait until the Transaction is included in a trunk block with
+	// enough confirmations, or until the timeout expires
+	WaitTx(context.Context, *WaitTxRequest) (*WaitTxResponse, error)
 	QueryACL(context.Context, *AclStatus) (*AclStatus, error)
 	QueryUtxoRecord(context.Context, *UtxoRecordDetail) (*UtxoRecordDetail, error)
+	// GetAddressTxHistory query transactions related to an address or contract
+	// account from the tx index, newest first, requires enableTxIndex
+	GetAddressTxHistory(context.Context, *AddressTxHistoryRequest) (*AddressTxHistoryResponse, error)
 	QueryContractStatData(context.Context, *ContractStatDataRequest) (*ContractStatDataResponse, error)
+	// ListContractKeys list the state keys of a contract by prefix with pagination,
+	// optionally read at a historical trunk height
+	ListContractKeys(context.Context, *ListContractKeysRequest) (*ListContractKeysResponse, error)
+	// GetStateAtHeight query the value of a bucket key as of a trunk block,
+	// the block is specified by blockid or height
+	GetStateAtHeight(context.Context, *StateAtHeightRequest) (*StateAtHeightResponse, error)
 	GetAccountContracts(context.Context, *GetAccountContractsRequest) (*GetAccountContractsResponse, error)
+	// GetContractInfo 查询已部署合约的类型、代码摘要、部署账户和部署升级交易，可选返回合约代码
+	GetContractInfo(context.Context, *ContractInfoRequest) (*ContractInfoResponse, error)
 	// QueryTx query Transaction by TxStatus,
 	// Bcname and Txid are required for this
 	QueryTx(context.Context, *TxStatus) (*TxStatus, error)
+	// GetTxProof get merkle proof of a confirmed transaction,
+	// the proof can be verified offline with the returned block header
+	GetTxProof(context.Context, *TxProofRequest) (*TxProofResponse, error)
+	// BatchQueryTx query Transactions by a list of txid of one chain,
+	// one TxStatus is returned for each txid with its own error code
+	BatchQueryTx(context.Context, *BatchTxRequest) (*BatchTxs, error)
+	// ListPendingTxs list unconfirmed transactions in mempool in the order they
+	// would be packed, filtered by initiator or contract
+	ListPendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// GetPendingTx get an unconfirmed transaction in mempool, including orphans
+	GetPendingTx(context.Context, *PendingTxRequest) (*PendingTxResponse, error)
 	// GetBalance get balance of an address,
 	// Address is required for this
 	GetBalance(context.Context, *AddressStatus) (*AddressStatus, error)
@@ -7296,24 +12211,44 @@ type XchainServer interface {
 	// GetFrozenBalance get balance that still be frozen of an address,
 	// Address is required for this
 	GetFrozenBalance(context.Context, *AddressStatus) (*AddressStatus, error)
+	// GetTokenSupply 查询原生代币的发行总量、冻结量和持有量最多的地址
+	GetTokenSupply(context.Context, *TokenSupplyRequest) (*TokenSupplyResponse, error)
 	// GetBlock get block by blockid and return if the block in trunk or in branch
 	GetBlock(context.Context, *BlockID) (*Block, error)
 	// GetBlockByHeight get block by height and return if the block in trunk or in
 	// branch
 	GetBlockByHeight(context.Context, *BlockHeight) (*Block, error)
+	// GetBlocksByRange stream trunk blocks from start height to end height
+	GetBlocksByRange(*BlockRangeRequest, Xchain_GetBlocksByRangeServer) error
+	// GetBlockHeaders get trunk block headers from start height to end height,
+	// at most 500 headers are returned once
+	GetBlockHeaders(context.Context, *BlockHeadersRequest) (*BlockHeaders, error)
+	// StreamBlockHeaders stream trunk block headers from start height to end height
+	StreamBlockHeaders(*BlockHeadersRequest, Xchain_StreamBlockHeadersServer) error
+	// QueryContractEvents 分页查询主干区块高度区间内的合约事件
+	QueryContractEvents(context.Context, *ContractEventsRequest) (*ContractEventsResponse, error)
 	GetBlockChainStatus(context.Context, *BCStatus) (*BCStatus, error)
 	// Get blockchains query blockchains
 	GetBlockChains(context.Context, *CommonIn) (*BlockChains, error)
 	// GetSystemStatus query system status
 	GetSystemStatus(context.Context, *CommonIn) (*SystemsStatusReply, error)
+	// GetChainStats 统计最近区块窗口内的吞吐量、出块间隔和合约调用情况
+	GetChainStats(context.Context, *ChainStatsRequest) (*ChainStatsResponse, error)
 	// GetConsensusChains query consensus status
 	GetConsensusStatus(context.Context, *ConsensusStatRequest) (*ConsensusStatus, error)
 	// GetNetURL return net url
 	GetNetURL(context.Context, *CommonIn) (*RawUrl, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(context.Context, *UtxoInput) (*UtxoOutput, error)
+	// ReleaseUTXOLock 提前释放SelectUTXO/SelectUTXOBySize临时锁定的utxo
+	ReleaseUTXOLock(context.Context, *UtxoLockRelease) (*CommonReply, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
 	PreExecWithSelectUTXO(context.Context, *PreExecWithSelectUTXORequest) (*PreExecWithSelectUTXOResponse, error)
+	// SimulateTx preExec an assembled transaction and return the state diff it
+	// would make, the transaction does not need to be signed
+	SimulateTx(context.Context, *SimulateTxRequest) (*SimulateTxResponse, error)
+	// EstimateFee preExec and estimate the fee of each resource
+	EstimateFee(context.Context, *InvokeRPCRequest) (*EstimateFeeResponse, error)
 	//  DposCandidates get all candidates of the tdpos consensus
 	DposCandidates(context.Context, *DposCandidatesRequest) (*DposCandidatesResponse, error)
 	//  DposNominateRecords get all records nominated by an user
@@ -7328,8 +12263,18 @@ type XchainServer interface {
 	DposCheckResults(context.Context, *DposCheckResultsRequest) (*DposCheckResultsResponse, error)
 	// DposStatus get dpos status
 	DposStatus(context.Context, *DposStatusRequest) (*DposStatusResponse, error)
+	// XpoaValidators get current and pending validators of the xpoa consensus
+	XpoaValidators(context.Context, *XpoaValidatorsRequest) (*XpoaValidatorsResponse, error)
+	// XpoaStatus get xpoa status
+	XpoaStatus(context.Context, *XpoaStatusRequest) (*XpoaStatusResponse, error)
+	// GovernTokenBalance get govern token balance of an account
+	GovernTokenBalance(context.Context, *GovernTokenBalanceRequest) (*GovernTokenBalanceResponse, error)
+	// ProposalQuery get status, vote tally and trigger of a proposal
+	ProposalQuery(context.Context, *ProposalQueryRequest) (*ProposalQueryResponse, error)
 	// GetAccountByAK get account sets contain a specific address
 	GetAccountByAK(context.Context, *AK2AccountRequest) (*AK2AccountResponse, error)
+	// GetAccountHistory 查询合约账户ACL、AK和合约方法ACL的变更历史
+	GetAccountHistory(context.Context, *AccountHistoryRequest) (*AccountHistoryResponse, error)
 	// GetAddressContracts get contracts of accounts contain a specific address
 	GetAddressContracts(context.Context, *AddressContractsRequest) (*AddressContractsResponse, error)
 	//预执行合约
@@ -7346,21 +12291,48 @@ func (*UnimplementedXchainServer) SelectUTXOBySize(ctx context.Context, req *Utx
 func (*UnimplementedXchainServer) PostTx(ctx context.Context, req *TxStatus) (*CommonReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostTx not implemented")
 }
+func (*UnimplementedXchainServer) WaitTx(ctx context.Context, req *WaitTxRequest) (*WaitTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitTx not implemented")
+}
 func (*UnimplementedXchainServer) QueryACL(ctx context.Context, req *AclStatus) (*AclStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryACL not implemented")
 }
 func (*UnimplementedXchainServer) QueryUtxoRecord(ctx context.Context, req *UtxoRecordDetail) (*UtxoRecordDetail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUtxoRecord not implemented")
 }
+func (*UnimplementedXchainServer) GetAddressTxHistory(ctx context.Context, req *AddressTxHistoryRequest) (*AddressTxHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTxHistory not implemented")
+}
 func (*UnimplementedXchainServer) QueryContractStatData(ctx context.Context, req *ContractStatDataRequest) (*ContractStatDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryContractStatData not implemented")
 }
+func (*UnimplementedXchainServer) ListContractKeys(ctx context.Context, req *ListContractKeysRequest) (*ListContractKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContractKeys not implemented")
+}
+func (*UnimplementedXchainServer) GetStateAtHeight(ctx context.Context, req *StateAtHeightRequest) (*StateAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateAtHeight not implemented")
+}
 func (*UnimplementedXchainServer) GetAccountContracts(ctx context.Context, req *GetAccountContractsRequest) (*GetAccountContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountContracts not implemented")
 }
+func (*UnimplementedXchainServer) GetContractInfo(ctx context.Context, req *ContractInfoRequest) (*ContractInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractInfo not implemented")
+}
 func (*UnimplementedXchainServer) QueryTx(ctx context.Context, req *TxStatus) (*TxStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTx not implemented")
 }
+func (*UnimplementedXchainServer) GetTxProof(ctx context.Context, req *TxProofRequest) (*TxProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxProof not implemented")
+}
+func (*UnimplementedXchainServer) BatchQueryTx(ctx context.Context, req *BatchTxRequest) (*BatchTxs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryTx not implemented")
+}
+func (*UnimplementedXchainServer) ListPendingTxs(ctx context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTxs not implemented")
+}
+func (*UnimplementedXchainServer) GetPendingTx(ctx context.Context, req *PendingTxRequest) (*PendingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTx not implemented")
+}
 func (*UnimplementedXchainServer) GetBalance(ctx context.Context, req *AddressStatus) (*AddressStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
@@ -7370,12 +12342,27 @@ func (*UnimplementedXchainServer) GetBalanceDetail(ctx context.Context, req *Add
 func (*UnimplementedXchainServer) GetFrozenBalance(ctx context.Context, req *AddressStatus) (*AddressStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFrozenBalance not implemented")
 }
+func (*UnimplementedXchainServer) GetTokenSupply(ctx context.Context, req *TokenSupplyRequest) (*TokenSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenSupply not implemented")
+}
 func (*UnimplementedXchainServer) GetBlock(ctx context.Context, req *BlockID) (*Block, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
 }
 func (*UnimplementedXchainServer) GetBlockByHeight(ctx context.Context, req *BlockHeight) (*Block, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
 }
+func (*UnimplementedXchainServer) GetBlocksByRange(req *BlockRangeRequest, srv Xchain_GetBlocksByRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBlocksByRange not implemented")
+}
+func (*UnimplementedXchainServer) GetBlockHeaders(ctx context.Context, req *BlockHeadersRequest) (*BlockHeaders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaders not implemented")
+}
+func (*UnimplementedXchainServer) StreamBlockHeaders(req *BlockHeadersRequest, srv Xchain_StreamBlockHeadersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockHeaders not implemented")
+}
+func (*UnimplementedXchainServer) QueryContractEvents(ctx context.Context, req *ContractEventsRequest) (*ContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryContractEvents not implemented")
+}
 func (*UnimplementedXchainServer) GetBlockChainStatus(ctx context.Context, req *BCStatus) (*BCStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockChainStatus not implemented")
 }
@@ -7385,6 +12372,9 @@ func (*UnimplementedXchainServer) GetBlockChains(ctx context.Context, req *Commo
 func (*UnimplementedXchainServer) GetSystemStatus(ctx context.Context, req *CommonIn) (*SystemsStatusReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStatus not implemented")
 }
+func (*UnimplementedXchainServer) GetChainStats(ctx context.Context, req *ChainStatsRequest) (*ChainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStats not implemented")
+}
 func (*UnimplementedXchainServer) GetConsensusStatus(ctx context.Context, req *ConsensusStatRequest) (*ConsensusStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusStatus not implemented")
 }
@@ -7394,9 +12384,18 @@ func (*UnimplementedXchainServer) GetNetURL(ctx context.Context, req *CommonIn)
 func (*UnimplementedXchainServer) SelectUTXO(ctx context.Context, req *UtxoInput) (*UtxoOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectUTXO not implemented")
 }
+func (*UnimplementedXchainServer) ReleaseUTXOLock(ctx context.Context, req *UtxoLockRelease) (*CommonReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseUTXOLock not implemented")
+}
 func (*UnimplementedXchainServer) PreExecWithSelectUTXO(ctx context.Context, req *PreExecWithSelectUTXORequest) (*PreExecWithSelectUTXOResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreExecWithSelectUTXO not implemented")
 }
+func (*UnimplementedXchainServer) SimulateTx(ctx context.Context, req *SimulateTxRequest) (*SimulateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTx not implemented")
+}
+func (*UnimplementedXchainServer) EstimateFee(ctx context.Context, req *InvokeRPCRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 func (*UnimplementedXchainServer) DposCandidates(ctx context.Context, req *DposCandidatesRequest) (*DposCandidatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DposCandidates not implemented")
 }
@@ -7418,9 +12417,24 @@ func (*UnimplementedXchainServer) DposCheckResults(ctx context.Context, req *Dpo
 func (*UnimplementedXchainServer) DposStatus(ctx context.Context, req *DposStatusRequest) (*DposStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DposStatus not implemented")
 }
+func (*UnimplementedXchainServer) XpoaValidators(ctx context.Context, req *XpoaValidatorsRequest) (*XpoaValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XpoaValidators not implemented")
+}
+func (*UnimplementedXchainServer) XpoaStatus(ctx context.Context, req *XpoaStatusRequest) (*XpoaStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XpoaStatus not implemented")
+}
+func (*UnimplementedXchainServer) GovernTokenBalance(ctx context.Context, req *GovernTokenBalanceRequest) (*GovernTokenBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernTokenBalance not implemented")
+}
+func (*UnimplementedXchainServer) ProposalQuery(ctx context.Context, req *ProposalQueryRequest) (*ProposalQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalQuery not implemented")
+}
 func (*UnimplementedXchainServer) GetAccountByAK(ctx context.Context, req *AK2AccountRequest) (*AK2AccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByAK not implemented")
 }
+func (*UnimplementedXchainServer) GetAccountHistory(ctx context.Context, req *AccountHistoryRequest) (*AccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountHistory not implemented")
+}
 func (*UnimplementedXchainServer) GetAddressContracts(ctx context.Context, req *AddressContractsRequest) (*AddressContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAddressContracts not implemented")
 }
@@ -7456,104 +12470,266 @@ func _Xchain_PostTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).PostTx(ctx, in)
+		return srv.(XchainServer).PostTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/PostTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).PostTx(ctx, req.(*TxStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_WaitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).WaitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/WaitTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).WaitTx(ctx, req.(*WaitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_QueryACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AclStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).QueryACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/QueryACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).QueryACL(ctx, req.(*AclStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_QueryUtxoRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtxoRecordDetail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).QueryUtxoRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/QueryUtxoRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).QueryUtxoRecord(ctx, req.(*UtxoRecordDetail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetAddressTxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressTxHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetAddressTxHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetAddressTxHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetAddressTxHistory(ctx, req.(*AddressTxHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_QueryContractStatData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractStatDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).QueryContractStatData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/QueryContractStatData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).QueryContractStatData(ctx, req.(*ContractStatDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_ListContractKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContractKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ListContractKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ListContractKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ListContractKeys(ctx, req.(*ListContractKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetStateAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetStateAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetStateAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetStateAtHeight(ctx, req.(*StateAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetAccountContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetAccountContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetAccountContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetAccountContracts(ctx, req.(*GetAccountContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetContractInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetContractInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/PostTx",
+		FullMethod: "/pb.Xchain/GetContractInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).PostTx(ctx, req.(*TxStatus))
+		return srv.(XchainServer).GetContractInfo(ctx, req.(*ContractInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Xchain_QueryACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AclStatus)
+func _Xchain_QueryTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatus)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).QueryACL(ctx, in)
+		return srv.(XchainServer).QueryTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/QueryACL",
+		FullMethod: "/pb.Xchain/QueryTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).QueryACL(ctx, req.(*AclStatus))
+		return srv.(XchainServer).QueryTx(ctx, req.(*TxStatus))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Xchain_QueryUtxoRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UtxoRecordDetail)
+func _Xchain_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxProofRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).QueryUtxoRecord(ctx, in)
+		return srv.(XchainServer).GetTxProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/QueryUtxoRecord",
+		FullMethod: "/pb.Xchain/GetTxProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).QueryUtxoRecord(ctx, req.(*UtxoRecordDetail))
+		return srv.(XchainServer).GetTxProof(ctx, req.(*TxProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Xchain_QueryContractStatData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractStatDataRequest)
+func _Xchain_BatchQueryTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).QueryContractStatData(ctx, in)
+		return srv.(XchainServer).BatchQueryTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/QueryContractStatData",
+		FullMethod: "/pb.Xchain/BatchQueryTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).QueryContractStatData(ctx, req.(*ContractStatDataRequest))
+		return srv.(XchainServer).BatchQueryTx(ctx, req.(*BatchTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Xchain_GetAccountContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountContractsRequest)
+func _Xchain_ListPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).GetAccountContracts(ctx, in)
+		return srv.(XchainServer).ListPendingTxs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/GetAccountContracts",
+		FullMethod: "/pb.Xchain/ListPendingTxs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).GetAccountContracts(ctx, req.(*GetAccountContractsRequest))
+		return srv.(XchainServer).ListPendingTxs(ctx, req.(*PendingTxsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Xchain_QueryTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxStatus)
+func _Xchain_GetPendingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(XchainServer).QueryTx(ctx, in)
+		return srv.(XchainServer).GetPendingTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Xchain/QueryTx",
+		FullMethod: "/pb.Xchain/GetPendingTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(XchainServer).QueryTx(ctx, req.(*TxStatus))
+		return srv.(XchainServer).GetPendingTx(ctx, req.(*PendingTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7612,6 +12788,24 @@ func _Xchain_GetFrozenBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetTokenSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTokenSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTokenSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTokenSupply(ctx, req.(*TokenSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockID)
 	if err := dec(in); err != nil {
@@ -7648,6 +12842,84 @@ func _Xchain_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XchainServer).GetBlocksByRange(m, &xchainGetBlocksByRangeServer{stream})
+}
+
+type Xchain_GetBlocksByRangeServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type xchainGetBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *xchainGetBlocksByRangeServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Xchain_GetBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetBlockHeaders(ctx, req.(*BlockHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_StreamBlockHeaders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockHeadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XchainServer).StreamBlockHeaders(m, &xchainStreamBlockHeadersServer{stream})
+}
+
+type Xchain_StreamBlockHeadersServer interface {
+	Send(*BlockHeader) error
+	grpc.ServerStream
+}
+
+type xchainStreamBlockHeadersServer struct {
+	grpc.ServerStream
+}
+
+func (x *xchainStreamBlockHeadersServer) Send(m *BlockHeader) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Xchain_QueryContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).QueryContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/QueryContractEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).QueryContractEvents(ctx, req.(*ContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetBlockChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BCStatus)
 	if err := dec(in); err != nil {
@@ -7702,6 +12974,24 @@ func _Xchain_GetSystemStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetChainStats(ctx, req.(*ChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetConsensusStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConsensusStatRequest)
 	if err := dec(in); err != nil {
@@ -7756,6 +13046,24 @@ func _Xchain_SelectUTXO_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_ReleaseUTXOLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtxoLockRelease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ReleaseUTXOLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ReleaseUTXOLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ReleaseUTXOLock(ctx, req.(*UtxoLockRelease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_PreExecWithSelectUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PreExecWithSelectUTXORequest)
 	if err := dec(in); err != nil {
@@ -7774,6 +13082,42 @@ func _Xchain_PreExecWithSelectUTXO_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_SimulateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).SimulateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/SimulateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).SimulateTx(ctx, req.(*SimulateTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRPCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).EstimateFee(ctx, req.(*InvokeRPCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_DposCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DposCandidatesRequest)
 	if err := dec(in); err != nil {
@@ -7900,6 +13244,78 @@ func _Xchain_DposStatus_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_XpoaValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XpoaValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).XpoaValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/XpoaValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).XpoaValidators(ctx, req.(*XpoaValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_XpoaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XpoaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).XpoaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/XpoaStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).XpoaStatus(ctx, req.(*XpoaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GovernTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GovernTokenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GovernTokenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GovernTokenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GovernTokenBalance(ctx, req.(*GovernTokenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_ProposalQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ProposalQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ProposalQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ProposalQuery(ctx, req.(*ProposalQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetAccountByAK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AK2AccountRequest)
 	if err := dec(in); err != nil {
@@ -7918,6 +13334,24 @@ func _Xchain_GetAccountByAK_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetAccountHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetAccountHistory(ctx, req.(*AccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetAddressContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddressContractsRequest)
 	if err := dec(in); err != nil {
@@ -7966,6 +13400,10 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostTx",
 			Handler:    _Xchain_PostTx_Handler,
 		},
+		{
+			MethodName: "WaitTx",
+			Handler:    _Xchain_WaitTx_Handler,
+		},
 		{
 			MethodName: "QueryACL",
 			Handler:    _Xchain_QueryACL_Handler,
@@ -7974,18 +13412,50 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryUtxoRecord",
 			Handler:    _Xchain_QueryUtxoRecord_Handler,
 		},
+		{
+			MethodName: "GetAddressTxHistory",
+			Handler:    _Xchain_GetAddressTxHistory_Handler,
+		},
 		{
 			MethodName: "QueryContractStatData",
 			Handler:    _Xchain_QueryContractStatData_Handler,
 		},
+		{
+			MethodName: "ListContractKeys",
+			Handler:    _Xchain_ListContractKeys_Handler,
+		},
+		{
+			MethodName: "GetStateAtHeight",
+			Handler:    _Xchain_GetStateAtHeight_Handler,
+		},
 		{
 			MethodName: "GetAccountContracts",
 			Handler:    _Xchain_GetAccountContracts_Handler,
 		},
+		{
+			MethodName: "GetContractInfo",
+			Handler:    _Xchain_GetContractInfo_Handler,
+		},
 		{
 			MethodName: "QueryTx",
 			Handler:    _Xchain_QueryTx_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _Xchain_GetTxProof_Handler,
+		},
+		{
+			MethodName: "BatchQueryTx",
+			Handler:    _Xchain_BatchQueryTx_Handler,
+		},
+		{
+			MethodName: "ListPendingTxs",
+			Handler:    _Xchain_ListPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetPendingTx",
+			Handler:    _Xchain_GetPendingTx_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _Xchain_GetBalance_Handler,
@@ -7998,6 +13468,10 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFrozenBalance",
 			Handler:    _Xchain_GetFrozenBalance_Handler,
 		},
+		{
+			MethodName: "GetTokenSupply",
+			Handler:    _Xchain_GetTokenSupply_Handler,
+		},
 		{
 			MethodName: "GetBlock",
 			Handler:    _Xchain_GetBlock_Handler,
@@ -8006,6 +13480,14 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByHeight",
 			Handler:    _Xchain_GetBlockByHeight_Handler,
 		},
+		{
+			MethodName: "GetBlockHeaders",
+			Handler:    _Xchain_GetBlockHeaders_Handler,
+		},
+		{
+			MethodName: "QueryContractEvents",
+			Handler:    _Xchain_QueryContractEvents_Handler,
+		},
 		{
 			MethodName: "GetBlockChainStatus",
 			Handler:    _Xchain_GetBlockChainStatus_Handler,
@@ -8018,6 +13500,10 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSystemStatus",
 			Handler:    _Xchain_GetSystemStatus_Handler,
 		},
+		{
+			MethodName: "GetChainStats",
+			Handler:    _Xchain_GetChainStats_Handler,
+		},
 		{
 			MethodName: "GetConsensusStatus",
 			Handler:    _Xchain_GetConsensusStatus_Handler,
@@ -8030,10 +13516,22 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SelectUTXO",
 			Handler:    _Xchain_SelectUTXO_Handler,
 		},
+		{
+			MethodName: "ReleaseUTXOLock",
+			Handler:    _Xchain_ReleaseUTXOLock_Handler,
+		},
 		{
 			MethodName: "PreExecWithSelectUTXO",
 			Handler:    _Xchain_PreExecWithSelectUTXO_Handler,
 		},
+		{
+			MethodName: "SimulateTx",
+			Handler:    _Xchain_SimulateTx_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Xchain_EstimateFee_Handler,
+		},
 		{
 			MethodName: "DposCandidates",
 			Handler:    _Xchain_DposCandidates_Handler,
@@ -8062,10 +13560,30 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DposStatus",
 			Handler:    _Xchain_DposStatus_Handler,
 		},
+		{
+			MethodName: "XpoaValidators",
+			Handler:    _Xchain_XpoaValidators_Handler,
+		},
+		{
+			MethodName: "XpoaStatus",
+			Handler:    _Xchain_XpoaStatus_Handler,
+		},
+		{
+			MethodName: "GovernTokenBalance",
+			Handler:    _Xchain_GovernTokenBalance_Handler,
+		},
+		{
+			MethodName: "ProposalQuery",
+			Handler:    _Xchain_ProposalQuery_Handler,
+		},
 		{
 			MethodName: "GetAccountByAK",
 			Handler:    _Xchain_GetAccountByAK_Handler,
 		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _Xchain_GetAccountHistory_Handler,
+		},
 		{
 			MethodName: "GetAddressContracts",
 			Handler:    _Xchain_GetAddressContracts_Handler,
@@ -8075,6 +13593,17 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Xchain_PreExec_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlocksByRange",
+			Handler:       _Xchain_GetBlocksByRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlockHeaders",
+			Handler:       _Xchain_StreamBlockHeaders_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "xchain.proto",
 }
@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+// methodFilter 按grpc完整方法名过滤请求
+type methodFilter struct {
+	log   logs.Logger
+	allow map[string]bool
+	deny  map[string]bool
+}
+
+// newMethodFilter 允许和拒绝列表均为空时返回nil
+func newMethodFilter(allow, deny []string, log logs.Logger) *methodFilter {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+	return &methodFilter{
+		log:   log,
+		allow: fullMethodSet(allow),
+		deny:  fullMethodSet(deny),
+	}
+}
+
+// fullMethodSet 配置中的方法名可以省略开头的/
+func fullMethodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		set["/"+strings.TrimPrefix(method, "/")] = true
+	}
+	return set
+}
+
+func (f *methodFilter) permit(fullMethod string) bool {
+	if f.deny[fullMethod] {
+		return false
+	}
+	return len(f.allow) == 0 || f.allow[fullMethod]
+}
+
+// UnaryInterceptor 需要放在RpcServ.UnaryInterceptor之后，由其根据错误设置响应头
+func (f *methodFilter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if f.permit(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if rctx := sctx.ValueReqCtx(ctx); rctx != nil {
+			rctx.GetLog().Warn("request refused by method filter", "rpc_method", info.FullMethod)
+		}
+		return newEmptyResp(info), ecom.ErrForbidden
+	}
+}
+
+// StreamInterceptor 流式接口没有统一的响应头，直接返回grpc错误
+func (f *methodFilter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if !f.permit(info.FullMethod) {
+			f.log.Warn("stream refused by method filter", "rpc_method", info.FullMethod)
+			return status.Error(codes.PermissionDenied, "method not allowed")
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"testing"
+)
+
+func TestMethodFilter(t *testing.T) {
+	if newMethodFilter(nil, nil, nil) != nil {
+		t.Fatal("expect nil filter without lists")
+	}
+
+	filter := newMethodFilter(nil, []string{"/pb.Xchain/PostTx"}, nil)
+	if filter.permit("/pb.Xchain/PostTx") || !filter.permit("/pb.Xchain/GetBlock") {
+		t.Fatal("unexpected deny list result")
+	}
+
+	filter = newMethodFilter([]string{"pb.Xchain/GetBlock", "/pb.Xchain/PostTx"}, []string{"/pb.Xchain/PostTx"}, nil)
+	if !filter.permit("/pb.Xchain/GetBlock") {
+		t.Fatal("expect allowed method permitted")
+	}
+	if filter.permit("/pb.Xchain/PostTx") {
+		t.Fatal("expect deny list take precedence")
+	}
+	if filter.permit("/pb.EventService/Subscribe") {
+		t.Fatal("expect method out of allow list refused")
+	}
+}
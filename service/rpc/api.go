@@ -1,19 +1,52 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"math/big"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/xuperchain/xuperchain/models"
 	acom "github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/txindex"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	putils "github.com/xuperchain/xupercore/kernel/contract/proposal/utils"
+	"github.com/xuperchain/xupercore/kernel/contract/sandbox"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
 	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
 	"github.com/xuperchain/xupercore/lib/utils"
 	"github.com/xuperchain/xupercore/protos"
 )
 
+const (
+	// WaitTx默认和最长等待时间
+	defWaitTxTimeout = 30 * time.Second
+	maxWaitTxTimeout = 5 * time.Minute
+	// 分页查询默认和最大数量
+	defPageLimit = 20
+	maxPageLimit = 100
+	// BatchQueryTx单次最多查询的交易数量
+	maxBatchTxids = 100
+	// GetBlockHeaders单次最多返回的区块头数量
+	maxBlockHeaders = 500
+	// GetChainStats默认和最多统计的区块数，以及默认返回的合约数
+	defStatsWindow = 50
+	maxStatsWindow = 100
+	defStatsTopN   = 10
+	// GetSystemStatus计算各链速度时统计的区块数
+	speedStatsWindow = 20
+)
+
 // 注意：
 // 1.rpc接口响应resp不能为nil，必须实例化
 // 2.rpc接口响应err必须为ecom.Error类型的标准错误，没有错误响应err=nil
@@ -37,6 +70,10 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 		rctx.GetLog().Warn("param error,tx convert to xledger tx failed")
 		return resp, ecom.ErrParameter
 	}
+	if t.readOnly {
+		rctx.GetLog().Warn("node is read only,refuse post tx")
+		return resp, acom.ErrReadOnly
+	}
 
 	// 提交交易
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -60,6 +97,109 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 	return resp, err
 }
 
+// WaitTx wait until the transaction is confirmed on trunk with enough confirmations
+func (t *RpcServ) WaitTx(gctx context.Context, req *pb.WaitTxRequest) (*pb.WaitTxResponse, error) {
+	// 默认响应
+	resp := &pb.WaitTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 ||
+		req.GetConfirmations() < 0 || req.GetTimeout() < 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	confirmations := req.GetConfirmations()
+	if confirmations == 0 {
+		confirmations = 1
+	}
+	timeout := time.Duration(req.GetTimeout()) * time.Millisecond
+	if timeout == 0 {
+		timeout = defWaitTxTimeout
+	}
+	if timeout > maxWaitTxTimeout {
+		timeout = maxWaitTxTimeout
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	// 先订阅区块事件再查询交易，避免遗漏两者之间产生的区块
+	blockCh, unwatch, err := t.blockNotifier.watch(req.GetBcname())
+	if err != nil {
+		rctx.GetLog().Warn("subscribe block event failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+	defer unwatch()
+
+	ctx, cancel := context.WithTimeout(gctx, timeout)
+	defer cancel()
+	for {
+		done, err := t.fillWaitTxResp(handle, req.GetTxid(), confirmations, resp)
+		if err != nil {
+			rctx.GetLog().Warn("query tx failed", "err", err)
+			return resp, err
+		}
+		if done {
+			rctx.GetLog().SetInfoField("height", resp.GetHeight())
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			rctx.GetLog().Warn("wait tx timeout", "status", resp.GetStatus(),
+				"confirmations", resp.GetConfirmations())
+			return resp, acom.ErrWaitTxTimeout
+		case _, ok := <-blockCh:
+			if !ok {
+				rctx.GetLog().Warn("block event iterator exit")
+				return resp, ecom.ErrInternal.More("block event iterator exit")
+			}
+		}
+	}
+}
+
+// fillWaitTxResp 查询交易当前状态并填充响应，确认数满足要求时返回true
+func (t *RpcServ) fillWaitTxResp(handle *models.ChainHandle, txid []byte,
+	confirmations int64, resp *pb.WaitTxResponse) (bool, error) {
+	resp.Blockid = nil
+	resp.Height = 0
+	resp.Confirmations = 0
+
+	txInfo, err := handle.QueryTx(txid)
+	if err != nil {
+		// 交易可能尚未广播到本节点，继续等待
+		if ecom.CastError(err).Equal(ecom.ErrTxNotExist) {
+			resp.Status = pb.TransactionStatus_NOEXIST
+			return false, nil
+		}
+		return false, err
+	}
+
+	resp.Status = pb.TransactionStatus(txInfo.GetStatus())
+	if resp.Status != pb.TransactionStatus_CONFIRM {
+		return false, nil
+	}
+
+	blockid := txInfo.GetTx().GetBlockid()
+	blkInfo, err := handle.QueryBlock(blockid, false)
+	if err != nil {
+		return false, err
+	}
+	resp.Blockid = blockid
+	resp.Height = blkInfo.GetBlock().GetHeight()
+	resp.Confirmations = txInfo.GetDistance() + 1
+	return resp.Confirmations >= confirmations, nil
+}
+
 // PreExec smart contract preExec process
 func (t *RpcServ) PreExec(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.InvokeRPCResponse, error) {
 	// 默认响应
@@ -147,6 +287,152 @@ func (t *RpcServ) PreExecWithSelectUTXO(gctx context.Context,
 	return resp, nil
 }
 
+// SimulateTx preExec an assembled transaction and return the state diff it would make
+func (t *RpcServ) SimulateTx(gctx context.Context, req *pb.SimulateTxRequest) (*pb.SimulateTxResponse, error) {
+	// 默认响应
+	resp := &pb.SimulateTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	if req == nil || req.GetBcname() == "" || req.GetTx() == nil {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	tx := acom.TxToXledger(req.GetTx())
+	if tx == nil {
+		rctx.GetLog().Warn("param error,tx convert to xledger tx failed")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	// 合约执行结果按当前状态重新预执行，不使用交易中携带的读写集
+	if len(tx.GetContractRequests()) > 0 {
+		res, err := handle.PreExec(tx.GetContractRequests(), tx.GetInitiator(), tx.GetAuthRequire())
+		if err != nil {
+			rctx.GetLog().Warn("pre exec failed", "err", err)
+			return resp, err
+		}
+		resp.GasUsed = res.GetGasUsed()
+		resp.Responses = acom.ConvertInvokeResp(res).GetResponses()
+
+		events, err := sandbox.ParseContractEvents(&lpb.Transaction{TxOutputsExt: res.GetOutputs()})
+		if err != nil {
+			rctx.GetLog().Warn("parse contract events failed", "err", err)
+			return resp, ecom.ErrInternal.More("%v", err)
+		}
+		resp.Events = acom.ContractEventsToXchain(events)
+
+		for _, output := range res.GetOutputs() {
+			// 临时bucket只记录合约utxo和事件，不会写入状态
+			if output.GetBucket() == sandbox.TransientBucket {
+				continue
+			}
+			oldValue, err := handle.GetContractValue(output.GetBucket(), output.GetKey())
+			if err != nil {
+				rctx.GetLog().Warn("get contract value failed", "bucket", output.GetBucket(), "err", err)
+				return resp, err
+			}
+			change := &pb.KeyChange{
+				Bucket:   output.GetBucket(),
+				Key:      output.GetKey(),
+				OldValue: oldValue,
+			}
+			if sandbox.IsDelFlag(output.GetValue()) {
+				change.Deleted = true
+			} else {
+				change.NewValue = output.GetValue()
+			}
+			resp.KeyChanges = append(resp.KeyChanges, change)
+		}
+	}
+
+	// 组装好的交易已包含合约转账产生的utxo输入输出
+	deltas := acom.UtxoBalanceDeltas(tx.GetTxInputs(), tx.GetTxOutputs())
+	for _, addr := range acom.SortedAddresses(deltas) {
+		balance, err := handle.GetBalance(addr)
+		if err != nil {
+			rctx.GetLog().Warn("get balance failed", "address", addr, "err", err)
+			return resp, err
+		}
+		newBalance, ok := new(big.Int).SetString(balance, 10)
+		if !ok {
+			return resp, ecom.ErrInternal.More("bad balance %s", balance)
+		}
+		newBalance.Add(newBalance, deltas[addr])
+		resp.BalanceChanges = append(resp.BalanceChanges, &pb.BalanceChange{
+			Address:    addr,
+			Balance:    balance,
+			NewBalance: newBalance.String(),
+			Delta:      deltas[addr].String(),
+		})
+	}
+	if fee, ok := deltas[utxo.FeePlaceholder]; ok {
+		resp.Fee = fee.String()
+	}
+	resp.Bcname = req.GetBcname()
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("initiator", tx.GetInitiator())
+	rctx.GetLog().SetInfoField("gas_used", resp.GetGasUsed())
+	return resp, nil
+}
+
+// EstimateFee preExec and estimate the fee of each resource
+func (t *RpcServ) EstimateFee(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.EstimateFeeResponse, error) {
+	// 默认响应
+	resp := &pb.EstimateFeeResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	reqs, err := acom.ConvertInvokeReq(req.GetRequests())
+	if err != nil {
+		rctx.GetLog().Warn("param error, convert failed", "err", err)
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	res, err := handle.PreExec(reqs, req.GetInitiator(), req.GetAuthRequire())
+	if err != nil {
+		rctx.GetLog().Warn("pre exec failed", "err", err)
+		return resp, err
+	}
+
+	// 预执行返回的请求中系统保留合约在前且不计费，只统计用户请求部分
+	userReqCnt := 0
+	for _, r := range reqs {
+		if r != nil && (r.GetModuleName() != "" || r.GetContractName() != "" || r.GetMethodName() != "") {
+			userReqCnt++
+		}
+	}
+	requests := res.GetRequests()
+	if len(requests) > userReqCnt {
+		requests = requests[len(requests)-userReqCnt:]
+	}
+
+	resp = acom.EstimateFee(requests, handle.GetGasPrice())
+	resp.Bcname = req.GetBcname()
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("initiator", req.GetInitiator())
+	rctx.GetLog().SetInfoField("gas_used", res.GetGasUsed())
+	return resp, nil
+}
+
 // SelectUTXO select utxo inputs depending on amount
 func (t *RpcServ) SelectUTXO(gctx context.Context, req *pb.UtxoInput) (*pb.UtxoOutput, error) {
 	// 默认响应
@@ -164,6 +450,11 @@ func (t *RpcServ) SelectUTXO(gctx context.Context, req *pb.UtxoInput) (*pb.UtxoO
 		return resp, ecom.ErrParameter
 	}
 
+	if req.GetNeedLock() && t.readOnly {
+		rctx.GetLog().Warn("node is read only,refuse lock utxo")
+		return resp, acom.ErrReadOnly
+	}
+
 	// select utxo
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
@@ -200,6 +491,11 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 		return resp, ecom.ErrParameter
 	}
 
+	if req.GetNeedLock() && t.readOnly {
+		rctx.GetLog().Warn("node is read only,refuse lock utxo")
+		return resp, acom.ErrReadOnly
+	}
+
 	// select utxo
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
@@ -224,45 +520,58 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 	return resp, nil
 }
 
-// QueryContractStatData query statistic info about contract
-func (t *RpcServ) QueryContractStatData(gctx context.Context,
-	req *pb.ContractStatDataRequest) (*pb.ContractStatDataResponse, error) {
+// ReleaseUTXOLock release utxos locked by SelectUTXO or SelectUTXOBySize
+func (t *RpcServ) ReleaseUTXOLock(gctx context.Context, req *pb.UtxoLockRelease) (*pb.CommonReply, error) {
 	// 默认响应
-	resp := &pb.ContractStatDataResponse{}
+	resp := &pb.CommonReply{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
+	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" || len(req.GetUtxoList()) == 0 {
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
+	utxoList := make([]*lpb.Utxo, 0, len(req.GetUtxoList()))
+	for _, item := range req.GetUtxoList() {
+		// utxo只能属于请求中的地址
+		if len(item.GetRefTxid()) == 0 ||
+			(len(item.GetToAddr()) > 0 && string(item.GetToAddr()) != req.GetAddress()) {
+			rctx.GetLog().Warn("param error,utxo set error", "refTxid", utils.F(item.GetRefTxid()),
+				"refOffset", item.GetRefOffset())
+			return resp, ecom.ErrParameter
+		}
+		utxoList = append(utxoList, acom.UtxoToXledger(item))
+	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
-	res, err := handle.QueryContractStatData()
+	// 合约账户由账户的AK签名，普通地址由地址本身签名
+	signs := []*protos.SignatureInfo{{PublicKey: req.GetPublickey(), Sign: req.GetUserSign()}}
+	if aclUtils.IsAccount(req.GetAddress()) {
+		signs = make([]*protos.SignatureInfo, 0, len(req.GetAuthRequireSigns()))
+		for _, sign := range req.GetAuthRequireSigns() {
+			signs = append(signs, &protos.SignatureInfo{PublicKey: sign.GetPublicKey(), Sign: sign.GetSign()})
+		}
+	}
+	err = handle.ReleaseUtxoLock(req.GetAddress(), utxoList, req.GetTimestamp(), signs)
 	if err != nil {
-		rctx.GetLog().Warn("query contract stat data failed", "err", err.Error())
+		rctx.GetLog().Warn("release utxo lock failed", "err", err.Error())
 		return resp, err
 	}
 
-	resp.Bcname = req.GetBcname()
-	resp.Data = &pb.ContractStatData{
-		AccountCount:  res.GetAccountCount(),
-		ContractCount: res.GetContractCount(),
-	}
-
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	rctx.GetLog().SetInfoField("utxo_count", len(utxoList))
 	return resp, nil
 }
 
-// QueryUtxoRecord query utxo records
-func (t *RpcServ) QueryUtxoRecord(gctx context.Context,
-	req *pb.UtxoRecordDetail) (*pb.UtxoRecordDetail, error) {
-
+// QueryContractStatData query statistic info about contract
+func (t *RpcServ) QueryContractStatData(gctx context.Context,
+	req *pb.ContractStatDataRequest) (*pb.ContractStatDataResponse, error) {
 	// 默认响应
-	resp := &pb.UtxoRecordDetail{}
+	resp := &pb.ContractStatDataResponse{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
@@ -276,84 +585,94 @@ func (t *RpcServ) QueryUtxoRecord(gctx context.Context,
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
-	res, err := handle.QueryUtxoRecord(req.GetAccountName(), req.GetDisplayCount())
+	res, err := handle.QueryContractStatData()
 	if err != nil {
-		rctx.GetLog().Warn("query utxo record failed", "err", err.Error())
+		rctx.GetLog().Warn("query contract stat data failed", "err", err.Error())
 		return resp, err
 	}
 
 	resp.Bcname = req.GetBcname()
-	resp.AccountName = req.GetAccountName()
-	resp.OpenUtxoRecord = acom.UtxoRecordToXchain(res.GetOpenUtxo())
-	resp.LockedUtxoRecord = acom.UtxoRecordToXchain(res.GetLockedUtxo())
-	resp.FrozenUtxoRecord = acom.UtxoRecordToXchain(res.GetFrozenUtxo())
-	resp.DisplayCount = req.GetDisplayCount()
+	resp.Data = &pb.ContractStatData{
+		AccountCount:  res.GetAccountCount(),
+		ContractCount: res.GetContractCount(),
+	}
 
 	return resp, nil
 }
 
-// QueryACL query some account info
-func (t *RpcServ) QueryACL(gctx context.Context, req *pb.AclStatus) (*pb.AclStatus, error) {
+// ListContractKeys list contract state keys by prefix with pagination
+func (t *RpcServ) ListContractKeys(gctx context.Context,
+	req *pb.ListContractKeysRequest) (*pb.ListContractKeysResponse, error) {
 	// 默认响应
-	resp := &pb.AclStatus{}
+	resp := &pb.ListContractKeysResponse{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
+	if req == nil || req.GetBcname() == "" || req.GetContractName() == "" ||
+		req.GetLimit() < 0 || req.GetLimit() > maxPageLimit ||
+		(req.GetUseSnapshot() && req.GetHeight() < 0) {
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
-	if len(req.GetAccountName()) < 1 && (len(req.GetContractName()) < 1 || len(req.GetMethodName()) < 1) {
-		rctx.GetLog().Warn("param error,unset name")
+	// 游标为上一页最后一个key，必须在前缀范围内
+	if len(req.GetCursor()) > 0 && !bytes.HasPrefix(req.GetCursor(), req.GetPrefix()) {
+		rctx.GetLog().Warn("param error,cursor not match prefix")
 		return resp, ecom.ErrParameter
 	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defPageLimit
+	}
+	height := int64(-1)
+	if req.GetUseSnapshot() {
+		height = req.GetHeight()
+	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
-
-	var aclRes *protos.Acl
-	if len(req.GetAccountName()) > 0 {
-		aclRes, err = handle.QueryAccountACL(req.GetAccountName())
-	} else if len(req.GetContractName()) > 0 && len(req.GetMethodName()) > 0 {
-		aclRes, err = handle.QueryContractMethodACL(req.GetContractName(), req.GetMethodName())
+	var start []byte
+	if len(req.GetCursor()) > 0 {
+		start = append(append([]byte{}, req.GetCursor()...), 0)
 	}
+	kvs, hasMore, err := handle.ListContractKeys(req.GetContractName(), req.GetPrefix(),
+		start, int(limit), height)
 	if err != nil {
-		rctx.GetLog().Warn("query acl failed", "err", err)
+		rctx.GetLog().Warn("list contract keys failed", "err", err.Error())
 		return resp, err
 	}
 
-	if aclRes == nil {
-		resp.Confirmed = false
-		return resp, nil
+	resp.Kvs = make([]*pb.ContractKV, 0, len(kvs))
+	for _, kv := range kvs {
+		resp.Kvs = append(resp.Kvs, &pb.ContractKV{
+			Key:   kv.Key,
+			Value: kv.Value,
+		})
 	}
-
-	xchainAcl := acom.AclToXchain(aclRes)
-	if xchainAcl == nil {
-		rctx.GetLog().Warn("convert acl failed")
-		return resp, ecom.ErrInternal
+	if hasMore && len(kvs) > 0 {
+		resp.NextCursor = kvs[len(kvs)-1].Key
 	}
-
 	resp.Bcname = req.GetBcname()
-	resp.AccountName = req.GetAccountName()
 	resp.ContractName = req.GetContractName()
-	resp.MethodName = req.GetMethodName()
-	resp.Confirmed = true
-	resp.Acl = xchainAcl
 
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("contract_name", req.GetContractName())
+	rctx.GetLog().SetInfoField("height", height)
 	return resp, nil
 }
 
-// GetAccountContracts get account request
-func (t *RpcServ) GetAccountContracts(gctx context.Context, req *pb.GetAccountContractsRequest) (*pb.GetAccountContractsResponse, error) {
+// GetStateAtHeight query the value of a bucket key as of a trunk block
+func (t *RpcServ) GetStateAtHeight(gctx context.Context,
+	req *pb.StateAtHeightRequest) (*pb.StateAtHeightResponse, error) {
 	// 默认响应
-	resp := &pb.GetAccountContractsResponse{}
+	resp := &pb.StateAtHeightResponse{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAccount() == "" {
+	if req == nil || req.GetBcname() == "" || req.GetBucket() == "" || len(req.GetKey()) == 0 ||
+		(len(req.GetBlockid()) == 0 && req.GetHeight() < 0) {
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
@@ -364,65 +683,665 @@ func (t *RpcServ) GetAccountContracts(gctx context.Context, req *pb.GetAccountCo
 		return resp, err
 	}
 
-	var res []*protos.ContractStatus
-	res, err = handle.GetAccountContracts(req.GetAccount())
-	if err != nil {
-		rctx.GetLog().Warn("get account contract failed", "err", err)
+	if err := t.readStateAtHeight(rctx, handle, req, resp); err != nil {
 		return resp, err
 	}
-	xchainContractStatus, err := acom.ContractStatusListToXchain(res)
-	if err != nil || xchainContractStatus == nil {
-		rctx.GetLog().Warn("convert acl failed")
-		return resp, ecom.ErrInternal
-	}
-
-	resp.ContractsStatus = xchainContractStatus
 
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
-	rctx.GetLog().SetInfoField("account", req.GetAccount())
+	rctx.GetLog().SetInfoField("bucket", req.GetBucket())
+	rctx.GetLog().SetInfoField("height", resp.GetHeight())
 	return resp, nil
 }
 
-// QueryTx Get transaction details
-func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus, error) {
+// snapshotReader 读取区块头和区块快照，由ChainHandle实现
+type snapshotReader interface {
+	QueryBlockHeader(blkId []byte) (*xpb.BlockInfo, error)
+	QueryBlockHeaderByHeight(height int64) (*xpb.BlockInfo, error)
+	GetSnapshotValue(bucket string, key, blkId []byte) ([]byte, error)
+}
+
+// readStateAtHeight 读取请求指定区块时的存储值并填充响应
+func (t *RpcServ) readStateAtHeight(rctx sctx.ReqCtx, reader snapshotReader,
+	req *pb.StateAtHeightRequest, resp *pb.StateAtHeightResponse) error {
+	// blockid优先，否则按高度查询主干区块
+	var blkInfo *xpb.BlockInfo
+	var err error
+	if len(req.GetBlockid()) > 0 {
+		blkInfo, err = reader.QueryBlockHeader(req.GetBlockid())
+	} else {
+		blkInfo, err = reader.QueryBlockHeaderByHeight(req.GetHeight())
+	}
+	if err != nil {
+		rctx.GetLog().Warn("query block header failed", "err", err.Error())
+		return err
+	}
+	// 快照按区块高度沿主干交易回溯，只支持主干区块
+	if pb.Block_EBlockStatus(blkInfo.GetStatus()) != pb.Block_TRUNK {
+		rctx.GetLog().Warn("block not in trunk", "status", blkInfo.GetStatus())
+		return ecom.ErrBlockNotExist
+	}
+
+	block := blkInfo.GetBlock()
+	value, err := reader.GetSnapshotValue(req.GetBucket(), req.GetKey(), block.GetBlockid())
+	if err != nil {
+		rctx.GetLog().Warn("get snapshot value failed", "err", err.Error())
+		return err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Bucket = req.GetBucket()
+	resp.Key = req.GetKey()
+	resp.Value = value
+	resp.Blockid = block.GetBlockid()
+	resp.Height = block.GetHeight()
+	return nil
+}
+
+// QueryUtxoRecord query utxo records
+func (t *RpcServ) QueryUtxoRecord(gctx context.Context,
+	req *pb.UtxoRecordDetail) (*pb.UtxoRecordDetail, error) {
+
 	// 默认响应
-	resp := &pb.TxStatus{}
+	resp := &pb.UtxoRecordDetail{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+	if req == nil || req.GetBcname() == "" {
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
-		rctx.GetLog().Warn("new chain handle failed", "err", err)
-		return resp, ecom.ErrInternal.More("%v", err)
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
 	}
-
-	txInfo, err := handle.QueryTx(req.GetTxid())
+	res, err := handle.QueryUtxoRecord(req.GetAccountName(), req.GetDisplayCount())
 	if err != nil {
-		rctx.GetLog().Warn("query tx failed", "err", err)
+		rctx.GetLog().Warn("query utxo record failed", "err", err.Error())
 		return resp, err
 	}
 
-	tx := acom.TxToXchain(txInfo.Tx)
-	if tx == nil {
+	resp.Bcname = req.GetBcname()
+	resp.AccountName = req.GetAccountName()
+	resp.OpenUtxoRecord = acom.UtxoRecordToXchain(res.GetOpenUtxo())
+	resp.LockedUtxoRecord = acom.UtxoRecordToXchain(res.GetLockedUtxo())
+	resp.FrozenUtxoRecord = acom.UtxoRecordToXchain(res.GetFrozenUtxo())
+	resp.DisplayCount = req.GetDisplayCount()
+
+	return resp, nil
+}
+
+// GetAddressTxHistory query transactions related to an address from tx index
+func (t *RpcServ) GetAddressTxHistory(gctx context.Context,
+	req *pb.AddressTxHistoryRequest) (*pb.AddressTxHistoryResponse, error) {
+	// 默认响应
+	resp := &pb.AddressTxHistoryResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.txIndex == nil {
+		rctx.GetLog().Warn("tx index disabled")
+		return resp, ecom.ErrForbidden.More("tx index disabled")
+	}
+	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" ||
+		req.GetLimit() < 0 || req.GetLimit() > maxPageLimit {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defPageLimit
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	records, nextCursor, err := t.txIndex.Query(req.GetBcname(), req.GetAddress(),
+		req.GetCursor(), int(limit))
+	if err == txindex.ErrBadCursor {
+		rctx.GetLog().Warn("param error,bad cursor", "cursor", utils.F(req.GetCursor()))
+		return resp, ecom.ErrParameter
+	}
+	if err == txindex.ErrIndexNotReady {
+		rctx.GetLog().Warn("tx index not ready", "bc_name", req.GetBcname())
+		return resp, ecom.ErrChainStatus.More("%v", err)
+	}
+	if err != nil {
+		rctx.GetLog().Warn("query tx index failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	resp.Txs = make([]*pb.AddressTx, 0, len(records))
+	for _, record := range records {
+		item := &pb.AddressTx{
+			Txid:    record.Txid,
+			Blockid: record.Blockid,
+			Height:  record.Height,
+			Roles:   acom.TxIndexRolesToXchain(record.Roles),
+		}
+		if req.GetNeedContent() {
+			txInfo, err := handle.QueryTx(record.Txid)
+			if err != nil {
+				rctx.GetLog().Warn("query tx failed", "txid", utils.F(record.Txid), "err", err)
+				return resp, err
+			}
+			item.Tx = acom.TxToXchain(txInfo.Tx)
+		}
+		resp.Txs = append(resp.Txs, item)
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Address = req.GetAddress()
+	resp.NextCursor = nextCursor
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	rctx.GetLog().SetInfoField("tx_count", len(resp.Txs))
+	return resp, nil
+}
+
+// QueryACL query some account info
+func (t *RpcServ) QueryACL(gctx context.Context, req *pb.AclStatus) (*pb.AclStatus, error) {
+	// 默认响应
+	resp := &pb.AclStatus{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	if len(req.GetAccountName()) < 1 && (len(req.GetContractName()) < 1 || len(req.GetMethodName()) < 1) {
+		rctx.GetLog().Warn("param error,unset name")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	var aclRes *protos.Acl
+	if len(req.GetAccountName()) > 0 {
+		aclRes, err = handle.QueryAccountACL(req.GetAccountName())
+	} else if len(req.GetContractName()) > 0 && len(req.GetMethodName()) > 0 {
+		aclRes, err = handle.QueryContractMethodACL(req.GetContractName(), req.GetMethodName())
+	}
+	if err != nil {
+		rctx.GetLog().Warn("query acl failed", "err", err)
+		return resp, err
+	}
+
+	if aclRes == nil {
+		resp.Confirmed = false
+		return resp, nil
+	}
+
+	xchainAcl := acom.AclToXchain(aclRes)
+	if xchainAcl == nil {
+		rctx.GetLog().Warn("convert acl failed")
+		return resp, ecom.ErrInternal
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.AccountName = req.GetAccountName()
+	resp.ContractName = req.GetContractName()
+	resp.MethodName = req.GetMethodName()
+	resp.Confirmed = true
+	resp.Acl = xchainAcl
+
+	return resp, nil
+}
+
+// GetAccountContracts get account request
+func (t *RpcServ) GetAccountContracts(gctx context.Context, req *pb.GetAccountContractsRequest) (*pb.GetAccountContractsResponse, error) {
+	// 默认响应
+	resp := &pb.GetAccountContractsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetAccount() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	var res []*protos.ContractStatus
+	res, err = handle.GetAccountContracts(req.GetAccount())
+	if err != nil {
+		rctx.GetLog().Warn("get account contract failed", "err", err)
+		return resp, err
+	}
+	xchainContractStatus, err := acom.ContractStatusListToXchain(res)
+	if err != nil || xchainContractStatus == nil {
+		rctx.GetLog().Warn("convert acl failed")
+		return resp, ecom.ErrInternal
+	}
+
+	resp.ContractsStatus = xchainContractStatus
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("account", req.GetAccount())
+	return resp, nil
+}
+
+// GetContractInfo get deploy info of a contract
+func (t *RpcServ) GetContractInfo(gctx context.Context, req *pb.ContractInfoRequest) (*pb.ContractInfoResponse, error) {
+	// 默认响应
+	resp := &pb.ContractInfoResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetContractName() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	info, err := handle.GetContractInfo(req.GetContractName(), req.GetNeedCode())
+	if err != nil {
+		rctx.GetLog().Warn("get contract info failed", "err", err)
+		return resp, err
+	}
+	if info == nil {
+		rctx.GetLog().Warn("contract not exist", "contract", req.GetContractName())
+		return resp, acom.ErrContractNotExist
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.ContractName = req.GetContractName()
+	fillContractInfo(resp, info)
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("contract", req.GetContractName())
+	return resp, nil
+}
+
+// fillContractInfo 将合约部署信息填充到响应
+func fillContractInfo(resp *pb.ContractInfoResponse, info *models.ContractInfo) {
+	resp.Module = info.Desc.GetContractType()
+	if resp.Module == "" {
+		// 早期部署的wasm合约没有记录合约类型
+		resp.Module = "wasm"
+	}
+	resp.Runtime = info.Desc.GetRuntime()
+	resp.Compiler = info.Desc.GetCompiler()
+	resp.VmCompiler = info.Desc.GetVmCompiler()
+	resp.CodeHash = info.Desc.GetDigest()
+	resp.Account = info.Account
+	resp.DeployTxid = info.DeployTx.GetTxid()
+	resp.UpgradeTxid = info.UpgradeTx.GetTxid()
+	resp.Abi = info.Abi
+	resp.Code = info.Code
+}
+
+// QueryTx Get transaction details
+func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus, error) {
+	// 默认响应
+	resp := &pb.TxStatus{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	txInfo, err := handle.QueryTx(req.GetTxid())
+	if err != nil {
+		rctx.GetLog().Warn("query tx failed", "err", err)
+		return resp, err
+	}
+
+	tx := acom.TxToXchain(txInfo.Tx)
+	if tx == nil {
 		rctx.GetLog().Warn("convert tx failed")
 		return resp, ecom.ErrInternal
 	}
-	resp.Bcname = req.GetBcname()
-	resp.Txid = req.GetTxid()
-	resp.Tx = tx
-	resp.Status = pb.TransactionStatus(txInfo.Status)
-	resp.Distance = txInfo.Distance
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	resp.Tx = tx
+	resp.Status = pb.TransactionStatus(txInfo.Status)
+	resp.Distance = txInfo.Distance
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("account", utils.F(req.GetTxid()))
+	return resp, nil
+}
+
+// GetTxProof get merkle proof of a confirmed transaction
+func (t *RpcServ) GetTxProof(gctx context.Context, req *pb.TxProofRequest) (*pb.TxProofResponse, error) {
+	// 默认响应
+	resp := &pb.TxProofResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	txInfo, err := handle.QueryTx(req.GetTxid())
+	if err != nil {
+		rctx.GetLog().Warn("query tx failed", "err", err.Error())
+		return resp, err
+	}
+	// 只有主干上的交易才能给出证明，未确认或在分支上的交易稍后可能上链
+	if pb.TransactionStatus(txInfo.GetStatus()) != pb.TransactionStatus_CONFIRM {
+		rctx.GetLog().Warn("tx not confirmed", "status", txInfo.GetStatus())
+		return resp, acom.ErrTxNotConfirmed
+	}
+
+	blkInfo, err := handle.QueryBlock(txInfo.GetTx().GetBlockid(), true)
+	if err != nil {
+		rctx.GetLog().Warn("query block failed", "err", err.Error())
+		return resp, err
+	}
+	block := blkInfo.GetBlock()
+
+	txIndex := -1
+	for i, tx := range block.GetTransactions() {
+		if bytes.Equal(tx.GetTxid(), req.GetTxid()) {
+			txIndex = i
+			break
+		}
+	}
+	if txIndex < 0 {
+		rctx.GetLog().Warn("tx not found in block", "blockid", utils.F(block.GetBlockid()))
+		return resp, ecom.ErrInternal.More("tx not found in block")
+	}
+
+	// 区块中未保存merkle树时重新计算
+	tree := block.GetMerkleTree()
+	if len(tree) == 0 {
+		tree = ledger.MakeMerkleTree(block.GetTransactions())
+	}
+	path, err := acom.MakeMerklePath(tree, txIndex)
+	if err != nil {
+		rctx.GetLog().Warn("make merkle path failed", "err", err.Error())
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	header := acom.BlockToXchain(block)
+	if header == nil {
+		rctx.GetLog().Warn("convert block failed")
+		return resp, ecom.ErrInternal
+	}
+	header.Transactions = nil
+	header.MerkleTree = nil
+
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	resp.BlockHeader = header
+	resp.TxIndex = int64(txIndex)
+	resp.MerklePath = path
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+	return resp, nil
+}
+
+// ListPendingTxs list unconfirmed transactions in mempool
+func (t *RpcServ) ListPendingTxs(gctx context.Context, req *pb.PendingTxsRequest) (*pb.PendingTxsResponse, error) {
+	// 默认响应
+	resp := &pb.PendingTxsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetOffset() < 0 || req.GetLimit() < 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	txs, err := handle.GetPendingTxs()
+	if err != nil {
+		rctx.GetLog().Warn("get pending txs failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	matched := filterPendingTxs(txs, req.GetInitiator(), req.GetContract())
+	resp.Bcname = req.GetBcname()
+	resp.PoolSize = int64(len(txs))
+	resp.Matched = int64(len(matched))
+
+	// mempool随时变化，按偏移分页只保证单次结果的一致性
+	now := time.Now()
+	for i := req.GetOffset(); i < int64(len(matched)) && i < req.GetOffset()+limit; i++ {
+		pendingTx, err := t.toPendingTx(handle, matched[i], now, req.GetNeedContent())
+		if err != nil {
+			rctx.GetLog().Warn("convert pending tx failed", "err", err)
+			return resp, err
+		}
+		resp.Txs = append(resp.Txs, pendingTx)
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("pool_size", resp.PoolSize)
+	rctx.GetLog().SetInfoField("matched", resp.Matched)
+	return resp, nil
+}
+
+// GetPendingTx get an unconfirmed transaction in mempool
+func (t *RpcServ) GetPendingTx(gctx context.Context, req *pb.PendingTxRequest) (*pb.PendingTxResponse, error) {
+	// 默认响应
+	resp := &pb.PendingTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	tx, ok := handle.GetPendingTx(req.GetTxid())
+	if !ok || tx == nil {
+		rctx.GetLog().Warn("tx not in mempool")
+		return resp, ecom.ErrTxNotExist.More("tx not in mempool")
+	}
+
+	pendingTx, err := t.toPendingTx(handle, tx, time.Now(), req.GetNeedContent())
+	if err != nil {
+		rctx.GetLog().Warn("convert pending tx failed", "err", err)
+		return resp, err
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Tx = pendingTx
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+	rctx.GetLog().SetInfoField("state", pendingTx.GetState().String())
+	return resp, nil
+}
+
+// mempoolReader 查询交易在主干或mempool中的位置，由ChainHandle实现
+type mempoolReader interface {
+	IsTxInTrunk(txid []byte) bool
+	GetPendingTx(txid []byte) (*lpb.Transaction, bool)
+}
+
+// toPendingTx 转换未确认交易，并根据依赖交易所在位置判断其状态
+func (t *RpcServ) toPendingTx(handle mempoolReader, tx *lpb.Transaction,
+	now time.Time, needContent bool) (*pb.PendingTx, error) {
+	pendingTx := &pb.PendingTx{
+		Txid:              tx.GetTxid(),
+		Initiator:         tx.GetInitiator(),
+		ReceivedTimestamp: tx.GetReceivedTimestamp(),
+		Age:               now.Sub(time.Unix(0, tx.GetReceivedTimestamp())).Milliseconds(),
+		State:             pb.PendingTxState_PENDING_READY,
+	}
+	for _, req := range tx.GetContractRequests() {
+		pendingTx.Contracts = append(pendingTx.Contracts, req.GetContractName())
+	}
+
+	refTxids := make(map[string]bool)
+	for _, input := range tx.GetTxInputs() {
+		refTxids[string(input.GetRefTxid())] = true
+	}
+	for _, input := range tx.GetTxInputsExt() {
+		refTxids[string(input.GetRefTxid())] = true
+	}
+	delete(refTxids, "")
+	for refTxid := range refTxids {
+		if handle.IsTxInTrunk([]byte(refTxid)) {
+			continue
+		}
+		pendingTx.Parents = append(pendingTx.Parents, []byte(refTxid))
+		if _, ok := handle.GetPendingTx([]byte(refTxid)); ok {
+			if pendingTx.State == pb.PendingTxState_PENDING_READY {
+				pendingTx.State = pb.PendingTxState_PENDING_WAIT_PARENT
+			}
+		} else {
+			pendingTx.State = pb.PendingTxState_PENDING_ORPHAN
+		}
+	}
+	sort.Slice(pendingTx.Parents, func(i, j int) bool {
+		return bytes.Compare(pendingTx.Parents[i], pendingTx.Parents[j]) < 0
+	})
+
+	if needContent {
+		pendingTx.Tx = acom.TxToXchain(tx)
+		if pendingTx.Tx == nil {
+			return nil, ecom.ErrInternal.More("convert tx failed")
+		}
+	}
+	return pendingTx, nil
+}
+
+// filterPendingTxs 按发起者和调用合约过滤交易，条件为空时不过滤
+func filterPendingTxs(txs []*lpb.Transaction, initiator, contract string) []*lpb.Transaction {
+	var matched []*lpb.Transaction
+	for _, tx := range txs {
+		if initiator != "" && tx.GetInitiator() != initiator {
+			continue
+		}
+		if contract != "" && !txInvokesContract(tx, contract) {
+			continue
+		}
+		matched = append(matched, tx)
+	}
+	return matched
+}
+
+func txInvokesContract(tx *lpb.Transaction, contract string) bool {
+	for _, req := range tx.GetContractRequests() {
+		if req.GetContractName() == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchQueryTx Get transaction details of a batch of txids
+func (t *RpcServ) BatchQueryTx(gctx context.Context, req *pb.BatchTxRequest) (*pb.BatchTxs, error) {
+	// 默认响应
+	resp := &pb.BatchTxs{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxids()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	if len(req.GetTxids()) > maxBatchTxids {
+		rctx.GetLog().Warn("param error,too many txids", "count", len(req.GetTxids()))
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, err
+	}
+
+	// 单个交易查询失败不影响整体，错误码通过各条目的Header返回
+	resp.Txs = make([]*pb.TxStatus, 0, len(req.GetTxids()))
+	for _, txid := range req.GetTxids() {
+		resp.Txs = append(resp.Txs, t.queryTxStatus(handle.QueryTx, req.GetBcname(), txid))
+	}
 
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
-	rctx.GetLog().SetInfoField("account", utils.F(req.GetTxid()))
+	rctx.GetLog().SetInfoField("tx_count", len(req.GetTxids()))
 	return resp, nil
 }
 
+// queryTxStatus query one transaction, and set error code into the header of result
+func (t *RpcServ) queryTxStatus(queryTx func(txid []byte) (*xpb.TxInfo, error),
+	bcName string, txid []byte) *pb.TxStatus {
+	item := &pb.TxStatus{
+		Header: &pb.Header{
+			Error: pb.XChainErrorEnum_SUCCESS,
+		},
+		Bcname: bcName,
+		Txid:   txid,
+	}
+	if len(txid) == 0 {
+		item.Header.Error = t.convertErr(ecom.ErrParameter)
+		return item
+	}
+
+	txInfo, err := queryTx(txid)
+	if err != nil {
+		stdErr := ecom.CastError(err)
+		if stdErr.Code == ecom.ErrTxNotExist.Code {
+			item.Status = pb.TransactionStatus_NOEXIST
+		}
+		item.Header.Error = t.convertErr(stdErr)
+		return item
+	}
+
+	tx := acom.TxToXchain(txInfo.Tx)
+	if tx == nil {
+		item.Header.Error = t.convertErr(ecom.ErrInternal)
+		return item
+	}
+	item.Tx = tx
+	item.Status = pb.TransactionStatus(txInfo.Status)
+	item.Distance = txInfo.Distance
+	return item
+}
+
 // GetBalance get balance for account or addr
 func (t *RpcServ) GetBalance(gctx context.Context, req *pb.AddressStatus) (*pb.AddressStatus, error) {
 	// 默认响应
@@ -551,6 +1470,72 @@ func (t *RpcServ) GetBalanceDetail(gctx context.Context, req *pb.AddressBalanceS
 	return resp, nil
 }
 
+// GetTokenSupply get total, frozen and circulating native token and top holders
+func (t *RpcServ) GetTokenSupply(gctx context.Context, req *pb.TokenSupplyRequest) (*pb.TokenSupplyResponse, error) {
+	// 默认响应
+	resp := &pb.TokenSupplyResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.holderCache == nil {
+		rctx.GetLog().Warn("token supply disabled")
+		return resp, ecom.ErrForbidden.More("token supply disabled")
+	}
+	if req == nil || req.GetBcname() == "" || req.GetTopN() < 0 || req.GetTopN() > maxPageLimit {
+		rctx.GetLog().Warn("param error,some param unset or invalid top_n")
+		return resp, ecom.ErrParameter
+	}
+	topN := req.GetTopN()
+	if topN == 0 {
+		topN = defPageLimit
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "err", err)
+		return resp, err
+	}
+	utxoMeta := acom.UtxoMetaToXchain(status.GetUtxoMeta())
+	total, ok := new(big.Int).SetString(utxoMeta.GetUtxoTotal(), 10)
+	if !ok {
+		rctx.GetLog().Warn("parse utxo total failed", "utxo_total", utxoMeta.GetUtxoTotal())
+		return resp, ecom.ErrInternal.More("invalid utxo total")
+	}
+	height := status.GetLedgerMeta().GetTrunkHeight()
+	holders, err := t.holderCache.get(req.GetBcname(), height, handle.ListTokenHolders)
+	if err != nil {
+		rctx.GetLog().Warn("list token holders failed", "err", err)
+		return resp, err
+	}
+
+	frozen := big.NewInt(0)
+	for i, holder := range holders {
+		frozen.Add(frozen, holder.Frozen)
+		if int64(i) < topN {
+			resp.TopHolders = append(resp.TopHolders, &pb.TokenHolder{
+				Address: holder.Address,
+				Balance: holder.Balance.String(),
+				Frozen:  holder.Frozen.String(),
+			})
+		}
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Height = height
+	resp.Total = total.String()
+	resp.Frozen = frozen.String()
+	resp.Circulating = new(big.Int).Sub(total, frozen).String()
+	resp.HolderCount = int64(len(holders))
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("holder_count", resp.HolderCount)
+	return resp, nil
+}
+
 // GetBlock get block info according to blockID
 func (t *RpcServ) GetBlock(gctx context.Context, req *pb.BlockID) (*pb.Block, error) {
 	// 默认响应
@@ -716,6 +1701,10 @@ func (t *RpcServ) GetSystemStatus(gctx context.Context, req *pb.CommonIn) (*pb.S
 		systemsStatus.BcsStatus = append(systemsStatus.BcsStatus, status)
 	}
 
+	if req.ViewOption == pb.ViewOption_NONE || req.ViewOption == pb.ViewOption_LEDGER {
+		t.fillSpeeds(rctx, systemsStatus.Speeds, bcs)
+	}
+
 	if req.ViewOption == pb.ViewOption_NONE || req.ViewOption == pb.ViewOption_PEERS {
 		peerInfo := t.engine.Context().Net.PeerInfo()
 		peerUrls := acom.PeerInfoToStrings(peerInfo)
@@ -726,6 +1715,119 @@ func (t *RpcServ) GetSystemStatus(gctx context.Context, req *pb.CommonIn) (*pb.S
 	return resp, nil
 }
 
+// fillSpeeds 按最近区块计算各链速度，单链失败不影响其他链
+func (t *RpcServ) fillSpeeds(rctx sctx.ReqCtx, speeds *pb.Speeds, bcs []string) {
+	for _, bcName := range bcs {
+		handle, err := models.NewChainHandle(bcName, rctx)
+		if err != nil {
+			rctx.GetLog().Warn("new chain handle failed", "bcname", bcName, "err", err.Error())
+			continue
+		}
+		stats, err := t.chainSpeeds(handle, speedStatsWindow)
+		if err != nil {
+			rctx.GetLog().Warn("compute chain stats failed", "bcname", bcName, "err", err)
+			continue
+		}
+		speeds.BcSpeeds[bcName] = &pb.BCSpeeds{
+			BcSpeed: map[string]float64{
+				"tps":               stats.GetTps(),
+				"block_interval_ms": stats.GetBlockInterval().GetAvg(),
+				"txs_per_block":     stats.GetAvgTxsPerBlock(),
+			},
+		}
+		speeds.SumSpeeds["tps"] += stats.GetTps()
+	}
+}
+
+// GetChainStats get throughput and block statistics over recent trunk blocks
+func (t *RpcServ) GetChainStats(gctx context.Context, req *pb.ChainStatsRequest) (*pb.ChainStatsResponse, error) {
+	// 默认响应
+	resp := &pb.ChainStatsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetWindow() < 0 ||
+		req.GetWindow() > maxStatsWindow || req.GetTopN() < 0 {
+		rctx.GetLog().Warn("param error,some param unset or invalid window")
+		return resp, ecom.ErrParameter
+	}
+	window := req.GetWindow()
+	if window == 0 {
+		window = defStatsWindow
+	}
+	topN := req.GetTopN()
+	if topN == 0 {
+		topN = defStatsTopN
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	resp, err = t.chainStats(handle, window, int(topN))
+	if err != nil {
+		rctx.GetLog().Warn("compute chain stats failed", "err", err)
+		return &pb.ChainStatsResponse{}, err
+	}
+	resp.Bcname = req.GetBcname()
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("start", resp.GetStartHeight())
+	rctx.GetLog().SetInfoField("end", resp.GetEndHeight())
+	return resp, nil
+}
+
+// chainStats 统计主干最近window个区块
+func (t *RpcServ) chainStats(handle *models.ChainHandle, window int64, topN int) (*pb.ChainStatsResponse, error) {
+	blocks, err := t.trunkBlocks(handle, window, false)
+	if err != nil {
+		return nil, err
+	}
+	return acom.ChainStats(blocks, topN), nil
+}
+
+// chainSpeeds 按主干最近window个区块头统计速度，不读取交易内容
+func (t *RpcServ) chainSpeeds(handle *models.ChainHandle, window int64) (*pb.ChainStatsResponse, error) {
+	headers, err := t.trunkBlocks(handle, window, true)
+	if err != nil {
+		return nil, err
+	}
+	return acom.ChainSpeeds(headers), nil
+}
+
+// trunkBlocks 按高度升序返回主干最近window个区块，headerOnly时只读取区块头
+func (t *RpcServ) trunkBlocks(handle *models.ChainHandle, window int64,
+	headerOnly bool) ([]*lpb.InternalBlock, error) {
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		return nil, err
+	}
+	endHeight := status.GetLedgerMeta().GetTrunkHeight()
+	startHeight := endHeight - window + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+
+	blocks := make([]*lpb.InternalBlock, 0, endHeight-startHeight+1)
+	for height := startHeight; height <= endHeight; height++ {
+		var blkInfo *xpb.BlockInfo
+		if headerOnly {
+			blkInfo, err = handle.QueryBlockHeaderByHeight(height)
+		} else {
+			blkInfo, err = handle.QueryBlockByHeight(height, true)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if pb.Block_EBlockStatus(blkInfo.GetStatus()) != pb.Block_TRUNK {
+			return nil, ecom.ErrBlockNotExist
+		}
+		blocks = append(blocks, blkInfo.GetBlock())
+	}
+	return blocks, nil
+}
+
 // GetNetURL get net url in p2p_base
 func (t *RpcServ) GetNetURL(gctx context.Context, req *pb.CommonIn) (*pb.RawUrl, error) {
 	// 默认响应
@@ -733,55 +1835,349 @@ func (t *RpcServ) GetNetURL(gctx context.Context, req *pb.CommonIn) (*pb.RawUrl,
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	if req == nil {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	peerInfo := t.engine.Context().Net.PeerInfo()
+	resp.RawUrl = peerInfo.Address
+
+	rctx.GetLog().SetInfoField("raw_url", resp.RawUrl)
+	return resp, nil
+}
+
+// GetBlockByHeight  get trunk block by height
+func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*pb.Block, error) {
+	// 默认响应
+	resp := &pb.Block{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	blockInfo, err := handle.QueryBlockByHeight(req.GetHeight(), true)
+	if err != nil {
+		rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", req.GetHeight())
+		return resp, err
+	}
+
+	block := acom.BlockToXchain(blockInfo.Block)
+	if block == nil {
+		rctx.GetLog().Warn("convert block failed")
+		return resp, ecom.ErrInternal
+	}
+	resp.Block = block
+	resp.Status = pb.Block_EBlockStatus(blockInfo.Status)
+	resp.Bcname = req.GetBcname()
+	resp.Blockid = blockInfo.Block.Blockid
+
+	rctx.GetLog().SetInfoField("height", req.GetHeight())
+	rctx.GetLog().SetInfoField("blockid", utils.F(blockInfo.Block.Blockid))
+	return resp, nil
+}
+
+// GetBlocksByRange stream trunk blocks from start height to end height
+func (t *RpcServ) GetBlocksByRange(req *pb.BlockRangeRequest, stream pb.Xchain_GetBlocksByRangeServer) error {
+	// 获取请求上下文，由StreamInterceptor创建，对内传递rctx
+	rctx := sctx.ValueReqCtx(stream.Context())
+
+	if req.GetBcname() == "" || req.GetStartHeight() < 0 || req.GetEndHeight() < req.GetStartHeight() {
+		rctx.GetLog().Warn("param error,some param unset or invalid height range",
+			"start", req.GetStartHeight(), "end", req.GetEndHeight())
+		return ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return err
+	}
+	endHeight, err := t.clampTrunkHeight(handle, req.GetEndHeight())
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "error", err)
+		return err
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("start", req.GetStartHeight())
+	rctx.GetLog().SetInfoField("end", endHeight)
+
+	return t.sendBlockRange(rctx, req, endHeight, handle.QueryBlockByHeight, stream)
+}
+
+// sendBlockRange 依次查询并推送起始高度到endHeight的区块
+func (t *RpcServ) sendBlockRange(rctx sctx.ReqCtx, req *pb.BlockRangeRequest, endHeight int64,
+	queryBlock func(height int64, needContent bool) (*xpb.BlockInfo, error),
+	stream pb.Xchain_GetBlocksByRangeServer) error {
+	for height := req.GetStartHeight(); height <= endHeight; height++ {
+		// 客户端取消或断开时停止推送
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+
+		blockInfo, err := queryBlock(height, req.GetNeedContent())
+		if err != nil {
+			rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", height)
+			return err
+		}
+		block := acom.BlockToXchain(blockInfo.Block)
+		if block == nil {
+			rctx.GetLog().Warn("convert block failed", "height", height)
+			return ecom.ErrInternal
+		}
+
+		resp := &pb.Block{
+			Header: &pb.Header{
+				Logid:    rctx.GetLog().GetLogId(),
+				FromNode: t.genTraceId(),
+				Error:    pb.XChainErrorEnum_SUCCESS,
+			},
+			Bcname:  req.GetBcname(),
+			Blockid: block.GetBlockid(),
+			Status:  pb.Block_EBlockStatus(blockInfo.Status),
+			Block:   block,
+		}
+		// Send在发送窗口耗尽时阻塞，由grpc流控限制推送速度
+		if err := stream.Send(resp); err != nil {
+			rctx.GetLog().Warn("send block failed", "height", height, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBlockHeaders get trunk block headers from start height to end height
+func (t *RpcServ) GetBlockHeaders(gctx context.Context, req *pb.BlockHeadersRequest) (*pb.BlockHeaders, error) {
+	// 默认响应
+	resp := &pb.BlockHeaders{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetStartHeight() < 0 ||
+		req.GetEndHeight() < req.GetStartHeight() {
+		rctx.GetLog().Warn("param error,some param unset or invalid height range")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	endHeight, err := t.clampTrunkHeight(handle, req.GetEndHeight())
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "error", err)
+		return resp, err
+	}
+	// 单次最多返回maxBlockHeaders个，客户端从最后一个高度继续拉取
+	if endHeight-req.GetStartHeight() >= maxBlockHeaders {
+		endHeight = req.GetStartHeight() + maxBlockHeaders - 1
+	}
+
+	for height := req.GetStartHeight(); height <= endHeight; height++ {
+		header, err := t.queryBlockHeader(handle, height)
+		if err != nil {
+			rctx.GetLog().Warn("query block header error", "height", height, "err", err)
+			return resp, err
+		}
+		resp.Headers = append(resp.Headers, header)
+	}
+	resp.Bcname = req.GetBcname()
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("start", req.GetStartHeight())
+	rctx.GetLog().SetInfoField("end", endHeight)
+	return resp, nil
+}
+
+// StreamBlockHeaders stream trunk block headers from start height to end height
+func (t *RpcServ) StreamBlockHeaders(req *pb.BlockHeadersRequest, stream pb.Xchain_StreamBlockHeadersServer) error {
+	// 获取请求上下文，由StreamInterceptor创建，对内传递rctx
+	rctx := sctx.ValueReqCtx(stream.Context())
+
+	if req.GetBcname() == "" || req.GetStartHeight() < 0 || req.GetEndHeight() < req.GetStartHeight() {
+		rctx.GetLog().Warn("param error,some param unset or invalid height range",
+			"start", req.GetStartHeight(), "end", req.GetEndHeight())
+		return ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return err
+	}
+	endHeight, err := t.clampTrunkHeight(handle, req.GetEndHeight())
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "error", err)
+		return err
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("start", req.GetStartHeight())
+	rctx.GetLog().SetInfoField("end", endHeight)
+
+	for height := req.GetStartHeight(); height <= endHeight; height++ {
+		// 客户端取消或断开时停止推送
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+
+		header, err := t.queryBlockHeader(handle, height)
+		if err != nil {
+			rctx.GetLog().Warn("query block header error", "height", height, "err", err)
+			return err
+		}
+		if err := stream.Send(header); err != nil {
+			rctx.GetLog().Warn("send block header failed", "height", height, "err", err)
+			return err
+		}
 	}
-	peerInfo := t.engine.Context().Net.PeerInfo()
-	resp.RawUrl = peerInfo.Address
 
-	rctx.GetLog().SetInfoField("raw_url", resp.RawUrl)
-	return resp, nil
+	return nil
 }
 
-// GetBlockByHeight  get trunk block by height
-func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*pb.Block, error) {
+// QueryContractEvents query contract events of trunk blocks in a height range
+func (t *RpcServ) QueryContractEvents(gctx context.Context, req *pb.ContractEventsRequest) (*pb.ContractEventsResponse, error) {
 	// 默认响应
-	resp := &pb.Block{}
+	resp := &pb.ContractEventsResponse{}
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
+	if req == nil || req.GetBcname() == "" || req.GetStartHeight() < 0 ||
+		req.GetEndHeight() < req.GetStartHeight() || req.GetSkip() < 0 || req.GetLimit() < 0 {
+		rctx.GetLog().Warn("param error,some param unset or invalid height range")
 		return resp, ecom.ErrParameter
 	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
-	blockInfo, err := handle.QueryBlockByHeight(req.GetHeight(), true)
+	endHeight, err := t.clampTrunkHeight(handle, req.GetEndHeight())
 	if err != nil {
-		rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", req.GetHeight())
+		rctx.GetLog().Warn("get chain status error", "error", err)
 		return resp, err
 	}
-
-	block := acom.BlockToXchain(blockInfo.Block)
-	if block == nil {
-		rctx.GetLog().Warn("convert block failed")
-		return resp, ecom.ErrInternal
+	// 单次最多扫描maxBlockHeaders个区块，避免事件稀疏时长时间占用请求
+	scanEnd := endHeight
+	if scanEnd-req.GetStartHeight() >= maxBlockHeaders {
+		scanEnd = req.GetStartHeight() + maxBlockHeaders - 1
 	}
-	resp.Block = block
-	resp.Status = pb.Block_EBlockStatus(blockInfo.Status)
 	resp.Bcname = req.GetBcname()
-	resp.Blockid = blockInfo.Block.Blockid
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("start", req.GetStartHeight())
+	if scanEnd < req.GetStartHeight() {
+		return resp, nil
+	}
 
-	rctx.GetLog().SetInfoField("height", req.GetHeight())
-	rctx.GetLog().SetInfoField("blockid", utils.F(blockInfo.Block.Blockid))
+	// 复用事件订阅的过滤逻辑，保证查询结果与订阅推送一致
+	filter := &protos.BlockFilter{
+		Bcname: req.GetBcname(),
+		Range: &protos.BlockRange{
+			Start: strconv.FormatInt(req.GetStartHeight(), 10),
+			End:   strconv.FormatInt(scanEnd+1, 10),
+		},
+		Contract:  req.GetContract(),
+		EventName: req.GetEventName(),
+		Initiator: req.GetInitiator(),
+	}
+	iter, err := t.router.RawSubscribe(protos.SubscribeType_BLOCK, filter)
+	if err != nil {
+		rctx.GetLog().Warn("subscribe block event failed", "err", err)
+		return resp, ecom.ErrParameter.More("%v", err)
+	}
+	defer iter.Close()
+
+	if err := collectContractEvents(iter, req.GetStartHeight(), req.GetSkip(), limit, resp); err != nil {
+		rctx.GetLog().Warn("iterate block failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+	if !resp.HasMore && scanEnd < endHeight {
+		resp.HasMore = true
+		resp.NextHeight = scanEnd + 1
+	}
+	rctx.GetLog().SetInfoField("end", scanEnd)
+	rctx.GetLog().SetInfoField("event_count", len(resp.Events))
 	return resp, nil
 }
 
+// collectContractEvents 跳过起始高度的前skip个事件后最多收集limit个事件，
+// 收满后再向后查找一个事件，确有剩余时才设置HasMore，下一页从该事件开始
+func collectContractEvents(iter event.Iterator, startHeight, skip, limit int64,
+	resp *pb.ContractEventsResponse) error {
+	for iter.Next() {
+		block := iter.Data().(*protos.FilteredBlock)
+		blockid, _ := hex.DecodeString(block.GetBlockid())
+		index := int64(0)
+		for _, tx := range block.GetTxs() {
+			txid, _ := hex.DecodeString(tx.GetTxid())
+			for _, event := range acom.ContractEventsToXchain(tx.GetEvents()) {
+				index++
+				if block.GetBlockHeight() == startHeight && index <= skip {
+					continue
+				}
+				if int64(len(resp.Events)) >= limit {
+					resp.HasMore = true
+					resp.NextHeight = block.GetBlockHeight()
+					resp.NextSkip = index - 1
+					return nil
+				}
+				resp.Events = append(resp.Events, &pb.ContractEventInfo{
+					Height:  block.GetBlockHeight(),
+					Blockid: blockid,
+					Txid:    txid,
+					Event:   event,
+				})
+			}
+		}
+	}
+	return iter.Error()
+}
+
+// clampTrunkHeight 超出主干高度时截断到主干高度
+func (t *RpcServ) clampTrunkHeight(handle *models.ChainHandle, height int64) (int64, error) {
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		return 0, err
+	}
+	if trunkHeight := status.GetLedgerMeta().GetTrunkHeight(); height > trunkHeight {
+		height = trunkHeight
+	}
+	return height, nil
+}
+
+// queryBlockHeader 查询主干区块头，不读取交易内容
+func (t *RpcServ) queryBlockHeader(handle *models.ChainHandle, height int64) (*pb.BlockHeader, error) {
+	blkInfo, err := handle.QueryBlockHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if pb.Block_EBlockStatus(blkInfo.GetStatus()) != pb.Block_TRUNK {
+		return nil, ecom.ErrBlockNotExist
+	}
+	header := acom.BlockHeaderToXchain(blkInfo.GetBlock())
+	if header == nil {
+		return nil, ecom.ErrInternal.More("convert block header failed")
+	}
+	return header, nil
+}
+
 // GetAccountByAK get account list with contain ak
 func (t *RpcServ) GetAccountByAK(gctx context.Context, req *pb.AK2AccountRequest) (*pb.AK2AccountResponse, error) {
 	// 默认响应
@@ -813,6 +2209,191 @@ func (t *RpcServ) GetAccountByAK(gctx context.Context, req *pb.AK2AccountRequest
 	return resp, err
 }
 
+// GetAccountHistory get acl change history of a contract account
+func (t *RpcServ) GetAccountHistory(gctx context.Context, req *pb.AccountHistoryRequest) (*pb.AccountHistoryResponse, error) {
+	// 默认响应
+	resp := &pb.AccountHistoryResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || !aclUtils.IsAccount(req.GetAccount()) ||
+		req.GetOffset() < 0 || req.GetLimit() < 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+
+	// 每个key最多回溯到本页末尾再多一个版本，用于判断是否还有更多变更
+	depth := int(req.GetOffset() + limit + 1)
+	history := &accountHistory{
+		handle:  handle,
+		heights: make(map[string]int64),
+	}
+	err = history.addAccountACL(req.GetAccount(), depth)
+	if err != nil {
+		rctx.GetLog().Warn("query account acl history failed", "err", err)
+		return resp, err
+	}
+	contracts, err := handle.GetAccountContracts(req.GetAccount())
+	if err != nil {
+		rctx.GetLog().Warn("get account contracts failed", "err", err)
+		return resp, err
+	}
+	for _, contract := range contracts {
+		err = history.addMethodACLs(contract.GetContractName(), depth)
+		if err != nil {
+			rctx.GetLog().Warn("query method acl history failed", "contract", contract.GetContractName(),
+				"err", err)
+			return resp, err
+		}
+	}
+
+	// 同一个key的版本已按从新到旧排列，稳定排序保证同高度内的先后顺序
+	changes := history.changes
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].GetStatus() != changes[j].GetStatus() {
+			return changes[i].GetStatus() == pb.TransactionStatus_UNCONFIRM
+		}
+		return changes[i].GetHeight() > changes[j].GetHeight()
+	})
+	if req.GetOffset() < int64(len(changes)) {
+		end := req.GetOffset() + limit
+		if end > int64(len(changes)) {
+			end = int64(len(changes))
+		}
+		resp.Changes = changes[req.GetOffset():end]
+	}
+	resp.HasMore = int64(len(changes)) > req.GetOffset()+limit
+	resp.Truncated = history.truncated
+	resp.Bcname = req.GetBcname()
+	resp.Account = req.GetAccount()
+
+	rctx.GetLog().SetInfoField("account", req.GetAccount())
+	rctx.GetLog().SetInfoField("contract_count", len(contracts))
+	rctx.GetLog().SetInfoField("change_count", len(resp.Changes))
+	return resp, nil
+}
+
+// accountHistory 收集合约账户相关ACL的变更记录
+type accountHistory struct {
+	handle  *models.ChainHandle
+	heights map[string]int64 // blockid到高度的缓存
+	changes []*pb.AccountChange
+	// 存在无法回溯到最初版本的key，更早的变更可能缺失
+	truncated bool
+}
+
+func (h *accountHistory) addAccountACL(account string, depth int) error {
+	versions, truncated, err := h.handle.QueryKeyHistory(aclUtils.GetAccountBucket(), []byte(account), depth)
+	if err != nil {
+		return err
+	}
+	h.truncated = h.truncated || truncated
+
+	aks := make([][]string, len(versions))
+	for i, version := range versions {
+		acl, err := acom.ParseAclJSON(version.Value)
+		if err != nil {
+			return ecom.ErrInternal.More("%v", err)
+		}
+		aks[i] = acom.AclAddresses(acl)
+	}
+	for i, version := range versions {
+		change, err := h.newChange(pb.AccountChangeType_ACCOUNT_CHANGE_ACL, version)
+		if err != nil {
+			return err
+		}
+		// 回溯到达上限时最旧版本的前一版本未知，该版本不会出现在结果页中；
+		// 回溯中断时最旧版本的前一版本同样未知，不计算变更的AK
+		if i+1 < len(versions) {
+			change.AddedAks, change.RemovedAks = acom.DiffAddresses(aks[i+1], aks[i])
+		} else if len(versions) < depth && !truncated {
+			change.AddedAks, change.RemovedAks = acom.DiffAddresses(nil, aks[i])
+		}
+		h.changes = append(h.changes, change)
+	}
+	return nil
+}
+
+func (h *accountHistory) addMethodACLs(contractName string, depth int) error {
+	bucket := aclUtils.GetContractBucket()
+	prefix := []byte(aclUtils.MakeContractMethodKey(contractName, ""))
+	var start []byte
+	for {
+		keys, more, err := h.handle.ListContractKeys(bucket, prefix, start, maxPageLimit, -1)
+		if err != nil {
+			return err
+		}
+		for _, item := range keys {
+			versions, truncated, err := h.handle.QueryKeyHistory(bucket, item.GetKey(), depth)
+			if err != nil {
+				return err
+			}
+			h.truncated = h.truncated || truncated
+			for _, version := range versions {
+				change, err := h.newChange(pb.AccountChangeType_ACCOUNT_CHANGE_METHOD_ACL, version)
+				if err != nil {
+					return err
+				}
+				change.ContractName = contractName
+				change.MethodName = string(item.GetKey()[len(prefix):])
+				h.changes = append(h.changes, change)
+			}
+		}
+		if !more {
+			return nil
+		}
+		lastKey := keys[len(keys)-1].GetKey()
+		start = append(append([]byte{}, lastKey...), 0)
+	}
+}
+
+func (h *accountHistory) newChange(typ pb.AccountChangeType, version *models.KeyVersion) (*pb.AccountChange, error) {
+	acl, err := acom.ParseAclJSON(version.Value)
+	if err != nil {
+		return nil, ecom.ErrInternal.More("%v", err)
+	}
+
+	tx := version.Tx
+	change := &pb.AccountChange{
+		Type:        typ,
+		Txid:        tx.GetTxid(),
+		Status:      pb.TransactionStatus_UNCONFIRM,
+		Timestamp:   tx.GetTimestamp(),
+		Initiator:   tx.GetInitiator(),
+		AuthRequire: tx.GetAuthRequire(),
+		Acl:         acom.AclToXchain(acl),
+	}
+	if !version.Confirmed {
+		return change, nil
+	}
+
+	height, ok := h.heights[string(tx.GetBlockid())]
+	if !ok {
+		blkInfo, err := h.handle.QueryBlockHeader(tx.GetBlockid())
+		if err != nil {
+			return nil, err
+		}
+		height = blkInfo.GetBlock().GetHeight()
+		h.heights[string(tx.GetBlockid())] = height
+	}
+	change.Status = pb.TransactionStatus_CONFIRM
+	change.Height = height
+	return change, nil
+}
+
 // GetAddressContracts get contracts of accounts contain a specific address
 func (t *RpcServ) GetAddressContracts(gctx context.Context, req *pb.AddressContractsRequest) (*pb.AddressContractsResponse, error) {
 	// 默认响应
@@ -994,3 +2575,209 @@ func (t *RpcServ) DposStatus(gctx context.Context, req *pb.DposStatusRequest) (*
 
 	return resp, ecom.ErrForbidden
 }
+
+// XpoaValidators get current and pending validators of the xpoa consensus
+func (t *RpcServ) XpoaValidators(gctx context.Context, req *pb.XpoaValidatorsRequest) (*pb.XpoaValidatorsResponse, error) {
+	// 默认响应
+	resp := &pb.XpoaValidatorsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	status, err := handle.QueryXpoaStatus()
+	if err != nil {
+		rctx.GetLog().Warn("query xpoa status failed", "err", err)
+		return resp, err
+	}
+
+	// 候选人的网络地址来自本节点的p2p连接
+	netURLs := make(map[string]string)
+	peerInfo := t.engine.Context().Net.PeerInfo()
+	netURLs[peerInfo.Account] = peerInfo.Address
+	for _, peer := range peerInfo.Peer {
+		netURLs[peer.Account] = peer.Address
+	}
+	toValidators := func(addrs []string) []*pb.XpoaValidator {
+		validators := make([]*pb.XpoaValidator, 0, len(addrs))
+		for _, addr := range addrs {
+			validators = append(validators, &pb.XpoaValidator{Address: addr, Neturl: netURLs[addr]})
+		}
+		return validators
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Validators = toValidators(status.Validators)
+	resp.PendingValidators = toValidators(status.PendingValidators)
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("validator_count", len(resp.Validators))
+	return resp, nil
+}
+
+// XpoaStatus get xpoa status
+func (t *RpcServ) XpoaStatus(gctx context.Context, req *pb.XpoaStatusRequest) (*pb.XpoaStatusResponse, error) {
+	// 默认响应
+	resp := &pb.XpoaStatusResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	status, err := handle.QueryXpoaStatus()
+	if err != nil {
+		rctx.GetLog().Warn("query xpoa status failed", "err", err)
+		return resp, err
+	}
+	resp.Bcname = req.GetBcname()
+	resp.ConsensusName = status.ConsensusName
+	resp.Version = status.Version
+	resp.StartHeight = status.StartHeight
+	resp.Term = status.Term
+	resp.Miner = status.Miner
+	resp.Validators = status.Validators
+	resp.PendingValidators = status.PendingValidators
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("term", status.Term)
+	return resp, nil
+}
+
+// GovernTokenBalance get govern token balance of an account
+func (t *RpcServ) GovernTokenBalance(gctx context.Context, req *pb.GovernTokenBalanceRequest) (*pb.GovernTokenBalanceResponse, error) {
+	// 默认响应
+	resp := &pb.GovernTokenBalanceResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetAccount() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	value, err := handle.GetContractValue(putils.GetGovernTokenBucket(),
+		[]byte(putils.MakeAccountBalanceKey(req.GetAccount())))
+	if err != nil {
+		rctx.GetLog().Warn("get govern token balance failed", "err", err)
+		return resp, err
+	}
+	balance, err := acom.GovernTokenBalanceToXchain(value)
+	if err != nil {
+		rctx.GetLog().Warn("parse govern token balance failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+	totalSupply, err := t.governTokenTotalSupply(handle)
+	if err != nil {
+		rctx.GetLog().Warn("get govern token total supply failed", "err", err)
+		return resp, err
+	}
+
+	resp = balance
+	resp.Bcname = req.GetBcname()
+	resp.Account = req.GetAccount()
+	resp.TotalSupply = totalSupply.String()
+
+	rctx.GetLog().SetInfoField("account", req.GetAccount())
+	return resp, nil
+}
+
+// ProposalQuery get status, vote tally and trigger of a proposal
+func (t *RpcServ) ProposalQuery(gctx context.Context, req *pb.ProposalQueryRequest) (*pb.ProposalQueryResponse, error) {
+	// 默认响应
+	resp := &pb.ProposalQueryResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetProposalId() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	value, err := handle.GetContractValue(putils.GetProposalBucket(),
+		[]byte(putils.MakeProposalKey(req.GetProposalId())))
+	if err != nil {
+		rctx.GetLog().Warn("get proposal failed", "err", err)
+		return resp, err
+	}
+	if value == nil {
+		return resp, acom.ErrProposalNotExist
+	}
+	totalSupply, err := t.governTokenTotalSupply(handle)
+	if err != nil {
+		rctx.GetLog().Warn("get govern token total supply failed", "err", err)
+		return resp, err
+	}
+	proposal, err := acom.ProposalToXchain(req.GetProposalId(), value, totalSupply)
+	if err != nil {
+		rctx.GetLog().Warn("parse proposal failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	// 发起和投票时锁定的治理代币，结束后解锁但不删除记录
+	prefix := []byte(putils.MakeProposalLockPrefix(req.GetProposalId()))
+	var start []byte
+	for {
+		kvs, more, err := handle.ListContractKeys(putils.GetProposalBucket(), prefix, start, maxPageLimit, -1)
+		if err != nil {
+			rctx.GetLog().Warn("list proposal locks failed", "err", err)
+			return resp, err
+		}
+		for _, kv := range kvs {
+			proposal.Locks = append(proposal.Locks, &pb.ProposalLock{
+				Account: string(kv.GetKey()[len(prefix):]),
+				Amount:  string(kv.GetValue()),
+			})
+		}
+		if !more {
+			break
+		}
+		start = append(append([]byte{}, kvs[len(kvs)-1].GetKey()...), 0)
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Proposal = proposal
+
+	rctx.GetLog().SetInfoField("proposal_id", req.GetProposalId())
+	rctx.GetLog().SetInfoField("status", proposal.GetStatus())
+	return resp, nil
+}
+
+// governTokenTotalSupply 读取治理代币总量，治理代币未初始化时为0
+func (t *RpcServ) governTokenTotalSupply(handle *models.ChainHandle) (*big.Int, error) {
+	value, err := handle.GetContractValue(putils.GetGovernTokenBucket(), []byte(putils.MakeTotalSupplyKey()))
+	if err != nil {
+		return nil, err
+	}
+	totalSupply := big.NewInt(0)
+	if len(value) != 0 {
+		if _, ok := totalSupply.SetString(string(value), 10); !ok {
+			return nil, ecom.ErrInternal.More("invalid govern token total supply")
+		}
+	}
+	return totalSupply, nil
+}
@@ -0,0 +1,692 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xupercore/protos"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/models"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// fakeEngine 只实现NewChainHandle用到的Get，任何链都不存在，
+// 参数校验通过的请求都会在创建ChainHandle时返回ErrChainNotExist
+type fakeEngine struct {
+	ecom.Engine
+}
+
+func (e *fakeEngine) Get(name string) (ecom.Chain, error) {
+	return nil, ecom.ErrChainNotExist
+}
+
+func newTestReqCtx(t *testing.T) sctx.ReqCtx {
+	logs.InitLog(filepath.Join(utils.GetCurFileDir(), "../../conf/log.yaml"), t.TempDir())
+	rctx, err := sctx.NewReqCtx(&fakeEngine{}, "api_test", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rctx
+}
+
+func newTestContext(t *testing.T) context.Context {
+	return sctx.WithReqCtx(context.Background(), newTestReqCtx(t))
+}
+
+// checkHandlerErr 校验参数错误的请求返回ErrParameter，合法请求进入链查询
+func checkHandlerErr(t *testing.T, err error, wantParamErr bool) {
+	t.Helper()
+	want := ecom.ErrChainNotExist
+	if wantParamErr {
+		want = ecom.ErrParameter
+	}
+	if ecom.CastError(err).Code != want.Code {
+		t.Fatalf("handler error = %v, want %v", err, want)
+	}
+}
+
+// blockRangeStream 记录GetBlocksByRange推送的区块
+type blockRangeStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sendErr error
+	blocks  []*pb.Block
+	// 推送limit个区块后取消请求
+	limit  int
+	cancel context.CancelFunc
+}
+
+func (s *blockRangeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockRangeStream) Send(block *pb.Block) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.blocks = append(s.blocks, block)
+	if s.limit > 0 && len(s.blocks) >= s.limit {
+		s.cancel()
+	}
+	return nil
+}
+
+// callHandler 按请求类型调用对应的接口
+func callHandler(ctx context.Context, req interface{}) error {
+	s := &RpcServ{engine: &fakeEngine{}}
+	var err error
+	switch req := req.(type) {
+	case *pb.BlockRangeRequest:
+		err = s.GetBlocksByRange(req, &blockRangeStream{ctx: ctx})
+	case *pb.BatchTxRequest:
+		_, err = s.BatchQueryTx(ctx, req)
+	case *pb.StateAtHeightRequest:
+		_, err = s.GetStateAtHeight(ctx, req)
+	case *pb.PendingTxsRequest:
+		_, err = s.ListPendingTxs(ctx, req)
+	case *pb.PendingTxRequest:
+		_, err = s.GetPendingTx(ctx, req)
+	case *pb.ContractInfoRequest:
+		_, err = s.GetContractInfo(ctx, req)
+	}
+	return err
+}
+
+func TestHandlerParam(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          interface{}
+		wantParamErr bool
+	}{
+		{name: "blocks by range: no bcname", req: &pb.BlockRangeRequest{StartHeight: 1, EndHeight: 2},
+			wantParamErr: true},
+		{name: "blocks by range: negative start",
+			req: &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: -1, EndHeight: 2}, wantParamErr: true},
+		{name: "blocks by range: end before start",
+			req: &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 3, EndHeight: 2}, wantParamErr: true},
+		{name: "blocks by range: valid", req: &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 2, EndHeight: 2}},
+		{name: "batch query tx: nil request", req: (*pb.BatchTxRequest)(nil), wantParamErr: true},
+		{name: "batch query tx: no txids", req: &pb.BatchTxRequest{Bcname: "xuper"}, wantParamErr: true},
+		{name: "batch query tx: too many txids", req: &pb.BatchTxRequest{Bcname: "xuper",
+			Txids: make([][]byte, maxBatchTxids+1)}, wantParamErr: true},
+		{name: "batch query tx: valid", req: &pb.BatchTxRequest{Bcname: "xuper", Txids: [][]byte{{0x01}}}},
+		{name: "state at height: no bucket", req: &pb.StateAtHeightRequest{Bcname: "xuper", Key: []byte("k")},
+			wantParamErr: true},
+		{name: "state at height: no key", req: &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter"},
+			wantParamErr: true},
+		{name: "state at height: negative height without blockid", req: &pb.StateAtHeightRequest{
+			Bcname: "xuper", Bucket: "counter", Key: []byte("k"), Height: -1}, wantParamErr: true},
+		{name: "state at height: blockid", req: &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter",
+			Key: []byte("k"), Blockid: []byte{0x01}, Height: -1}},
+		{name: "list pending txs: negative offset", req: &pb.PendingTxsRequest{Bcname: "xuper", Offset: -1},
+			wantParamErr: true},
+		{name: "list pending txs: negative limit", req: &pb.PendingTxsRequest{Bcname: "xuper", Limit: -1},
+			wantParamErr: true},
+		{name: "list pending txs: valid", req: &pb.PendingTxsRequest{Bcname: "xuper", Limit: 1000}},
+		{name: "get pending tx: no txid", req: &pb.PendingTxRequest{Bcname: "xuper"}, wantParamErr: true},
+		{name: "get pending tx: valid", req: &pb.PendingTxRequest{Bcname: "xuper", Txid: []byte{0x01}}},
+		{name: "contract info: nil request", req: (*pb.ContractInfoRequest)(nil), wantParamErr: true},
+		{name: "contract info: no contract", req: &pb.ContractInfoRequest{Bcname: "xuper"}, wantParamErr: true},
+		{name: "contract info: valid", req: &pb.ContractInfoRequest{Bcname: "xuper", ContractName: "counter"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkHandlerErr(t, callHandler(newTestContext(t), tt.req), tt.wantParamErr)
+		})
+	}
+}
+
+func TestSendBlockRange(t *testing.T) {
+	queryBlock := func(height int64, needContent bool) (*xpb.BlockInfo, error) {
+		if height > 5 {
+			return nil, ecom.ErrBlockNotExist
+		}
+		block := &lpb.InternalBlock{Blockid: []byte{byte(height)}, Height: height}
+		if needContent {
+			block.Transactions = []*lpb.Transaction{{Txid: []byte{byte(height)}}}
+		}
+		return &xpb.BlockInfo{Status: lpb.BlockStatus_BLOCK_TRUNK, Block: block}, nil
+	}
+	rctx := newTestReqCtx(t)
+
+	tests := []struct {
+		name       string
+		req        *pb.BlockRangeRequest
+		endHeight  int64
+		limit      int
+		sendErr    error
+		wantErr    bool
+		wantHeight []int64
+	}{
+		{
+			name:       "all blocks",
+			req:        &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 1, NeedContent: true},
+			endHeight:  3,
+			wantHeight: []int64{1, 2, 3},
+		},
+		{
+			name:       "client canceled",
+			req:        &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 1},
+			endHeight:  5,
+			limit:      2,
+			wantErr:    true,
+			wantHeight: []int64{1, 2},
+		},
+		{
+			name:      "send failed",
+			req:       &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 1},
+			endHeight: 3,
+			sendErr:   errors.New("send failed"),
+			wantErr:   true,
+		},
+		{
+			name:       "query failed",
+			req:        &pb.BlockRangeRequest{Bcname: "xuper", StartHeight: 5},
+			endHeight:  6,
+			wantErr:    true,
+			wantHeight: []int64{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream := &blockRangeStream{
+				ctx:     ctx,
+				sendErr: tt.sendErr,
+				limit:   tt.limit,
+				cancel:  cancel,
+			}
+			err := (&RpcServ{}).sendBlockRange(rctx, tt.req, tt.endHeight, queryBlock, stream)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sendBlockRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var heights []int64
+			for _, block := range stream.blocks {
+				heights = append(heights, block.GetBlock().GetHeight())
+				if block.GetBcname() != "xuper" || block.GetStatus() != pb.Block_TRUNK {
+					t.Fatalf("unexpected block: %v", block)
+				}
+				if hasTxs := len(block.GetBlock().GetTransactions()) > 0; hasTxs != tt.req.GetNeedContent() {
+					t.Fatalf("expect content %v, got %v", tt.req.GetNeedContent(), hasTxs)
+				}
+			}
+			if !reflect.DeepEqual(heights, tt.wantHeight) {
+				t.Fatalf("sent heights = %v, want %v", heights, tt.wantHeight)
+			}
+		})
+	}
+}
+
+// sliceBlockIterator 从start高度开始依次返回blocks中的区块
+type sliceBlockIterator struct {
+	blocks []*protos.FilteredBlock
+	block  *protos.FilteredBlock
+}
+
+func newSliceBlockIterator(blocks []*protos.FilteredBlock, start int64) *sliceBlockIterator {
+	iter := &sliceBlockIterator{}
+	for _, block := range blocks {
+		if block.GetBlockHeight() >= start {
+			iter.blocks = append(iter.blocks, block)
+		}
+	}
+	return iter
+}
+
+func (i *sliceBlockIterator) Next() bool {
+	if len(i.blocks) == 0 {
+		return false
+	}
+	i.block, i.blocks = i.blocks[0], i.blocks[1:]
+	return true
+}
+
+func (i *sliceBlockIterator) Data() interface{} { return i.block }
+func (i *sliceBlockIterator) Error() error      { return nil }
+func (i *sliceBlockIterator) Close()            {}
+
+func filteredTx(txid string, names ...string) *protos.FilteredTransaction {
+	tx := &protos.FilteredTransaction{Txid: txid}
+	for _, name := range names {
+		tx.Events = append(tx.Events, &protos.ContractEvent{Contract: "counter", Name: name})
+	}
+	return tx
+}
+
+// testEventBlocks 高度1有3个事件，高度2没有事件，高度3有2个事件
+var testEventBlocks = []*protos.FilteredBlock{
+	{BlockHeight: 1, Blockid: "01", Txs: []*protos.FilteredTransaction{
+		filteredTx("0a", "e1", "e2"), filteredTx("0b", "e3"),
+	}},
+	{BlockHeight: 2, Blockid: "02"},
+	{BlockHeight: 3, Blockid: "03", Txs: []*protos.FilteredTransaction{
+		filteredTx("0c", "e4", "e5"),
+	}},
+}
+
+func eventNames(events []*pb.ContractEventInfo) []string {
+	var names []string
+	for _, event := range events {
+		names = append(names, event.GetEvent().GetName())
+	}
+	return names
+}
+
+func TestCollectContractEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     int64
+		skip      int64
+		limit     int64
+		want      []string
+		wantMore  bool
+		wantNextH int64
+		wantNextS int64
+	}{
+		{
+			name:  "all events",
+			start: 1, limit: 10,
+			want: []string{"e1", "e2", "e3", "e4", "e5"},
+		},
+		{
+			name:  "exactly limit without remaining",
+			start: 1, limit: 5,
+			want: []string{"e1", "e2", "e3", "e4", "e5"},
+		},
+		{
+			name:  "remaining in same block",
+			start: 1, limit: 2,
+			want:     []string{"e1", "e2"},
+			wantMore: true, wantNextH: 1, wantNextS: 2,
+		},
+		{
+			name:  "remaining in later block",
+			start: 1, limit: 3,
+			want:     []string{"e1", "e2", "e3"},
+			wantMore: true, wantNextH: 3, wantNextS: 0,
+		},
+		{
+			name:  "skip events of start height",
+			start: 1, skip: 2, limit: 10,
+			want: []string{"e3", "e4", "e5"},
+		},
+		{
+			name:  "skip with limit",
+			start: 3, skip: 1, limit: 1,
+			want: []string{"e5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &pb.ContractEventsResponse{}
+			iter := newSliceBlockIterator(testEventBlocks, tt.start)
+			if err := collectContractEvents(iter, tt.start, tt.skip, tt.limit, resp); err != nil {
+				t.Fatal(err)
+			}
+			if got := eventNames(resp.Events); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("events = %v, want %v", got, tt.want)
+			}
+			if resp.HasMore != tt.wantMore || resp.NextHeight != tt.wantNextH || resp.NextSkip != tt.wantNextS {
+				t.Fatalf("next page = %v,%d,%d, want %v,%d,%d", resp.HasMore, resp.NextHeight,
+					resp.NextSkip, tt.wantMore, tt.wantNextH, tt.wantNextS)
+			}
+		})
+	}
+}
+
+func TestCollectContractEventsResume(t *testing.T) {
+	for limit := int64(1); limit <= 5; limit++ {
+		var got []string
+		start, skip := int64(1), int64(0)
+		for pages := 0; ; pages++ {
+			if pages > 5 {
+				t.Fatalf("limit %d: too many pages", limit)
+			}
+			resp := &pb.ContractEventsResponse{}
+			iter := newSliceBlockIterator(testEventBlocks, start)
+			if err := collectContractEvents(iter, start, skip, limit, resp); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, eventNames(resp.Events)...)
+			if !resp.HasMore {
+				break
+			}
+			start, skip = resp.NextHeight, resp.NextSkip
+		}
+		want := []string{"e1", "e2", "e3", "e4", "e5"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("limit %d: events = %v, want %v", limit, got, want)
+		}
+	}
+}
+
+func TestQueryTxStatus(t *testing.T) {
+	queryTx := func(txid []byte) (*xpb.TxInfo, error) {
+		switch txid[0] {
+		case 0x01:
+			return &xpb.TxInfo{
+				Status:   lpb.TransactionStatus_TX_CONFIRM,
+				Distance: 3,
+				Tx:       &lpb.Transaction{Txid: txid, Initiator: "alice"},
+			}, nil
+		case 0x02:
+			return nil, ecom.ErrTxNotExist
+		default:
+			return nil, ecom.ErrInternal
+		}
+	}
+
+	tests := []struct {
+		name       string
+		txid       []byte
+		wantErr    pb.XChainErrorEnum
+		wantStatus pb.TransactionStatus
+		wantTx     bool
+	}{
+		{
+			name:       "confirmed",
+			txid:       []byte{0x01},
+			wantErr:    pb.XChainErrorEnum_SUCCESS,
+			wantStatus: pb.TransactionStatus_CONFIRM,
+			wantTx:     true,
+		},
+		{
+			name:       "not exist",
+			txid:       []byte{0x02},
+			wantErr:    pb.XChainErrorEnum_TX_NOT_FOUND_ERROR,
+			wantStatus: pb.TransactionStatus_NOEXIST,
+		},
+		{
+			name:    "query failed",
+			txid:    []byte{0x03},
+			wantErr: pb.XChainErrorEnum_UNKNOW_ERROR,
+		},
+		{
+			name:    "empty txid",
+			wantErr: pb.XChainErrorEnum_CONNECT_REFUSE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := (&RpcServ{}).queryTxStatus(queryTx, "xuper", tt.txid)
+			if item.GetHeader().GetError() != tt.wantErr || item.GetStatus() != tt.wantStatus {
+				t.Fatalf("item = %v,%v, want %v,%v", item.GetHeader().GetError(), item.GetStatus(),
+					tt.wantErr, tt.wantStatus)
+			}
+			if item.GetBcname() != "xuper" || string(item.GetTxid()) != string(tt.txid) {
+				t.Fatalf("unexpected item: %v", item)
+			}
+			if (item.GetTx() != nil) != tt.wantTx {
+				t.Fatalf("expect tx %v, got %v", tt.wantTx, item.GetTx())
+			}
+			if tt.wantTx && (item.GetDistance() != 3 || item.GetTx().GetInitiator() != "alice") {
+				t.Fatalf("unexpected tx: %v", item)
+			}
+		})
+	}
+}
+
+// fakeSnapshotReader 高度1和2为主干区块，高度3为分支区块，快照值为"{key}@{height}"
+type fakeSnapshotReader struct{}
+
+func (r *fakeSnapshotReader) QueryBlockHeader(blkId []byte) (*xpb.BlockInfo, error) {
+	return r.QueryBlockHeaderByHeight(int64(blkId[0]))
+}
+
+func (r *fakeSnapshotReader) QueryBlockHeaderByHeight(height int64) (*xpb.BlockInfo, error) {
+	status := lpb.BlockStatus_BLOCK_TRUNK
+	switch {
+	case height > 3:
+		return nil, ecom.ErrBlockNotExist
+	case height == 3:
+		status = lpb.BlockStatus_BLOCK_BRANCH
+	}
+	block := &lpb.InternalBlock{Blockid: []byte{byte(height)}, Height: height}
+	return &xpb.BlockInfo{Status: status, Block: block}, nil
+}
+
+func (r *fakeSnapshotReader) GetSnapshotValue(bucket string, key, blkId []byte) ([]byte, error) {
+	return []byte(fmt.Sprintf("%s@%d", key, blkId[0])), nil
+}
+
+func TestReadStateAtHeight(t *testing.T) {
+	rctx := newTestReqCtx(t)
+	tests := []struct {
+		name       string
+		req        *pb.StateAtHeightRequest
+		wantErr    bool
+		wantHeight int64
+		wantValue  string
+	}{
+		{
+			name:       "by height",
+			req:        &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter", Key: []byte("k"), Height: 1},
+			wantHeight: 1,
+			wantValue:  "k@1",
+		},
+		{
+			name: "blockid takes precedence",
+			req: &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter", Key: []byte("k"),
+				Blockid: []byte{0x02}, Height: 1},
+			wantHeight: 2,
+			wantValue:  "k@2",
+		},
+		{
+			name: "branch block",
+			req: &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter", Key: []byte("k"),
+				Blockid: []byte{0x03}},
+			wantErr: true,
+		},
+		{
+			name:    "height not exist",
+			req:     &pb.StateAtHeightRequest{Bcname: "xuper", Bucket: "counter", Key: []byte("k"), Height: 9},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &pb.StateAtHeightResponse{}
+			err := (&RpcServ{}).readStateAtHeight(rctx, &fakeSnapshotReader{}, tt.req, resp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readStateAtHeight() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if resp.GetHeight() != tt.wantHeight || string(resp.GetValue()) != tt.wantValue ||
+				resp.GetBlockid()[0] != byte(tt.wantHeight) || resp.GetBucket() != "counter" ||
+				string(resp.GetKey()) != "k" {
+				t.Fatalf("unexpected response: %v", resp)
+			}
+		})
+	}
+}
+
+// fakeMempool trunk中为已上链交易，pool中为mempool中的交易
+type fakeMempool struct {
+	trunk map[string]bool
+	pool  map[string]bool
+}
+
+func (m *fakeMempool) IsTxInTrunk(txid []byte) bool {
+	return m.trunk[string(txid)]
+}
+
+func (m *fakeMempool) GetPendingTx(txid []byte) (*lpb.Transaction, bool) {
+	if !m.pool[string(txid)] {
+		return nil, false
+	}
+	return &lpb.Transaction{Txid: txid}, true
+}
+
+func TestToPendingTx(t *testing.T) {
+	mempool := &fakeMempool{
+		trunk: map[string]bool{"t1": true},
+		pool:  map[string]bool{"p1": true, "p2": true},
+	}
+	now := time.Unix(100, 0)
+	newTx := func(refs ...string) *lpb.Transaction {
+		tx := &lpb.Transaction{
+			Txid:              []byte("tx"),
+			Initiator:         "alice",
+			ReceivedTimestamp: time.Unix(99, 0).UnixNano(),
+			ContractRequests:  []*protos.InvokeRequest{{ContractName: "counter"}},
+		}
+		for i, ref := range refs {
+			// utxo与读集依赖同一交易时只计一次
+			tx.TxInputs = append(tx.TxInputs, &protos.TxInput{RefTxid: []byte(ref)})
+			if i == 0 {
+				tx.TxInputsExt = append(tx.TxInputsExt, &protos.TxInputExt{RefTxid: []byte(ref)})
+			}
+		}
+		return tx
+	}
+
+	tests := []struct {
+		name        string
+		tx          *lpb.Transaction
+		needContent bool
+		wantState   pb.PendingTxState
+		wantParents []string
+	}{
+		{
+			name:      "parents in trunk",
+			tx:        newTx("t1"),
+			wantState: pb.PendingTxState_PENDING_READY,
+		},
+		{
+			name:        "wait parent in mempool",
+			tx:          newTx("p2", "t1", "p1"),
+			needContent: true,
+			wantState:   pb.PendingTxState_PENDING_WAIT_PARENT,
+			wantParents: []string{"p1", "p2"},
+		},
+		{
+			name:        "orphan",
+			tx:          newTx("p1", "x1"),
+			wantState:   pb.PendingTxState_PENDING_ORPHAN,
+			wantParents: []string{"p1", "x1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&RpcServ{}).toPendingTx(mempool, tt.tx, now, tt.needContent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var parents []string
+			for _, parent := range got.GetParents() {
+				parents = append(parents, string(parent))
+			}
+			if got.GetState() != tt.wantState || !reflect.DeepEqual(parents, tt.wantParents) {
+				t.Fatalf("pending tx = %v,%q, want %v,%q", got.GetState(), parents, tt.wantState, tt.wantParents)
+			}
+			if got.GetAge() != 1000 || got.GetInitiator() != "alice" ||
+				!reflect.DeepEqual(got.GetContracts(), []string{"counter"}) {
+				t.Fatalf("unexpected pending tx: %v", got)
+			}
+			if (got.GetTx() != nil) != tt.needContent {
+				t.Fatalf("expect content %v, got %v", tt.needContent, got.GetTx())
+			}
+		})
+	}
+}
+
+func TestFilterPendingTxs(t *testing.T) {
+	txs := []*lpb.Transaction{
+		{Txid: []byte("1"), Initiator: "alice"},
+		{Txid: []byte("2"), Initiator: "bob", ContractRequests: []*protos.InvokeRequest{{ContractName: "counter"}}},
+		{Txid: []byte("3"), Initiator: "alice", ContractRequests: []*protos.InvokeRequest{{ContractName: "counter"}}},
+	}
+	tests := []struct {
+		initiator string
+		contract  string
+		want      []string
+	}{
+		{want: []string{"1", "2", "3"}},
+		{initiator: "alice", want: []string{"1", "3"}},
+		{contract: "counter", want: []string{"2", "3"}},
+		{initiator: "alice", contract: "counter", want: []string{"3"}},
+		{initiator: "carol"},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, tx := range filterPendingTxs(txs, tt.initiator, tt.contract) {
+			got = append(got, string(tx.GetTxid()))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("filterPendingTxs(%q, %q) = %v, want %v", tt.initiator, tt.contract, got, tt.want)
+		}
+	}
+}
+
+func TestFillContractInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		info *models.ContractInfo
+		want *pb.ContractInfoResponse
+	}{
+		{
+			name: "upgraded evm contract",
+			info: &models.ContractInfo{
+				Desc: &protos.WasmCodeDesc{
+					ContractType: "evm",
+					Runtime:      "evm",
+					Digest:       []byte{0xab},
+				},
+				Account:   "XC1111111111111111@xuper",
+				DeployTx:  &lpb.Transaction{Txid: []byte{0x01}},
+				UpgradeTx: &lpb.Transaction{Txid: []byte{0x02}},
+				Abi:       []byte("abi"),
+				Code:      []byte("code"),
+			},
+			want: &pb.ContractInfoResponse{
+				Module:      "evm",
+				Runtime:     "evm",
+				CodeHash:    []byte{0xab},
+				Account:     "XC1111111111111111@xuper",
+				DeployTxid:  []byte{0x01},
+				UpgradeTxid: []byte{0x02},
+				Abi:         []byte("abi"),
+				Code:        []byte("code"),
+			},
+		},
+		{
+			name: "legacy wasm contract",
+			info: &models.ContractInfo{
+				Desc:     &protos.WasmCodeDesc{Runtime: "c", Compiler: "emcc", VmCompiler: "xvm"},
+				DeployTx: &lpb.Transaction{Txid: []byte{0x01}},
+			},
+			want: &pb.ContractInfoResponse{
+				Module:     "wasm",
+				Runtime:    "c",
+				Compiler:   "emcc",
+				VmCompiler: "xvm",
+				DeployTxid: []byte{0x01},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &pb.ContractInfoResponse{}
+			fillContractInfo(resp, tt.info)
+			if !proto.Equal(resp, tt.want) {
+				t.Fatalf("fillContractInfo() = %v, want %v", resp, tt.want)
+			}
+		})
+	}
+}
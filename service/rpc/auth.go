@@ -0,0 +1,195 @@
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+)
+
+// 网关会把http头Authorization转为grpc metadata authorization
+const (
+	authMetadataKey = "authorization"
+	bearerPrefix    = "bearer "
+)
+
+var (
+	errMissingToken     = errors.New("missing bearer token")
+	errInvalidToken     = errors.New("invalid token")
+	errTokenExpired     = errors.New("token expired or not yet valid")
+	errMethodNotAllowed = errors.New("method not allowed for token")
+)
+
+// methodSet 方法名集合，方法名均为小写，包含*时表示全部方法
+type methodSet map[string]bool
+
+func newMethodSet(methods []string) methodSet {
+	set := make(methodSet, len(methods))
+	for _, method := range methods {
+		set[strings.ToLower(method)] = true
+	}
+	return set
+}
+
+func (s methodSet) contains(method string) bool {
+	return s["*"] || s[strings.ToLower(method)]
+}
+
+// authenticator 校验请求携带的api key或HS256签名的JWT是否允许调用方法
+type authenticator struct {
+	log       logs.Logger
+	public    methodSet
+	apiKeys   map[string]methodSet
+	jwtSecret []byte
+	now       func() time.Time
+}
+
+// newAuthenticator 未开启访问控制时返回nil
+func newAuthenticator(conf sconf.AuthConf, log logs.Logger) *authenticator {
+	if !conf.Enable {
+		return nil
+	}
+
+	auth := &authenticator{
+		log:       log,
+		public:    newMethodSet(conf.PublicMethods),
+		apiKeys:   make(map[string]methodSet, len(conf.ApiKeys)),
+		jwtSecret: []byte(conf.JwtSecret),
+		now:       time.Now,
+	}
+	for _, apiKey := range conf.ApiKeys {
+		if apiKey.Key != "" {
+			auth.apiKeys[apiKey.Key] = newMethodSet(apiKey.Methods)
+		}
+	}
+	return auth
+}
+
+// authorize fullMethod为grpc的完整方法名，如/pb.Xchain/PreExec
+func (a *authenticator) authorize(ctx context.Context, fullMethod string) error {
+	method := methodName(fullMethod)
+	if a.public.contains(method) {
+		return nil
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return errMissingToken
+	}
+	methods, err := a.tokenMethods(token)
+	if err != nil {
+		return err
+	}
+	if !methods.contains(method) {
+		return errMethodNotAllowed
+	}
+	return nil
+}
+
+func (a *authenticator) tokenMethods(token string) (methodSet, error) {
+	if methods, ok := a.apiKeys[token]; ok {
+		return methods, nil
+	}
+	if len(a.jwtSecret) != 0 && strings.Count(token, ".") == 2 {
+		return a.verifyJWT(token)
+	}
+	return nil, errInvalidToken
+}
+
+// verifyJWT 只接受HS256签名，exp和nbf为空时不校验
+func (a *authenticator) verifyJWT(token string) (methodSet, error) {
+	parts := strings.Split(token, ".")
+	header := struct {
+		Alg string `json:"alg"`
+	}{}
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, errInvalidToken
+	}
+	sign, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	mac := hmac.New(sha256.New, a.jwtSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sign, mac.Sum(nil)) {
+		return nil, errInvalidToken
+	}
+
+	claims := struct {
+		Methods []string `json:"methods"`
+		Exp     int64    `json:"exp"`
+		Nbf     int64    `json:"nbf"`
+	}{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, errInvalidToken
+	}
+	now := a.now().Unix()
+	if (claims.Exp != 0 && now >= claims.Exp) || (claims.Nbf != 0 && now < claims.Nbf) {
+		return nil, errTokenExpired
+	}
+	return newMethodSet(claims.Methods), nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get(authMetadataKey) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):])
+		}
+	}
+	return ""
+}
+
+// UnaryInterceptor 需要放在RpcServ.UnaryInterceptor之后，由其根据错误设置响应头
+func (a *authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		err := a.authorize(ctx, info.FullMethod)
+		if err == nil {
+			return handler(ctx, req)
+		}
+
+		if rctx := sctx.ValueReqCtx(ctx); rctx != nil {
+			rctx.GetLog().Warn("request refused by authenticator", "rpc_method", info.FullMethod, "err", err)
+		}
+		return newEmptyResp(info), ecom.ErrUnauthorized.More("%v", err)
+	}
+}
+
+// StreamInterceptor 流式接口没有统一的响应头，直接返回grpc错误
+func (a *authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			a.log.Warn("stream refused by authenticator", "rpc_method", info.FullMethod, "err", err)
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(srv, ss)
+	}
+}
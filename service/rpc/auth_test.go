@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+)
+
+func signJWT(secret, alg, claims string) string {
+	enc := base64.RawURLEncoding
+	payload := enc.EncodeToString([]byte(`{"alg":"`+alg+`","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return payload + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticator(t *testing.T) {
+	if newAuthenticator(sconf.AuthConf{JwtSecret: "secret"}, nil) != nil {
+		t.Fatal("expect nil authenticator when disabled")
+	}
+
+	auth := newAuthenticator(sconf.AuthConf{
+		Enable:        true,
+		PublicMethods: []string{"GetSystemStatus"},
+		ApiKeys: []sconf.ApiKeyConf{
+			{Key: "admin", Methods: []string{"*"}},
+			{Key: "reader", Methods: []string{"getblock"}},
+		},
+		JwtSecret: "secret",
+	}, nil)
+	now := time.Unix(1000, 0)
+	auth.now = func() time.Time { return now }
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+	validJWT := signJWT("secret", "HS256", `{"methods":["PostTx"],"exp":2000,"nbf":500}`)
+	cases := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		err    error
+	}{
+		{"public", context.Background(), "/pb.Xchain/GetSystemStatus", nil},
+		{"missing", context.Background(), "/pb.Xchain/GetBlock", errMissingToken},
+		{"wildcard", withToken("admin"), "/pb.Xchain/PostTx", nil},
+		{"api key", withToken("reader"), "/pb.Xchain/GetBlock", nil},
+		{"api key method", withToken("reader"), "/pb.Xchain/PostTx", errMethodNotAllowed},
+		{"unknown key", withToken("other"), "/pb.Xchain/GetBlock", errInvalidToken},
+		{"jwt", withToken(validJWT), "/pb.Xchain/PostTx", nil},
+		{"jwt method", withToken(validJWT), "/pb.Xchain/GetBlock", errMethodNotAllowed},
+		{"jwt expired", withToken(signJWT("secret", "HS256", `{"methods":["*"],"exp":1000}`)), "/pb.Xchain/PostTx", errTokenExpired},
+		{"jwt not before", withToken(signJWT("secret", "HS256", `{"methods":["*"],"nbf":1001}`)), "/pb.Xchain/PostTx", errTokenExpired},
+		{"jwt bad sign", withToken(signJWT("other", "HS256", `{"methods":["*"]}`)), "/pb.Xchain/PostTx", errInvalidToken},
+		{"jwt bad alg", withToken(signJWT("secret", "none", `{"methods":["*"]}`)), "/pb.Xchain/PostTx", errInvalidToken},
+	}
+	for _, c := range cases {
+		if err := auth.authorize(c.ctx, c.method); err != c.err {
+			t.Errorf("%s: expect %v, got %v", c.name, c.err, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// blockNotifier 按链共享一个区块事件迭代器，向等待者广播新区块通知。
+// 迭代器阻塞在等待新区块时无法中断，由每个请求各自订阅会在请求结束后泄漏协程，
+// 共享后等待者可随时取消，每条链最多保留一个等待中的迭代器
+type blockNotifier struct {
+	mu        sync.Mutex
+	log       logs.Logger
+	subscribe func(bcName string) (event.Iterator, error)
+	chains    map[string]map[chan struct{}]struct{}
+}
+
+func newBlockNotifier(router *event.Router, log logs.Logger) *blockNotifier {
+	subscribe := func(bcName string) (event.Iterator, error) {
+		filter := &protos.BlockFilter{
+			Bcname:         bcName,
+			ExcludeTx:      true,
+			ExcludeTxEvent: true,
+		}
+		return router.RawSubscribe(protos.SubscribeType_BLOCK, filter)
+	}
+	return &blockNotifier{
+		log:       log,
+		subscribe: subscribe,
+		chains:    make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// watch 订阅链的新区块通知，迭代器异常退出时关闭通道，调用返回的cancel取消订阅
+func (n *blockNotifier) watch(bcName string) (<-chan struct{}, func(), error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	waiters, ok := n.chains[bcName]
+	if !ok {
+		iter, err := n.subscribe(bcName)
+		if err != nil {
+			return nil, nil, err
+		}
+		waiters = make(map[chan struct{}]struct{})
+		n.chains[bcName] = waiters
+		go n.run(bcName, iter, waiters)
+	}
+
+	ch := make(chan struct{}, 1)
+	waiters[ch] = struct{}{}
+	cancel := func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(waiters, ch)
+	}
+	return ch, cancel, nil
+}
+
+// run 将新区块通知给所有等待者，没有等待者时在下一个区块到来后退出
+func (n *blockNotifier) run(bcName string, iter event.Iterator, waiters map[chan struct{}]struct{}) {
+	defer iter.Close()
+	for iter.Next() {
+		n.mu.Lock()
+		if len(waiters) == 0 {
+			delete(n.chains, bcName)
+			n.mu.Unlock()
+			return
+		}
+		for ch := range waiters {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+		n.mu.Unlock()
+	}
+
+	if n.log != nil {
+		n.log.Warn("block event iterator exit", "bc_name", bcName, "err", iter.Error())
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.chains, bcName)
+	for ch := range waiters {
+		close(ch)
+		delete(waiters, ch)
+	}
+}
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+)
+
+// chanIterator 每从blocks收到一个值产生一个区块，blocks关闭时迭代结束
+type chanIterator struct {
+	blocks chan struct{}
+	closed chan struct{}
+}
+
+func newChanIterator() *chanIterator {
+	return &chanIterator{
+		blocks: make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+}
+
+func (i *chanIterator) Next() bool {
+	_, ok := <-i.blocks
+	return ok
+}
+
+func (i *chanIterator) Data() interface{} { return nil }
+func (i *chanIterator) Error() error      { return nil }
+func (i *chanIterator) Close()            { close(i.closed) }
+
+func TestBlockNotifier(t *testing.T) {
+	var iters []*chanIterator
+	n := &blockNotifier{
+		subscribe: func(bcName string) (event.Iterator, error) {
+			iter := newChanIterator()
+			iters = append(iters, iter)
+			return iter, nil
+		},
+		chains: make(map[string]map[chan struct{}]struct{}),
+	}
+
+	// 同一条链的等待者共享迭代器
+	ch1, cancel1, err := n.watch("xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch2, cancel2, _ := n.watch("xuper")
+	if len(iters) != 1 {
+		t.Fatalf("expect 1 iterator, got %d", len(iters))
+	}
+	iters[0].blocks <- struct{}{}
+	for _, ch := range []<-chan struct{}{ch1, ch2} {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("expect block notified")
+		}
+	}
+
+	// 等待者全部取消后，迭代器在下一个区块到来时关闭
+	cancel1()
+	cancel2()
+	iters[0].blocks <- struct{}{}
+	select {
+	case <-iters[0].closed:
+	case <-time.After(time.Second):
+		t.Fatal("expect iterator closed")
+	}
+
+	// 重新订阅创建新的迭代器，迭代器退出时关闭通道
+	ch3, cancel3, _ := n.watch("xuper")
+	defer cancel3()
+	if len(iters) != 2 {
+		t.Fatalf("expect 2 iterators, got %d", len(iters))
+	}
+	close(iters[1].blocks)
+	select {
+	case _, ok := <-ch3:
+		if ok {
+			t.Fatal("expect channel closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expect channel closed")
+	}
+}
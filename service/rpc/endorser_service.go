@@ -18,6 +18,7 @@ import (
 	scom "github.com/xuperchain/xuperchain/service/common"
 	sconf "github.com/xuperchain/xuperchain/service/config"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/tracing"
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
@@ -45,6 +46,7 @@ func newEndorserService(cfg *sconf.ServConf, engine ecom.Engine, svr XEndorserSe
 	switch cfg.EndorserModule {
 	case EndorserModuleDefault:
 		dxe := NewDefaultXEndorser(svr, engine)
+		dxe.readOnly = cfg.ReadOnly
 		return dxe, nil
 	case EndorserModuleProxy:
 		return &ProxyXEndorser{
@@ -126,6 +128,8 @@ type DefaultXEndorser struct {
 	svr         XEndorserServer
 	requestType map[string]bool
 	engine      ecom.Engine
+	// 只读节点不提交手续费交易，也不锁定utxo
+	readOnly bool
 }
 
 var _ XEndorser = (*DefaultXEndorser)(nil)
@@ -244,6 +248,9 @@ func (dxe *DefaultXEndorser) getPreExecResult(ctx context.Context, req *pb.Endor
 	if err != nil {
 		return nil, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, err
 	}
+	if dxe.readOnly && request.GetNeedLock() {
+		return nil, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, scom.ErrReadOnly
+	}
 
 	res, err := dxe.svr.PreExecWithSelectUTXO(ctx, request)
 	if err != nil {
@@ -328,6 +335,9 @@ func (dxe *DefaultXEndorser) processFee(ctx context.Context, req *pb.EndorserReq
 		// no fee provided, default to true
 		return pb.XChainErrorEnum_SUCCESS, nil
 	}
+	if dxe.readOnly {
+		return pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, scom.ErrReadOnly
+	}
 
 	txStatus := &pb.TxStatus{
 		Txid:   req.GetFee().GetTxid(),
@@ -444,7 +454,7 @@ func (dxe *DefaultXEndorser) createReqCtx(gctx context.Context, reqHeader *pb.He
 		return nil, fmt.Errorf("create request context failed.err:%v", err)
 	}
 
-	return rctx, nil
+	return tracing.WithSpan(gctx, rctx), nil
 }
 
 func (dxe *DefaultXEndorser) getClietIP(gctx context.Context) (string, error) {
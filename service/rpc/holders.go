@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/xuperchain/xuperchain/models"
+)
+
+// tokenHolderCache 按链缓存遍历utxo表得到的代币持有人，链高度不变时直接复用，
+// 同一条链同时只有一个请求遍历utxo表
+type tokenHolderCache struct {
+	mu     sync.Mutex
+	chains map[string]*tokenHolders
+}
+
+type tokenHolders struct {
+	mu      sync.Mutex
+	height  int64
+	holders []*models.TokenHolder
+}
+
+func newTokenHolderCache() *tokenHolderCache {
+	return &tokenHolderCache{
+		chains: make(map[string]*tokenHolders),
+	}
+}
+
+// get 返回链在height高度的持有人列表，未缓存时通过list遍历utxo表
+func (c *tokenHolderCache) get(bcName string, height int64,
+	list func(height int64) ([]*models.TokenHolder, error)) ([]*models.TokenHolder, error) {
+	c.mu.Lock()
+	entry, ok := c.chains[bcName]
+	if !ok {
+		entry = &tokenHolders{}
+		c.chains[bcName] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.holders != nil && entry.height == height {
+		return entry.holders, nil
+	}
+	holders, err := list(height)
+	if err != nil {
+		return nil, err
+	}
+	entry.height = height
+	entry.holders = holders
+	return holders, nil
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/models"
+)
+
+func TestTokenHolderCache(t *testing.T) {
+	cache := newTokenHolderCache()
+	scans := 0
+	list := func(height int64) ([]*models.TokenHolder, error) {
+		scans++
+		return []*models.TokenHolder{{Address: "a", Balance: big.NewInt(height)}}, nil
+	}
+
+	// 同一高度只遍历一次
+	for i := 0; i < 2; i++ {
+		holders, err := cache.get("xuper", 10, list)
+		if err != nil || len(holders) != 1 || holders[0].Balance.Int64() != 10 {
+			t.Fatalf("unexpected holders: %v, %v", holders, err)
+		}
+	}
+	if scans != 1 {
+		t.Fatalf("expect 1 scan, got %d", scans)
+	}
+
+	// 高度变化或其他链重新遍历
+	if holders, _ := cache.get("xuper", 11, list); holders[0].Balance.Int64() != 11 {
+		t.Fatal("expect holders of new height")
+	}
+	if _, err := cache.get("other", 11, list); err != nil || scans != 3 {
+		t.Fatalf("expect 3 scans, got %d, %v", scans, err)
+	}
+
+	// 遍历失败不缓存
+	failed := func(height int64) ([]*models.TokenHolder, error) {
+		return nil, errors.New("scan failed")
+	}
+	if _, err := cache.get("xuper", 12, failed); err == nil {
+		t.Fatal("expect scan error")
+	}
+	if _, err := cache.get("xuper", 12, list); err != nil || scans != 4 {
+		t.Fatalf("expect rescan after failure, got %d, %v", scans, err)
+	}
+}
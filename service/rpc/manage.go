@@ -28,6 +28,7 @@ import (
 	scom "github.com/xuperchain/xuperchain/service/common"
 	sconf "github.com/xuperchain/xuperchain/service/config"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/txindex"
 )
 
 // rpc server启停控制管理
@@ -59,6 +60,13 @@ func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, er
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
+	if scfg.EnableTxIndex {
+		obj.rpcServ.txIndex = txindex.NewManager(xosEngine, log)
+	}
+	obj.rpcServ.readOnly = scfg.ReadOnly
+	if scfg.EnableTokenSupply {
+		obj.rpcServ.holderCache = newTokenHolderCache()
+	}
 
 	return obj, nil
 }
@@ -71,6 +79,10 @@ func (t *RpcServMG) Run() error {
 
 	t.log.Trace("run grpc server", "isTls", t.scfg.EnableTls)
 
+	if t.rpcServ.txIndex != nil {
+		t.rpcServ.txIndex.Start()
+	}
+
 	// 启动rpc server，阻塞直到退出
 	err := t.runRpcServ()
 	if err != nil {
@@ -98,13 +110,32 @@ func (t *RpcServMG) runRpcServ() error {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		t.rpcServ.UnaryInterceptor(),
 	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		t.rpcServ.StreamInterceptor(),
+	}
 
+	if filter := newMethodFilter(t.scfg.AllowMethods, t.scfg.DenyMethods, t.log); filter != nil {
+		unaryInterceptors = append(unaryInterceptors, filter.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, filter.StreamInterceptor())
+	}
+	// 限流依赖请求上下文，需要放在RpcServ拦截器之后
+	if limiter := newRateLimiter(t.scfg.RateLimit, t.log); limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, limiter.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, limiter.StreamInterceptor())
+	}
+	// 鉴权放在限流之后，避免未授权请求绕过限流反复尝试token
+	if auth := newAuthenticator(t.scfg.Auth, t.log); auth != nil {
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor())
+	}
 	if t.scfg.EnableMetric {
 		unaryInterceptors = append(unaryInterceptors, gpromeus.UnaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, gpromeus.StreamServerInterceptor)
 	}
 
 	rpcOptions := []grpc.ServerOption{
 		middleware.WithUnaryServerChain(unaryInterceptors...),
+		middleware.WithStreamServerChain(streamInterceptors...),
 		grpc.MaxRecvMsgSize(t.scfg.MaxRecvMsgSize),
 		grpc.ReadBufferSize(t.scfg.ReadBufSize),
 		grpc.InitialWindowSize(t.scfg.InitWindowSize),
@@ -138,6 +169,7 @@ func (t *RpcServMG) runRpcServ() error {
 
 	if t.scfg.EnableMetric {
 		metrics.RegisterMetrics()
+		registerStreamMetrics()
 		gpromeus.Register(t.servHD)
 		gpromeus.EnableHandlingTimeHistogram(
 			gpromeus.WithHistogramBuckets(metrics.DefBuckets),
@@ -201,4 +233,7 @@ func (t *RpcServMG) stopRpcServ() {
 		// 优雅关闭grpc server
 		t.servHD.GracefulStop()
 	}
+	if t.rpcServ.txIndex != nil {
+		t.rpcServ.txIndex.Stop()
+	}
 }
@@ -0,0 +1,191 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	acom "github.com/xuperchain/xuperchain/service/common"
+	sconf "github.com/xuperchain/xuperchain/service/config"
+)
+
+// 清理空闲令牌桶的间隔，桶已补满即视为空闲，删除后再创建不影响限流结果
+const limiterCleanInterval = time.Minute
+
+// tokenBucket 令牌桶，按时间差惰性补充令牌
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(conf sconf.LimitConf, now time.Time) *tokenBucket {
+	burst := float64(conf.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(conf.Rate))
+	}
+	return &tokenBucket{
+		rate:   conf.Rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *tokenBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+// rateLimiter 按客户端ip以及ip+方法两级令牌桶限流
+type rateLimiter struct {
+	log     logs.Logger
+	perIP   sconf.LimitConf
+	methods map[string]sconf.LimitConf
+
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastClean time.Time
+	now       func() time.Time
+}
+
+// newRateLimiter 未开启限流或没有有效的限制时返回nil
+func newRateLimiter(conf sconf.RateLimitConf, log logs.Logger) *rateLimiter {
+	if !conf.Enable {
+		return nil
+	}
+
+	limiter := &rateLimiter{
+		log:     log,
+		perIP:   conf.PerIP,
+		methods: make(map[string]sconf.LimitConf),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+	for method, limit := range conf.Methods {
+		if limit.Rate > 0 {
+			limiter.methods[strings.ToLower(method)] = limit
+		}
+	}
+	if limiter.perIP.Rate <= 0 && len(limiter.methods) == 0 {
+		return nil
+	}
+	limiter.lastClean = limiter.now()
+	return limiter
+}
+
+// allow fullMethod为grpc的完整方法名，如/pb.Xchain/PreExec
+func (l *rateLimiter) allow(clientIP, fullMethod string) bool {
+	method := strings.ToLower(methodName(fullMethod))
+	methodLimit, limitMethod := l.methods[method]
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.cleanIdle(now)
+
+	// 先检查方法限制，避免被单个方法拒绝的请求消耗ip的令牌
+	if limitMethod && !l.bucket(clientIP+"/"+method, methodLimit, now).allow(now) {
+		return false
+	}
+	if l.perIP.Rate > 0 && !l.bucket(clientIP, l.perIP, now).allow(now) {
+		return false
+	}
+	return true
+}
+
+func (l *rateLimiter) bucket(key string, conf sconf.LimitConf, now time.Time) *tokenBucket {
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(conf, now)
+		l.buckets[key] = bucket
+	}
+	return bucket
+}
+
+func (l *rateLimiter) cleanIdle(now time.Time) {
+	if now.Sub(l.lastClean) < limiterCleanInterval {
+		return
+	}
+	for key, bucket := range l.buckets {
+		if bucket.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastClean = now
+}
+
+// UnaryInterceptor 需要放在RpcServ.UnaryInterceptor之后，依赖其创建的请求上下文，
+// 并由其根据错误设置响应头
+func (l *rateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		rctx := sctx.ValueReqCtx(ctx)
+		if rctx == nil || l.allow(rctx.GetClientIp(), info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		rctx.GetLog().Warn("request refused by rate limiter", "rpc_method", info.FullMethod)
+		return newEmptyResp(info), acom.ErrRateLimited
+	}
+}
+
+// StreamInterceptor 流式接口在收到请求前还没有请求上下文，直接从连接中获取客户端ip
+func (l *rateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		clientIp, err := getClientIp(ss.Context())
+		if err != nil || l.allow(clientIp, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		l.log.Warn("stream refused by rate limiter", "rpc_method", info.FullMethod, "client_ip", clientIp)
+		return status.Error(codes.ResourceExhausted, acom.ErrRateLimited.Error())
+	}
+}
+
+// newEmptyResp 按服务实现的方法签名构造空响应，拦截器返回的响应不能为nil
+func newEmptyResp(info *grpc.UnaryServerInfo) interface{} {
+	method := reflect.ValueOf(info.Server).MethodByName(methodName(info.FullMethod))
+	if !method.IsValid() || method.Type().NumOut() == 0 {
+		return nil
+	}
+	respType := method.Type().Out(0)
+	if respType.Kind() != reflect.Ptr {
+		return nil
+	}
+	return reflect.New(respType.Elem()).Interface()
+}
+
+// methodName 从grpc完整方法名中取出方法名，如/pb.Xchain/PreExec为PreExec
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
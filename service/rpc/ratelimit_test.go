@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+func TestRateLimiter(t *testing.T) {
+	if newRateLimiter(sconf.RateLimitConf{PerIP: sconf.LimitConf{Rate: 1}}, nil) != nil {
+		t.Fatal("expect nil limiter when disabled")
+	}
+
+	limiter := newRateLimiter(sconf.RateLimitConf{
+		Enable: true,
+		PerIP:  sconf.LimitConf{Rate: 10, Burst: 3},
+		Methods: map[string]sconf.LimitConf{
+			"preexec": {Rate: 1},
+		},
+	}, nil)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	const preExec = "/pb.Xchain/PreExec"
+	const getBlock = "/pb.Xchain/GetBlock"
+	if !limiter.allow("1.1.1.1", preExec) || limiter.allow("1.1.1.1", preExec) {
+		t.Fatal("expect method limit with burst 1")
+	}
+	// 被方法限制拒绝的请求不消耗ip令牌
+	if !limiter.allow("1.1.1.1", getBlock) || !limiter.allow("1.1.1.1", getBlock) {
+		t.Fatal("expect ip tokens left")
+	}
+	if limiter.allow("1.1.1.1", getBlock) {
+		t.Fatal("expect ip limit exceeded")
+	}
+	if !limiter.allow("2.2.2.2", preExec) {
+		t.Fatal("expect independent bucket for another ip")
+	}
+
+	now = now.Add(time.Second)
+	if !limiter.allow("1.1.1.1", preExec) {
+		t.Fatal("expect tokens refilled")
+	}
+
+	now = now.Add(limiterCleanInterval)
+	limiter.allow("3.3.3.3", getBlock)
+	if len(limiter.buckets) != 1 {
+		t.Fatalf("expect idle buckets cleaned, got %d", len(limiter.buckets))
+	}
+}
+
+func TestRateLimiterStream(t *testing.T) {
+	logs.InitLog(filepath.Join(utils.GetCurFileDir(), "../../conf/log.yaml"), t.TempDir())
+	log, _ := logs.NewLogger("", "ratelimit_test")
+	limiter := newRateLimiter(sconf.RateLimitConf{Enable: true, PerIP: sconf.LimitConf{Rate: 1}}, log)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("1.1.1.1"), Port: 80}})
+	ss := &mockServerStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.EventService/Subscribe"}
+	handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+	interceptor := limiter.StreamInterceptor()
+	if err := interceptor(nil, ss, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if err := interceptor(nil, ss, info, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expect stream refused, got %v", err)
+	}
+}
+
+func TestGetClientIp(t *testing.T) {
+	peerCtx := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 37101}})
+	}
+	forwarded := metadata.Pairs(forwardedForKey, "3.3.3.3", forwardedForKey, "4.4.4.4, 2.2.2.2")
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"direct", peerCtx("1.1.1.1"), "1.1.1.1"},
+		// 只信任网关追加的最后一个ip
+		{"gateway", metadata.NewIncomingContext(peerCtx("127.0.0.1"), forwarded), "2.2.2.2"},
+		{"gateway without forwarded", peerCtx("127.0.0.1"), "127.0.0.1"},
+		{"remote with forwarded", metadata.NewIncomingContext(peerCtx("1.1.1.1"), forwarded), "1.1.1.1"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := getClientIp(c.ctx)
+			if err != nil || got != c.want {
+				t.Fatalf("getClientIp() = %s, %v, want %s", got, err, c.want)
+			}
+		})
+	}
+	if _, err := getClientIp(context.Background()); err == nil {
+		t.Fatal("expect error without peer")
+	}
+}
+
+func TestNewEmptyResp(t *testing.T) {
+	info := &grpc.UnaryServerInfo{Server: &RpcServ{}, FullMethod: "/pb.Xchain/PreExec"}
+	if _, ok := newEmptyResp(info).(*pb.InvokeRPCResponse); !ok {
+		t.Fatal("expect InvokeRPCResponse")
+	}
+	info.FullMethod = "/pb.Xchain/NotExist"
+	if newEmptyResp(info) != nil {
+		t.Fatal("expect nil for unknown method")
+	}
+}
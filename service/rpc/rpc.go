@@ -8,28 +8,50 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/lib/utils"
 
 	acom "github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/tracing"
+	"github.com/xuperchain/xuperchain/service/txindex"
 )
 
+// 网关转发请求时附带客户端ip的metadata
+const forwardedForKey = "x-forwarded-for"
+
 type RpcServ struct {
 	engine ecom.Engine
 	log    logs.Logger
+	router *event.Router
+	// WaitTx共享的新区块通知
+	blockNotifier *blockNotifier
+	// 地址交易索引，未开启时为nil
+	txIndex *txindex.Manager
+	// 只读节点拒绝提交交易和锁定utxo
+	readOnly bool
+	// 代币持有人缓存，未开启GetTokenSupply时为nil
+	holderCache *tokenHolderCache
 }
 
 func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
+	router := event.NewRouter(engine)
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:        engine,
+		log:           log,
+		router:        router,
+		blockNotifier: newBlockNotifier(router, log),
 	}
 }
 
@@ -52,6 +74,11 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		}
 		reqHeader := req.(HeaderInterface).GetHeader()
 
+		// start server span, continue the trace context of caller if any
+		ctx, span := tracing.Start(tracing.Extract(ctx), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String(tracing.AttrLogId, reqHeader.GetLogid())))
+
 		// set request context
 		reqCtx, _ := t.createReqCtx(ctx, reqHeader)
 		ctx = sctx.WithReqCtx(ctx, reqCtx)
@@ -59,7 +86,8 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		// output access log
 		logFields := make([]interface{}, 0)
 		logFields = append(logFields, "from", reqHeader.GetFromNode(),
-			"client_ip", reqCtx.GetClientIp(), "rpc_method", info.FullMethod)
+			"client_ip", reqCtx.GetClientIp(), "rpc_method", info.FullMethod,
+			"trace_id", tracing.TraceId(ctx))
 
 		// panic recover
 		defer func() {
@@ -73,6 +101,7 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 				n := runtime.Stack(stack[:], false)
 				log.Printf("%s Rpc server happen panic: %s", reqCtx.GetLog().GetLogId(), stack[:n])
 			}
+			tracing.End(span, err)
 		}()
 
 		// handle request
@@ -102,6 +131,71 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamInterceptor provides a hook to intercept the execution of a streaming RPC on the server.
+// 与UnaryInterceptor一致补全logid、创建请求上下文、输出access日志和恢复panic，并统计流的监控数据
+func (t *RpcServ) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) (err error) {
+		ctx, span := tracing.Start(tracing.Extract(ss.Context()), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer))
+		stream := &monitorStream{ServerStream: ss, serv: t, ctx: ctx}
+		start := time.Now()
+		StreamActiveGauge.WithLabelValues(info.FullMethod).Inc()
+
+		reason := streamCloseFinished
+		defer func() {
+			// 请求被拦截或接收失败时还没有请求上下文，按默认logid创建
+			if stream.rctx == nil {
+				_ = stream.initReqCtx(nil)
+			}
+			logger := t.log
+			if stream.rctx != nil {
+				logger = stream.rctx.GetLog()
+			}
+
+			// panic recover
+			if e := recover(); e != nil {
+				reason = streamClosePanic
+				err = fmt.Errorf("%s log_id = %s", ecom.ErrInternal, logger.GetLogId())
+				logger.Error("Rpc server happen panic", "error", e)
+
+				// stack
+				stack := make([]byte, 8192)
+				n := runtime.Stack(stack[:], false)
+				log.Printf("%s Rpc server happen panic: %s", logger.GetLogId(), stack[:n])
+			}
+
+			StreamActiveGauge.WithLabelValues(info.FullMethod).Dec()
+			StreamDurationHistogram.WithLabelValues(info.FullMethod, reason).Observe(time.Since(start).Seconds())
+			StreamSentCounter.WithLabelValues(info.FullMethod).Add(float64(stream.sent))
+			StreamSentBytesCounter.WithLabelValues(info.FullMethod).Add(float64(stream.sentBytes))
+
+			// output access log
+			// 可以通过log库提供的SetInfoField方法附加输出到access日志
+			stdErr := ecom.ErrSuccess
+			if err != nil {
+				stdErr = ecom.CastError(err)
+			}
+			logFields := []interface{}{"client_ip", stream.clientIp(), "rpc_method", info.FullMethod,
+				"close_reason", reason, "sent", stream.sent, "sent_bytes", stream.sentBytes,
+				"status", stdErr.Status, "err_code", stdErr.Code, "err_msg", stdErr.Msg,
+				"cost_time", time.Since(start).String(), "trace_id", tracing.TraceId(ctx)}
+			if err != nil {
+				logFields = append(logFields, "err", err.Error())
+			}
+			logger.Info("access", logFields...)
+
+			span.SetAttributes(attribute.String(tracing.AttrLogId, logger.GetLogId()),
+				attribute.String("xchain.close_reason", reason), attribute.Int("xchain.sent", stream.sent))
+			tracing.End(span, err)
+		}()
+
+		err = handler(srv, stream)
+		reason = streamCloseReason(ss.Context(), err)
+		return err
+	}
+}
+
 func (t *RpcServ) defReqHeader() *pb.Header {
 	return &pb.Header{
 		Logid:    utils.GenLogId(),
@@ -125,10 +219,29 @@ func (t *RpcServ) createReqCtx(gctx context.Context, reqHeader *pb.Header) (sctx
 		return nil, fmt.Errorf("create request context failed")
 	}
 
-	return rctx, nil
+	// 基于请求上下文创建的span作为gctx中span的子span
+	return tracing.WithSpan(gctx, rctx), nil
+}
+
+// 流式接口不经过UnaryInterceptor，由StreamInterceptor按相同规则补全logid并创建请求上下文
+func (t *RpcServ) createStreamReqCtx(gctx context.Context, reqHeader *pb.Header) (sctx.ReqCtx, error) {
+	if reqHeader.GetLogid() == "" {
+		reqHeader = &pb.Header{
+			Logid:    utils.GenLogId(),
+			FromNode: reqHeader.GetFromNode(),
+		}
+	}
+
+	return t.createReqCtx(gctx, reqHeader)
 }
 
 func (t *RpcServ) getClietIP(gctx context.Context) (string, error) {
+	return getClientIp(gctx)
+}
+
+// getClientIp 网关在本机通过回环地址转发http请求，此时取网关追加在x-forwarded-for最后的http客户端ip，
+// 之前的值由http客户端提供，不可信
+func getClientIp(gctx context.Context) (string, error) {
 	pr, ok := peer.FromContext(gctx)
 	if !ok {
 		return "", fmt.Errorf("create peer form context failed")
@@ -139,7 +252,20 @@ func (t *RpcServ) getClietIP(gctx context.Context) (string, error) {
 	}
 
 	addrSlice := strings.Split(pr.Addr.String(), ":")
-	return addrSlice[0], nil
+	clientIp := addrSlice[0]
+	if ip := net.ParseIP(clientIp); ip == nil || !ip.IsLoopback() {
+		return clientIp, nil
+	}
+	md, _ := metadata.FromIncomingContext(gctx)
+	forwarded := md.Get(forwardedForKey)
+	if len(forwarded) == 0 {
+		return clientIp, nil
+	}
+	last := forwarded[len(forwarded)-1]
+	if ip := strings.TrimSpace(last[strings.LastIndex(last, ",")+1:]); ip != "" {
+		return ip, nil
+	}
+	return clientIp, nil
 }
 
 // 生成包含机器host和请求时间的AES加密字符串，方便问题定位
@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	"github.com/xuperchain/xupercore/lib/metrics"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// 流关闭原因
+const (
+	streamCloseFinished = "finished"
+	streamCloseCanceled = "canceled"
+	streamCloseDeadline = "deadline_exceeded"
+	streamCloseError    = "error"
+	streamClosePanic    = "panic"
+
+	subsystemStream = "stream"
+	labelReason     = "reason"
+)
+
+// 流式接口监控，按方法统计订阅时长、推送消息数、推送字节数和关闭原因
+var (
+	StreamActiveGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: subsystemStream,
+			Name:      "active_total",
+			Help:      "Total number of active streams.",
+		},
+		[]string{metrics.LabelCallMethod})
+	StreamDurationHistogram = prom.NewHistogramVec(
+		prom.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: subsystemStream,
+			Name:      "duration_seconds",
+			Help:      "Histogram of stream duration.",
+			Buckets:   []float64{.1, 1, 10, 60, 300, 1800, 3600, 21600, 86400},
+		},
+		[]string{metrics.LabelCallMethod, labelReason})
+	StreamSentCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: subsystemStream,
+			Name:      "sent_msg_total",
+			Help:      "Total number of messages sent by streams.",
+		},
+		[]string{metrics.LabelCallMethod})
+	StreamSentBytesCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: subsystemStream,
+			Name:      "sent_bytes",
+			Help:      "Total size of messages sent by streams.",
+		},
+		[]string{metrics.LabelCallMethod})
+)
+
+func registerStreamMetrics() {
+	prom.MustRegister(StreamActiveGauge, StreamDurationHistogram, StreamSentCounter, StreamSentBytesCounter)
+}
+
+// monitorStream 在收到请求后创建请求上下文，并统计推送的消息
+type monitorStream struct {
+	grpc.ServerStream
+	serv *RpcServ
+	ctx  context.Context
+	rctx sctx.ReqCtx
+
+	sent      int
+	sentBytes int
+}
+
+func (s *monitorStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg 服务端流式接口在调用处理函数前接收请求，按请求头中的logid创建请求上下文
+func (s *monitorStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.rctx != nil {
+		return nil
+	}
+
+	var reqHeader *pb.Header
+	if req, ok := m.(interface{ GetHeader() *pb.Header }); ok {
+		reqHeader = req.GetHeader()
+	}
+	return s.initReqCtx(reqHeader)
+}
+
+func (s *monitorStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.sent++
+	if msg, ok := m.(proto.Message); ok {
+		s.sentBytes += proto.Size(msg)
+	}
+	return nil
+}
+
+func (s *monitorStream) initReqCtx(reqHeader *pb.Header) error {
+	rctx, err := s.serv.createStreamReqCtx(s.ctx, reqHeader)
+	if err != nil {
+		return err
+	}
+	s.rctx = rctx
+	s.ctx = sctx.WithReqCtx(s.ctx, rctx)
+	return nil
+}
+
+func (s *monitorStream) clientIp() string {
+	if s.rctx == nil {
+		return ""
+	}
+	return s.rctx.GetClientIp()
+}
+
+// streamCloseReason 客户端断开时处理函数可能返回nil，需要优先检查上下文
+func streamCloseReason(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled) || status.Code(err) == codes.Canceled:
+		return streamCloseCanceled
+	case errors.Is(ctx.Err(), context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded:
+		return streamCloseDeadline
+	case err != nil:
+		return streamCloseError
+	default:
+		return streamCloseFinished
+	}
+}
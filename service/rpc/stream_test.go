@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sendErr error
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) SendMsg(m interface{}) error {
+	return s.sendErr
+}
+
+func TestMonitorStreamSend(t *testing.T) {
+	ss := &mockServerStream{ctx: context.Background()}
+	stream := &monitorStream{ServerStream: ss, ctx: ss.Context()}
+	event := &pb.Event{Payload: []byte("payload")}
+	for i := 0; i < 2; i++ {
+		if err := stream.SendMsg(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ss.sendErr = errors.New("send failed")
+	if err := stream.SendMsg(event); err == nil {
+		t.Fatal("expect send error")
+	}
+	if stream.sent != 2 || stream.sentBytes != 2*proto.Size(event) {
+		t.Fatalf("unexpected stats, sent:%d bytes:%d", stream.sent, stream.sentBytes)
+	}
+}
+
+func TestStreamCloseReason(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	cases := []struct {
+		ctx    context.Context
+		err    error
+		reason string
+	}{
+		{context.Background(), nil, streamCloseFinished},
+		{canceled, nil, streamCloseCanceled},
+		{context.Background(), status.Error(codes.DeadlineExceeded, "timeout"), streamCloseDeadline},
+		{context.Background(), errors.New("event service disabled"), streamCloseError},
+	}
+	for _, c := range cases {
+		if reason := streamCloseReason(c.ctx, c.err); reason != c.reason {
+			t.Errorf("expect %s, got %s", c.reason, reason)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc/metadata"
+
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+)
+
+// metadataCarrier 在grpc metadata中读写trace context
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Extract 从grpc请求的metadata中提取上游的trace context
+func Extract(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// ExtractHTTP 从http请求头中提取上游的trace context
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// Inject 把ctx中的trace context写入grpc metadata
+func Inject(ctx context.Context, md metadata.MD) {
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+}
+
+// tracedReqCtx 请求上下文本身不携带span，这里只把Value委托给携带span的ctx，
+// 超时和取消仍保持原请求上下文的行为
+type tracedReqCtx struct {
+	sctx.ReqCtx
+	ctx context.Context
+}
+
+func (c *tracedReqCtx) Value(key interface{}) interface{} {
+	return c.ctx.Value(key)
+}
+
+// WithSpan 让基于请求上下文创建的span成为ctx中span的子span
+func WithSpan(ctx context.Context, rctx sctx.ReqCtx) sctx.ReqCtx {
+	if rctx == nil {
+		return nil
+	}
+	return &tracedReqCtx{ReqCtx: rctx, ctx: ctx}
+}
@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+)
+
+const (
+	tracerName = "github.com/xuperchain/xuperchain/service"
+
+	// 内置导出方式
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+
+	// span中记录logid的属性名，用于关联日志
+	AttrLogId = "xchain.logid"
+)
+
+// ExporterFactory 按配置创建span导出器，需要对接collector时通过RegisterExporter注册
+type ExporterFactory func(conf sconf.TraceConf) (sdktrace.SpanExporter, error)
+
+var (
+	exportersMu sync.RWMutex
+	exporters   = map[string]ExporterFactory{
+		ExporterStdout: newStdoutExporter,
+		ExporterFile:   newFileExporter,
+	}
+)
+
+// RegisterExporter 注册导出方式，需要在Init之前调用
+func RegisterExporter(name string, factory ExporterFactory) {
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	exporters[name] = factory
+}
+
+// Init 设置全局的W3C传播器，开启追踪时按配置设置全局TracerProvider，
+// 未开启时span不记录也不导出，但仍透传上游的trace context。
+// 返回的函数用于退出时导出剩余的span
+func Init(conf sconf.TraceConf) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+	if !conf.Enable {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exportersMu.RLock()
+	factory, ok := exporters[conf.Exporter]
+	exportersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown trace exporter: %s", conf.Exporter)
+	}
+	exporter, err := factory(conf)
+	if err != nil {
+		return nil, fmt.Errorf("create trace exporter failed: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", conf.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func newStdoutExporter(conf sconf.TraceConf) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+}
+
+func newFileExporter(conf sconf.TraceConf) (sdktrace.SpanExporter, error) {
+	if conf.FilePath == "" {
+		return nil, fmt.Errorf("file path of trace exporter unset")
+	}
+	file, err := os.OpenFile(conf.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return stdouttrace.New(stdouttrace.WithWriter(file))
+}
+
+// Start 以ctx中的span为父span创建新的span
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// End 记录错误并结束span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceId 返回ctx中span的trace id，没有有效的span时返回空
+func TraceId(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/metadata"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+)
+
+// memoryExporter 内存导出器关闭时会清空span，测试中保留
+type memoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (e memoryExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	if _, err := Init(sconf.TraceConf{Enable: true, Exporter: "unknown"}); err == nil {
+		t.Fatal("expect unknown exporter error")
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	RegisterExporter("memory", func(conf sconf.TraceConf) (sdktrace.SpanExporter, error) {
+		return memoryExporter{exporter}, nil
+	})
+	shutdown, err := Init(sconf.TraceConf{Enable: true, Exporter: "memory", ServiceName: "xchain", SampleRatio: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 网关注入的trace context在rpc服务中延续
+	gwCtx, gwSpan := Start(context.Background(), "gateway")
+	md := metadata.MD{}
+	Inject(gwCtx, md)
+	if len(md.Get("traceparent")) != 1 {
+		t.Fatalf("expect traceparent injected, got %v", md)
+	}
+	rpcCtx, rpcSpan := Start(Extract(metadata.NewIncomingContext(context.Background(), md)), "rpc")
+	if TraceId(rpcCtx) != TraceId(gwCtx) {
+		t.Fatal("expect same trace id")
+	}
+
+	// 基于请求上下文创建的span是rpc span的子span
+	if WithSpan(rpcCtx, nil) != nil {
+		t.Fatal("expect nil request context")
+	}
+	_, childSpan := Start(&tracedReqCtx{ctx: rpcCtx}, "child")
+	End(childSpan, errors.New("query failed"))
+	End(rpcSpan, nil)
+	gwSpan.End()
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expect 3 spans, got %d", len(spans))
+	}
+	child, rpc, gw := spans[0], spans[1], spans[2]
+	if child.Parent.SpanID() != rpc.SpanContext.SpanID() || rpc.Parent.SpanID() != gw.SpanContext.SpanID() ||
+		gw.Parent.IsValid() {
+		t.Fatal("unexpected span parent")
+	}
+	if len(child.Events) != 1 || child.Status.Description != "query failed" {
+		t.Fatalf("expect error recorded, got %+v", child.Status)
+	}
+}
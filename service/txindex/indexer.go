@@ -0,0 +1,347 @@
+package txindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+)
+
+// 地址在交易中的角色，按位存储
+const (
+	RoleInitiator = 1 << iota
+	RoleInput
+	RoleOutput
+	RoleAuthRequire
+)
+
+// 索引表前缀
+// 地址索引：A{address}/{height}{index} => {roles}{txid}
+// 区块记录：H{height} => heightRecord
+const (
+	addrTablePrefix   = "A"
+	heightTablePrefix = "H"
+	addrSeparator     = "/"
+
+	// 分页游标长度：8字节高度+4字节区块内交易序号
+	cursorLen = 12
+)
+
+var (
+	// ErrBadCursor 分页游标格式错误
+	ErrBadCursor = errors.New("bad cursor")
+
+	// 区块与已索引的前一区块不连续，需要重新回滚
+	errNotContinuous = errors.New("block not continuous with indexed block")
+)
+
+// ledgerReader 索引所需的账本读接口
+type ledgerReader interface {
+	GetMeta() *lpb.LedgerMeta
+	QueryBlockByHeight(height int64) (*lpb.InternalBlock, error)
+}
+
+// Record 地址交易索引记录
+type Record struct {
+	Txid    []byte
+	Blockid []byte
+	Height  int64
+	Roles   int
+
+	cursor []byte
+}
+
+// heightRecord 记录每个已索引高度的区块id和涉及的地址，用于分叉时回滚
+type heightRecord struct {
+	Blockid []byte   `json:"blockid"`
+	Addrs   []string `json:"addrs"`
+}
+
+// chainIndexer 维护单条链的地址交易索引
+type chainIndexer struct {
+	bcName string
+	ledger ledgerReader
+	db     kvdb.Database
+	log    logs.Logger
+
+	// 写索引时加写锁，避免查询读到回滚了一半的数据
+	mutex sync.RWMutex
+	// 已索引的最大高度，-1表示尚未索引
+	tip int64
+}
+
+func newChainIndexer(bcName string, ledger ledgerReader, db kvdb.Database,
+	log logs.Logger) (*chainIndexer, error) {
+	obj := &chainIndexer{
+		bcName: bcName,
+		ledger: ledger,
+		db:     db,
+		log:    log,
+		tip:    -1,
+	}
+
+	// 从最后一条区块记录恢复索引进度
+	iter := db.NewIteratorWithPrefix([]byte(heightTablePrefix))
+	defer iter.Release()
+	if iter.Last() {
+		obj.tip = decodeHeight(iter.Key()[len(heightTablePrefix):])
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("load tx index tip failed.err:%v", err)
+	}
+
+	return obj, nil
+}
+
+// sync 回滚分叉的区块并索引到账本主干最新高度，exitCh关闭时提前退出
+func (c *chainIndexer) sync(exitCh <-chan struct{}) error {
+	if err := c.rollback(); err != nil {
+		return err
+	}
+
+	trunkHeight := c.ledger.GetMeta().GetTrunkHeight()
+	for height := c.tip + 1; height <= trunkHeight; height++ {
+		select {
+		case <-exitCh:
+			return nil
+		default:
+		}
+
+		block, err := c.ledger.QueryBlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("query block failed.height:%d,err:%v", height, err)
+		}
+		err = c.indexBlock(block)
+		if err == errNotContinuous {
+			// 主干在索引过程中发生切换，等待下一轮回滚
+			c.log.Info("trunk switched while indexing", "bc_name", c.bcName, "height", height)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollback 回滚已不在主干上的区块索引
+func (c *chainIndexer) rollback() error {
+	for c.tip >= 0 {
+		record, err := c.getHeightRecord(c.tip)
+		if err != nil {
+			return err
+		}
+		block, err := c.ledger.QueryBlockByHeight(c.tip)
+		if err == nil && bytes.Equal(block.GetBlockid(), record.Blockid) {
+			return nil
+		}
+
+		batch := c.db.NewBatch()
+		for _, addr := range record.Addrs {
+			c.deleteAddrKeys(batch, addr, c.tip)
+		}
+		_ = batch.Delete(heightKey(c.tip))
+		c.mutex.Lock()
+		err = batch.Write()
+		c.mutex.Unlock()
+		if err != nil {
+			return fmt.Errorf("rollback tx index failed.height:%d,err:%v", c.tip, err)
+		}
+		c.log.Info("rollback tx index", "bc_name", c.bcName, "height", c.tip)
+		c.tip--
+	}
+
+	return nil
+}
+
+// indexBlock 索引一个主干区块，区块高度必须为tip+1
+func (c *chainIndexer) indexBlock(block *lpb.InternalBlock) error {
+	height := block.GetHeight()
+	if height != c.tip+1 {
+		return errNotContinuous
+	}
+	if c.tip >= 0 {
+		record, err := c.getHeightRecord(c.tip)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(block.GetPreHash(), record.Blockid) {
+			return errNotContinuous
+		}
+	}
+
+	batch := c.db.NewBatch()
+	addrSet := make(map[string]bool)
+	record := &heightRecord{Blockid: block.GetBlockid()}
+	for i, tx := range block.GetTransactions() {
+		for addr, roles := range txAddrRoles(tx) {
+			value := append([]byte{byte(roles)}, tx.GetTxid()...)
+			if err := batch.Put(addrKey(addr, height, int32(i)), value); err != nil {
+				return err
+			}
+			if !addrSet[addr] {
+				addrSet[addr] = true
+				record.Addrs = append(record.Addrs, addr)
+			}
+		}
+	}
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := batch.Put(heightKey(height), buf); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	err = batch.Write()
+	c.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("write tx index failed.height:%d,err:%v", height, err)
+	}
+
+	c.tip = height
+	return nil
+}
+
+// query 按时间倒序查询地址相关交易，cursor为上一页返回的游标，为空时从最新交易开始
+func (c *chainIndexer) query(address string, cursor []byte, limit int) ([]*Record, []byte, error) {
+	if len(cursor) != 0 && len(cursor) != cursorLen {
+		return nil, nil, ErrBadCursor
+	}
+
+	prefix := []byte(addrTablePrefix + address + addrSeparator)
+	end := prefixLimit(prefix)
+	if len(cursor) != 0 {
+		end = append(append([]byte{}, prefix...), cursor...)
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	iter := c.db.NewIteratorWithRange(prefix, end)
+	defer iter.Release()
+
+	records := make([]*Record, 0, limit)
+	blockids := make(map[int64][]byte)
+	var nextCursor []byte
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		if len(records) >= limit {
+			nextCursor = append([]byte{}, records[len(records)-1].cursor...)
+			break
+		}
+
+		key := iter.Key()
+		value := iter.Value()
+		if len(key) != len(prefix)+cursorLen || len(value) < 1 {
+			continue
+		}
+		height := decodeHeight(key[len(prefix):])
+		if _, ok := blockids[height]; !ok {
+			record, err := c.getHeightRecord(height)
+			if err != nil {
+				return nil, nil, err
+			}
+			blockids[height] = record.Blockid
+		}
+		records = append(records, &Record{
+			Txid:    append([]byte{}, value[1:]...),
+			Blockid: blockids[height],
+			Height:  height,
+			Roles:   int(value[0]),
+			cursor:  append([]byte{}, key[len(prefix):]...),
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	return records, nextCursor, nil
+}
+
+func (c *chainIndexer) getHeightRecord(height int64) (*heightRecord, error) {
+	buf, err := c.db.Get(heightKey(height))
+	if err != nil {
+		return nil, fmt.Errorf("get tx index height record failed.height:%d,err:%v", height, err)
+	}
+	record := &heightRecord{}
+	if err := json.Unmarshal(buf, record); err != nil {
+		return nil, fmt.Errorf("unmarshal tx index height record failed.height:%d,err:%v", height, err)
+	}
+	return record, nil
+}
+
+// deleteAddrKeys 删除地址在指定高度的全部索引
+func (c *chainIndexer) deleteAddrKeys(batch kvdb.Batch, addr string, height int64) {
+	prefix := []byte(addrTablePrefix + addr + addrSeparator)
+	start := append(append([]byte{}, prefix...), encodeHeight(height)...)
+	iter := c.db.NewIteratorWithPrefix(start)
+	defer iter.Release()
+	for iter.Next() {
+		_ = batch.Delete(append([]byte{}, iter.Key()...))
+	}
+}
+
+// txAddrRoles 解析交易涉及的地址及其角色
+func txAddrRoles(tx *lpb.Transaction) map[string]int {
+	addrRoles := make(map[string]int)
+	add := func(addr string, role int) {
+		if addr == "" || addr == utxo.FeePlaceholder {
+			return
+		}
+		addrRoles[addr] |= role
+	}
+
+	add(tx.GetInitiator(), RoleInitiator)
+	for _, input := range tx.GetTxInputs() {
+		add(string(input.GetFromAddr()), RoleInput)
+	}
+	for _, output := range tx.GetTxOutputs() {
+		add(string(output.GetToAddr()), RoleOutput)
+	}
+	// auth_require格式为account/address或address，合约账户和AK都建立索引
+	for _, authRequire := range tx.GetAuthRequire() {
+		for _, addr := range strings.Split(authRequire, addrSeparator) {
+			add(addr, RoleAuthRequire)
+		}
+	}
+
+	return addrRoles
+}
+
+func addrKey(addr string, height int64, index int32) []byte {
+	key := make([]byte, 0, len(addrTablePrefix)+len(addr)+len(addrSeparator)+cursorLen)
+	key = append(key, addrTablePrefix+addr+addrSeparator...)
+	key = append(key, encodeHeight(height)...)
+	return binary.BigEndian.AppendUint32(key, uint32(index))
+}
+
+// prefixLimit 返回大于所有以prefix开头的key的最小key
+func prefixLimit(prefix []byte) []byte {
+	limit := append([]byte{}, prefix...)
+	for i := len(limit) - 1; i >= 0; i-- {
+		if limit[i] < 0xff {
+			limit[i]++
+			return limit[:i+1]
+		}
+	}
+	return nil
+}
+
+func heightKey(height int64) []byte {
+	return append([]byte(heightTablePrefix), encodeHeight(height)...)
+}
+
+func encodeHeight(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+func decodeHeight(buf []byte) int64 {
+	return int64(binary.BigEndian.Uint64(buf[:8]))
+}
@@ -0,0 +1,177 @@
+package txindex
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+type mockLedger struct {
+	blocks []*lpb.InternalBlock
+}
+
+func (l *mockLedger) GetMeta() *lpb.LedgerMeta {
+	return &lpb.LedgerMeta{TrunkHeight: int64(len(l.blocks)) - 1}
+}
+
+func (l *mockLedger) QueryBlockByHeight(height int64) (*lpb.InternalBlock, error) {
+	if height < 0 || height >= int64(len(l.blocks)) {
+		return nil, fmt.Errorf("block not exist")
+	}
+	return l.blocks[height], nil
+}
+
+// appendBlock 追加一个区块，每笔交易由from转账给to
+func (l *mockLedger) appendBlock(tag string, transfers ...[2]string) {
+	height := int64(len(l.blocks))
+	block := &lpb.InternalBlock{
+		Blockid: []byte(fmt.Sprintf("%s-block-%d", tag, height)),
+		Height:  height,
+	}
+	if height > 0 {
+		block.PreHash = l.blocks[height-1].Blockid
+	}
+	for i, transfer := range transfers {
+		block.Transactions = append(block.Transactions, &lpb.Transaction{
+			Txid:        []byte(fmt.Sprintf("%s-tx-%d-%d", tag, height, i)),
+			Initiator:   transfer[0],
+			AuthRequire: []string{transfer[0]},
+			TxInputs:    []*protos.TxInput{{FromAddr: []byte(transfer[0])}},
+			TxOutputs: []*protos.TxOutput{
+				{ToAddr: []byte(transfer[1])},
+				{ToAddr: []byte("$")},
+			},
+		})
+	}
+	l.blocks = append(l.blocks, block)
+}
+
+func newTestIndexer(t *testing.T, ledger ledgerReader) *chainIndexer {
+	db, err := kvdb.CreateKVInstance(&kvdb.KVParameter{
+		DBPath:                t.TempDir(),
+		KVEngineType:          kvdb.KVEngineTypeLDB,
+		StorageType:           kvdb.StorageTypeSingle,
+		MemCacheSize:          memCacheSize,
+		FileHandlersCacheSize: fileHandlersCacheSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	confFile := filepath.Join(utils.GetCurFileDir(), "../../conf/log.yaml")
+	logs.InitLog(confFile, t.TempDir())
+	log, _ := logs.NewLogger("", "txindex_test")
+	indexer, err := newChainIndexer("xuper", ledger, db, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return indexer
+}
+
+func TestIndexAndQuery(t *testing.T) {
+	ledger := &mockLedger{}
+	ledger.appendBlock("a")
+	ledger.appendBlock("a", [2]string{"alice", "bob"})
+	ledger.appendBlock("a", [2]string{"bob", "carol"}, [2]string{"alice", "carol"})
+	indexer := newTestIndexer(t, ledger)
+	if err := indexer.sync(nil); err != nil {
+		t.Fatal(err)
+	}
+	if indexer.tip != 2 {
+		t.Fatalf("expect tip 2, got %d", indexer.tip)
+	}
+
+	records, cursor, err := indexer.query("alice", nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !bytes.Equal(records[0].Txid, []byte("a-tx-2-1")) {
+		t.Fatalf("unexpected first page: %+v", records)
+	}
+	if records[0].Roles != RoleInitiator|RoleInput|RoleAuthRequire {
+		t.Fatalf("unexpected roles: %d", records[0].Roles)
+	}
+	if len(cursor) == 0 {
+		t.Fatal("expect next cursor")
+	}
+
+	records, cursor, err = indexer.query("alice", cursor, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !bytes.Equal(records[0].Txid, []byte("a-tx-1-0")) {
+		t.Fatalf("unexpected second page: %+v", records)
+	}
+	if len(cursor) != 0 {
+		t.Fatal("expect no more data")
+	}
+
+	records, _, err = indexer.query("carol", nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Roles != RoleOutput {
+		t.Fatalf("unexpected carol records: %+v", records)
+	}
+
+	records, _, err = indexer.query("$", nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Fatal("fee placeholder should not be indexed")
+	}
+
+	if _, _, err = indexer.query("alice", []byte("bad"), 1); err != ErrBadCursor {
+		t.Fatalf("expect bad cursor error, got %v", err)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	ledger := &mockLedger{}
+	ledger.appendBlock("a")
+	ledger.appendBlock("a", [2]string{"alice", "bob"})
+	ledger.appendBlock("a", [2]string{"alice", "carol"})
+	indexer := newTestIndexer(t, ledger)
+	if err := indexer.sync(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// 高度2发生分叉，新主干更长
+	ledger.blocks = ledger.blocks[:2]
+	ledger.appendBlock("b", [2]string{"dave", "bob"})
+	ledger.appendBlock("b", [2]string{"bob", "erin"})
+	if err := indexer.sync(nil); err != nil {
+		t.Fatal(err)
+	}
+	if indexer.tip != 3 {
+		t.Fatalf("expect tip 3, got %d", indexer.tip)
+	}
+
+	records, _, err := indexer.query("carol", nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("forked tx should be removed: %+v", records)
+	}
+
+	records, _, err = indexer.query("bob", nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expect 3 records for bob, got %d", len(records))
+	}
+	if !bytes.Equal(records[0].Blockid, []byte("b-block-3")) || records[0].Height != 3 {
+		t.Fatalf("unexpected latest record: %+v", records[0])
+	}
+}
@@ -0,0 +1,152 @@
+package txindex
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
+)
+
+const (
+	// 索引数据目录，位于节点data目录下，每条链一个子目录
+	indexDirName = "txindex"
+	// 检查新区块的时间间隔
+	syncInterval = time.Second
+
+	memCacheSize          = 32  //MB
+	fileHandlersCacheSize = 256 //how many opened files-handlers cached
+)
+
+var (
+	// ErrIndexNotReady 链的索引尚未创建
+	ErrIndexNotReady = errors.New("tx index not ready")
+)
+
+// Manager 在服务层维护各条链的地址交易二级索引，随主干区块增长异步更新
+type Manager struct {
+	engine ecom.Engine
+	log    logs.Logger
+
+	mutex    sync.RWMutex
+	indexers map[string]*chainIndexer
+	dbs      []kvdb.Database
+
+	exitCh   chan struct{}
+	exitOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewManager(engine ecom.Engine, log logs.Logger) *Manager {
+	return &Manager{
+		engine:   engine,
+		log:      log,
+		indexers: make(map[string]*chainIndexer),
+		exitCh:   make(chan struct{}),
+	}
+}
+
+// Start 启动后台索引协程
+func (m *Manager) Start() {
+	m.wg.Add(1)
+	go m.run()
+}
+
+// Stop 停止索引并关闭数据库，需要幂等
+func (m *Manager) Stop() {
+	m.exitOnce.Do(func() {
+		close(m.exitCh)
+		m.wg.Wait()
+
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		for _, db := range m.dbs {
+			db.Close()
+		}
+		m.indexers = make(map[string]*chainIndexer)
+		m.dbs = nil
+	})
+}
+
+// Query 按时间倒序分页查询地址相关交易，返回记录和下一页游标，没有更多数据时游标为空
+func (m *Manager) Query(bcName, address string, cursor []byte, limit int) ([]*Record, []byte, error) {
+	m.mutex.RLock()
+	indexer, ok := m.indexers[bcName]
+	m.mutex.RUnlock()
+	if !ok {
+		return nil, nil, ErrIndexNotReady
+	}
+
+	return indexer.query(address, cursor, limit)
+}
+
+func (m *Manager) run() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+	for {
+		// 引擎运行中可能加载新的链，每轮都检查
+		for _, bcName := range m.engine.GetChains() {
+			indexer, err := m.getIndexer(bcName)
+			if err != nil {
+				m.log.Warn("create tx indexer failed", "bc_name", bcName, "err", err)
+				continue
+			}
+			if err := indexer.sync(m.exitCh); err != nil {
+				m.log.Warn("sync tx index failed", "bc_name", bcName, "err", err)
+			}
+		}
+
+		select {
+		case <-m.exitCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) getIndexer(bcName string) (*chainIndexer, error) {
+	m.mutex.RLock()
+	indexer, ok := m.indexers[bcName]
+	m.mutex.RUnlock()
+	if ok {
+		return indexer, nil
+	}
+
+	chain, err := m.engine.Get(bcName)
+	if err != nil {
+		return nil, err
+	}
+
+	envCfg := m.engine.Context().EnvCfg
+	dbPath := filepath.Join(envCfg.GenDataAbsPath(indexDirName), bcName)
+	db, err := kvdb.CreateKVInstance(&kvdb.KVParameter{
+		DBPath:                dbPath,
+		KVEngineType:          kvdb.KVEngineTypeLDB,
+		StorageType:           kvdb.StorageTypeSingle,
+		MemCacheSize:          memCacheSize,
+		FileHandlersCacheSize: fileHandlersCacheSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open tx index db failed.path:%s,err:%v", dbPath, err)
+	}
+
+	indexer, err = newChainIndexer(bcName, chain.Context().Ledger, db, m.log)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.indexers[bcName] = indexer
+	m.dbs = append(m.dbs, db)
+	m.log.Info("tx index loaded", "bc_name", bcName, "tip", indexer.tip)
+	return indexer, nil
+}